@@ -484,7 +484,7 @@ type GeneratorRef struct {
 	APIVersion string `json:"apiVersion,omitempty"`
 
 	// Specify the Kind of the generator resource
-	// +kubebuilder:validation:Enum=ACRAccessToken;ClusterGenerator;ECRAuthorizationToken;Fake;GCRAccessToken;GithubAccessToken;QuayAccessToken;Password;SSHKey;STSSessionToken;UUID;VaultDynamicSecret;Webhook;Grafana;MFA
+	// +kubebuilder:validation:Enum=ACRAccessToken;ClusterGenerator;ECRAuthorizationToken;Fake;GCRAccessToken;GithubAccessToken;QuayAccessToken;Password;SSHKey;STSSessionToken;UUID;VaultDynamicSecret;Webhook;Grafana;MFA;Certificate;ServiceAccountToken;WireGuardKey;AgeKey;DatabaseUser;GitlabAccessToken;OAuth2Token;Transform
 	Kind string `json:"kind"`
 
 	// Specify the name of the generator resource
@@ -499,6 +499,7 @@ type ExternalSecretConditionType string
 const (
 	ExternalSecretReady   ExternalSecretConditionType = "Ready"
 	ExternalSecretDeleted ExternalSecretConditionType = "Deleted"
+	ExternalSecretPaused  ExternalSecretConditionType = "Paused"
 )
 
 type ExternalSecretStatusCondition struct {
@@ -524,6 +525,12 @@ const (
 	ConditionReasonSecretDeleted = "SecretDeleted"
 	// ConditionReasonSecretMissing indicates that the secret is missing.
 	ConditionReasonSecretMissing = "SecretMissing"
+	// ConditionReasonTemplateLimitExceeded indicates that a template exceeded a resource limit.
+	ConditionReasonTemplateLimitExceeded = "TemplateLimitExceeded"
+	// ConditionReasonPaused indicates that syncing is paused.
+	ConditionReasonPaused = "Paused"
+	// ConditionReasonResumed indicates that syncing has been resumed.
+	ConditionReasonResumed = "Resumed"
 
 	ReasonUpdateFailed          = "UpdateFailed"
 	ReasonDeprecated            = "ParameterDeprecated"
@@ -531,8 +538,19 @@ const (
 	ReasonUpdated               = "Updated"
 	ReasonDeleted               = "Deleted"
 	ReasonMissingProviderSecret = "MissingProviderSecret"
+	ReasonCertificateExpiring   = "CertificateExpiring"
+	ReasonCertificateExpired    = "CertificateExpired"
 )
 
+// CertificateExpiry holds the earliest expiry of the certificates found in a key of the target Secret.
+type CertificateExpiry struct {
+	// Key is the key of the target Secret that contains the certificates.
+	Key string `json:"key"`
+
+	// NotAfter is the earliest notAfter of all certificates found in the key.
+	NotAfter metav1.Time `json:"notAfter"`
+}
+
 type ExternalSecretStatus struct {
 	// +nullable
 	// refreshTime is the time and date the external secret was fetched and
@@ -547,6 +565,17 @@ type ExternalSecretStatus struct {
 
 	// Binding represents a servicebinding.io Provisioned Service reference to the secret
 	Binding corev1.LocalObjectReference `json:"binding,omitempty"`
+
+	// Certificates holds the earliest certificate expiry per key of the target Secret.
+	// It is only populated when certificate expiry tracking is enabled in the controller.
+	// +optional
+	Certificates []CertificateExpiry `json:"certificates,omitempty"`
+
+	// RenewalTime is the time at which generated values, e.g. certificates, must be renewed.
+	// ExternalSecrets with refreshPolicy Periodic are refreshed at this time,
+	// even if the refreshInterval has not passed yet.
+	// +optional
+	RenewalTime *metav1.Time `json:"renewalTime,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -572,6 +601,15 @@ const (
 	// AnnotationDataHash all secrets managed by an ExternalSecret have this annotation with the hash of their data.
 	AnnotationDataHash = "reconcile.external-secrets.io/data-hash"
 
+	// AnnotationPaused pauses syncing of an ExternalSecret or PushSecret while it is set to "true".
+	// No provider calls are made and the existing target secret is kept.
+	AnnotationPaused = "external-secrets.io/paused"
+
+	// AnnotationForceSync triggers a sync of an ExternalSecret or PushSecret when its value changes.
+	// The controllers do not read it: like any change of the annotations, it changes the resource version
+	// that is compared with the synced one, so it has no effect with refreshPolicy CreatedOnce.
+	AnnotationForceSync = "external-secrets.io/force-sync"
+
 	// LabelManaged all secrets managed by an ExternalSecret will have this label equal to "true".
 	LabelManaged      = "reconcile.external-secrets.io/managed"
 	LabelManagedValue = "true"
@@ -66,6 +66,19 @@ type Provider interface {
 // +k8s:deepcopy-gen:interfaces=nil
 // +k8s:deepcopy-gen=nil
 
+// ReferentProvider is an optional interface for providers that support referent authentication,
+// i.e. a ClusterSecretStore that references credentials without a namespace,
+// which are resolved in the namespace of the ExternalSecret.
+type ReferentProvider interface {
+	// IsReferent returns true if the store uses referent authentication.
+	IsReferent(store GenericStore) bool
+}
+
+// +kubebuilder:object:root=false
+// +kubebuilder:object:generate:false
+// +k8s:deepcopy-gen:interfaces=nil
+// +k8s:deepcopy-gen=nil
+
 // SecretsClient provides access to secrets.
 type SecretsClient interface {
 	// GetSecret returns a single secret from the provider
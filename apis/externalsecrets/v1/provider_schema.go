@@ -97,6 +97,14 @@ func GetProvider(s GenericStore) (Provider, error) {
 	return f, nil
 }
 
+// GetProviderName returns the name of the provider configured in the generic store.
+func GetProviderName(s GenericStore) (string, error) {
+	if s == nil || s.GetSpec() == nil {
+		return "", fmt.Errorf("no spec found in %#v", s)
+	}
+	return getProviderName(s.GetSpec().Provider)
+}
+
 // getProviderName returns the name of the configured provider
 // or an error if the provider is not configured.
 func getProviderName(storeSpec *SecretStoreProvider) (string, error) {
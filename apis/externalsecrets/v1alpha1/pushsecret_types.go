@@ -25,6 +25,8 @@ import (
 const (
 	ReasonSynced  = "Synced"
 	ReasonErrored = "Errored"
+	ReasonPaused  = "Paused"
+	ReasonResumed = "Resumed"
 )
 
 type PushSecretStoreRef struct {
@@ -183,7 +185,8 @@ func (d PushSecretData) GetProperty() string {
 type PushSecretConditionType string
 
 const (
-	PushSecretReady PushSecretConditionType = "Ready"
+	PushSecretReady  PushSecretConditionType = "Ready"
+	PushSecretPaused PushSecretConditionType = "Paused"
 )
 
 // PushSecretStatusCondition indicates the status of the PushSecret.
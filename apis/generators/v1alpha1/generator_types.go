@@ -14,8 +14,34 @@ limitations under the License.
 
 package v1alpha1
 
+import (
+	esv1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1"
+)
+
 type ControllerClassResource struct {
 	Spec struct {
 		ControllerClass string `json:"controller"`
 	} `json:"spec"`
 }
+
+// GeneratorInput makes the values of another generator available to a generator,
+// which allows to chain generators.
+type GeneratorInput struct {
+	// Name of the input. References of the generator to a Secret with this name
+	// resolve to the values of the referenced generator instead.
+	// +kubebuilder:validation:MinLength:=1
+	// +kubebuilder:validation:MaxLength:=253
+	// +kubebuilder:validation:Pattern:=^[a-z0-9]([-a-z0-9]*[a-z0-9])?(\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*$
+	Name string `json:"name"`
+
+	// GeneratorRef references the generator that creates the values of the input.
+	GeneratorRef esv1.GeneratorRef `json:"generatorRef"`
+}
+
+// GeneratorInputsResource is used to read the inputs of any generator.
+// +kubebuilder:object:generate=false
+type GeneratorInputsResource struct {
+	Spec struct {
+		Inputs []GeneratorInput `json:"inputs"`
+	} `json:"spec"`
+}
@@ -43,6 +43,14 @@ var (
 	ACRAccessTokenKind        = reflect.TypeOf(ACRAccessToken{}).Name()
 	PasswordKind              = reflect.TypeOf(Password{}).Name()
 	SSHKeyKind                = reflect.TypeOf(SSHKey{}).Name()
+	CertificateKind           = reflect.TypeOf(Certificate{}).Name()
+	ServiceAccountTokenKind   = reflect.TypeOf(ServiceAccountToken{}).Name()
+	WireGuardKeyKind          = reflect.TypeOf(WireGuardKey{}).Name()
+	AgeKeyKind                = reflect.TypeOf(AgeKey{}).Name()
+	DatabaseUserKind          = reflect.TypeOf(DatabaseUser{}).Name()
+	GitlabAccessTokenKind     = reflect.TypeOf(GitlabAccessToken{}).Name()
+	OAuth2TokenKind           = reflect.TypeOf(OAuth2Token{}).Name()
+	TransformKind             = reflect.TypeOf(Transform{}).Name()
 	WebhookKind               = reflect.TypeOf(Webhook{}).Name()
 	FakeKind                  = reflect.TypeOf(Fake{}).Name()
 	VaultDynamicSecretKind    = reflect.TypeOf(VaultDynamicSecret{}).Name()
@@ -87,4 +95,12 @@ func init() {
 	SchemeBuilder.Register(&Webhook{}, &WebhookList{})
 	SchemeBuilder.Register(&Grafana{}, &GrafanaList{})
 	SchemeBuilder.Register(&MFA{}, &MFAList{})
+	SchemeBuilder.Register(&Certificate{}, &CertificateList{})
+	SchemeBuilder.Register(&ServiceAccountToken{}, &ServiceAccountTokenList{})
+	SchemeBuilder.Register(&WireGuardKey{}, &WireGuardKeyList{})
+	SchemeBuilder.Register(&AgeKey{}, &AgeKeyList{})
+	SchemeBuilder.Register(&DatabaseUser{}, &DatabaseUserList{})
+	SchemeBuilder.Register(&GitlabAccessToken{}, &GitlabAccessTokenList{})
+	SchemeBuilder.Register(&OAuth2Token{}, &OAuth2TokenList{})
+	SchemeBuilder.Register(&Transform{}, &TransformList{})
 }
@@ -51,6 +51,11 @@ type ACRAccessTokenSpec struct {
 	// PublicCloud, USGovernmentCloud, ChinaCloud, GermanCloud
 	// +kubebuilder:default=PublicCloud
 	EnvironmentType esv1.AzureEnvironmentType `json:"environmentType,omitempty"`
+
+	// Inputs makes the values of other generators available to this generator
+	// as if they were Secrets.
+	// +optional
+	Inputs []GeneratorInput `json:"inputs,omitempty"`
 }
 
 type ACRAuth struct {
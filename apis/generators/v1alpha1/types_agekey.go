@@ -0,0 +1,55 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// AgeKeySpec controls the behavior of the age key generator.
+type AgeKeySpec struct {
+	// SSHKeySecretRef references an ed25519 private key in OpenSSH format.
+	// If set, the key is converted to an age identity like ssh-to-age does,
+	// instead of generating a new identity.
+	// +optional
+	SSHKeySecretRef *SecretKeySelector `json:"sshKeySecretRef,omitempty"`
+
+	// Inputs makes the values of other generators available to this generator
+	// as if they were Secrets.
+	// +optional
+	Inputs []GeneratorInput `json:"inputs,omitempty"`
+}
+
+// AgeKey generates an age X25519 identity and its recipient.
+// +kubebuilder:object:root=true
+// +kubebuilder:storageversion
+// +kubebuilder:subresource:status
+// +kubebuilder:metadata:labels="external-secrets.io/component=controller"
+// +kubebuilder:resource:scope=Namespaced,categories={external-secrets, external-secrets-generators}
+type AgeKey struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec AgeKeySpec `json:"spec,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// AgeKeyList contains a list of AgeKey resources.
+type AgeKeyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []AgeKey `json:"items"`
+}
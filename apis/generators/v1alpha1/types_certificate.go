@@ -0,0 +1,153 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// CertificateSpec controls the behavior of the certificate generator.
+type CertificateSpec struct {
+	// KeyAlgorithm specifies the algorithm of the private key (RSA, ECDSA, Ed25519)
+	// +kubebuilder:validation:Enum=RSA;ECDSA;Ed25519
+	// +kubebuilder:default="ECDSA"
+	KeyAlgorithm string `json:"keyAlgorithm,omitempty"`
+
+	// KeySize specifies the size of the private key.
+	// For RSA keys: 2048, 3072, 4096 (default: 2048)
+	// For ECDSA keys: 256, 384, 521 (default: 256)
+	// Ignored for Ed25519 keys
+	// +optional
+	KeySize *int `json:"keySize,omitempty"`
+
+	// Subject is the distinguished name of the certificate.
+	// +optional
+	Subject *CertificateSubject `json:"subject,omitempty"`
+
+	// DNSNames is a list of DNS subjectAltNames.
+	// +optional
+	DNSNames []string `json:"dnsNames,omitempty"`
+
+	// IPAddresses is a list of IP address subjectAltNames.
+	// +optional
+	IPAddresses []string `json:"ipAddresses,omitempty"`
+
+	// URIs is a list of URI subjectAltNames.
+	// +optional
+	URIs []string `json:"uris,omitempty"`
+
+	// EmailAddresses is a list of email subjectAltNames.
+	// +optional
+	EmailAddresses []string `json:"emailAddresses,omitempty"`
+
+	// Usages is the set of key usages and extended key usages of the certificate.
+	// Defaults to digital signature, and key encipherment for RSA keys.
+	// +optional
+	Usages []KeyUsage `json:"usages,omitempty"`
+
+	// IsCA marks the certificate as a certificate authority.
+	// The cert sign usage is added automatically.
+	// +optional
+	IsCA bool `json:"isCA,omitempty"`
+
+	// Duration is the lifetime of the certificate (default: 2160h).
+	// +optional
+	Duration *metav1.Duration `json:"duration,omitempty"`
+
+	// RenewBeforePercentage is the percentage of the lifetime of the certificate
+	// that is left when it is renewed (default: 33).
+	// The certificate is only renewed in an ExternalSecret with refreshPolicy Periodic.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=99
+	// +optional
+	RenewBeforePercentage *int32 `json:"renewBeforePercentage,omitempty"`
+
+	// CA references the certificate and private key used to sign the certificate.
+	// If it is not set the certificate is self-signed.
+	// +optional
+	CA *CertificateCA `json:"ca,omitempty"`
+
+	// Inputs makes the values of other generators available to this generator
+	// as if they were Secrets.
+	// +optional
+	Inputs []GeneratorInput `json:"inputs,omitempty"`
+}
+
+// CertificateSubject is the distinguished name of a certificate.
+type CertificateSubject struct {
+	// +optional
+	CommonName string `json:"commonName,omitempty"`
+	// +optional
+	Organizations []string `json:"organizations,omitempty"`
+	// +optional
+	OrganizationalUnits []string `json:"organizationalUnits,omitempty"`
+	// +optional
+	Countries []string `json:"countries,omitempty"`
+	// +optional
+	Provinces []string `json:"provinces,omitempty"`
+	// +optional
+	Localities []string `json:"localities,omitempty"`
+}
+
+// CertificateCA references the certificate authority used to sign a certificate.
+type CertificateCA struct {
+	// CertSecretRef references the PEM encoded certificate of the CA.
+	// Further certificates in the same key are added to ca.crt.
+	CertSecretRef SecretKeySelector `json:"certSecretRef"`
+
+	// KeySecretRef references the PEM encoded private key of the CA.
+	KeySecretRef SecretKeySelector `json:"keySecretRef"`
+}
+
+// KeyUsage is a key usage or extended key usage of a certificate.
+// +kubebuilder:validation:Enum="digital signature";"content commitment";"key encipherment";"data encipherment";"key agreement";"cert sign";"crl sign";"server auth";"client auth";"code signing";"email protection";"ocsp signing"
+type KeyUsage string
+
+const (
+	KeyUsageDigitalSignature  KeyUsage = "digital signature"
+	KeyUsageContentCommitment KeyUsage = "content commitment"
+	KeyUsageKeyEncipherment   KeyUsage = "key encipherment"
+	KeyUsageDataEncipherment  KeyUsage = "data encipherment"
+	KeyUsageKeyAgreement      KeyUsage = "key agreement"
+	KeyUsageCertSign          KeyUsage = "cert sign"
+	KeyUsageCRLSign           KeyUsage = "crl sign"
+	KeyUsageServerAuth        KeyUsage = "server auth"
+	KeyUsageClientAuth        KeyUsage = "client auth"
+	KeyUsageCodeSigning       KeyUsage = "code signing"
+	KeyUsageEmailProtection   KeyUsage = "email protection"
+	KeyUsageOCSPSigning       KeyUsage = "ocsp signing"
+)
+
+// Certificate generates X.509 certificates and their private keys.
+// +kubebuilder:object:root=true
+// +kubebuilder:storageversion
+// +kubebuilder:subresource:status
+// +kubebuilder:metadata:labels="external-secrets.io/component=controller"
+// +kubebuilder:resource:scope=Namespaced,categories={external-secrets, external-secrets-generators}
+type Certificate struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec CertificateSpec `json:"spec,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// CertificateList contains a list of Certificate resources.
+type CertificateList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Certificate `json:"items"`
+}
@@ -27,7 +27,7 @@ type ClusterGeneratorSpec struct {
 }
 
 // GeneratorKind represents a kind of generator.
-// +kubebuilder:validation:Enum=ACRAccessToken;ECRAuthorizationToken;Fake;GCRAccessToken;GithubAccessToken;QuayAccessToken;Password;SSHKey;STSSessionToken;UUID;VaultDynamicSecret;Webhook;Grafana
+// +kubebuilder:validation:Enum=ACRAccessToken;ECRAuthorizationToken;Fake;GCRAccessToken;GithubAccessToken;QuayAccessToken;Password;SSHKey;STSSessionToken;UUID;VaultDynamicSecret;Webhook;Grafana;Certificate;ServiceAccountToken;WireGuardKey;AgeKey;DatabaseUser;GitlabAccessToken;OAuth2Token;Transform
 type GeneratorKind string
 
 const (
@@ -45,6 +45,14 @@ const (
 	GeneratorKindWebhook               GeneratorKind = "Webhook"
 	GeneratorKindGrafana               GeneratorKind = "Grafana"
 	GeneratorKindMFA                   GeneratorKind = "MFA"
+	GeneratorKindCertificate           GeneratorKind = "Certificate"
+	GeneratorKindServiceAccountToken   GeneratorKind = "ServiceAccountToken"
+	GeneratorKindWireGuardKey          GeneratorKind = "WireGuardKey"
+	GeneratorKindAgeKey                GeneratorKind = "AgeKey"
+	GeneratorKindDatabaseUser          GeneratorKind = "DatabaseUser"
+	GeneratorKindGitlabAccessToken     GeneratorKind = "GitlabAccessToken"
+	GeneratorKindOAuth2Token           GeneratorKind = "OAuth2Token"
+	GeneratorKindTransform             GeneratorKind = "Transform"
 )
 
 // +kubebuilder:validation:MaxProperties=1
@@ -64,6 +72,14 @@ type GeneratorSpec struct {
 	WebhookSpec               *WebhookSpec               `json:"webhookSpec,omitempty"`
 	GrafanaSpec               *GrafanaSpec               `json:"grafanaSpec,omitempty"`
 	MFASpec                   *MFASpec                   `json:"mfaSpec,omitempty"`
+	CertificateSpec           *CertificateSpec           `json:"certificateSpec,omitempty"`
+	ServiceAccountTokenSpec   *ServiceAccountTokenSpec   `json:"serviceAccountTokenSpec,omitempty"`
+	WireGuardKeySpec          *WireGuardKeySpec          `json:"wireGuardKeySpec,omitempty"`
+	AgeKeySpec                *AgeKeySpec                `json:"ageKeySpec,omitempty"`
+	DatabaseUserSpec          *DatabaseUserSpec          `json:"databaseUserSpec,omitempty"`
+	GitlabAccessTokenSpec     *GitlabAccessTokenSpec     `json:"gitlabAccessTokenSpec,omitempty"`
+	OAuth2TokenSpec           *OAuth2TokenSpec           `json:"oauth2TokenSpec,omitempty"`
+	TransformSpec             *TransformSpec             `json:"transformSpec,omitempty"`
 }
 
 // ClusterGenerator represents a cluster-wide generator which can be referenced as part of `generatorRef` fields.
@@ -0,0 +1,151 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	esv1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1"
+)
+
+// DatabaseUserSpec controls the behavior of the database user generator.
+type DatabaseUserSpec struct {
+	// Driver is the type of the database server.
+	Driver DatabaseDriver `json:"driver"`
+
+	// Host is the hostname or IP address of the database server.
+	Host string `json:"host"`
+
+	// Port of the database server.
+	// Defaults to 5432 for PostgreSQL and 3306 for MySQL.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+	// +optional
+	Port *int32 `json:"port,omitempty"`
+
+	// Database to connect to.
+	// It is available as `.Database` in the statements.
+	// +optional
+	Database string `json:"database,omitempty"`
+
+	// SSLMode controls the TLS connection to the database server.
+	// +kubebuilder:default="verify-full"
+	// +optional
+	SSLMode DatabaseSSLMode `json:"sslMode,omitempty"`
+
+	// PEM encoded CA bundle used to validate the certificate of the database server
+	// if the SSLMode is verify-full. If not set the system root certificates are used.
+	// +optional
+	CABundle []byte `json:"caBundle,omitempty"`
+
+	// The provider for the CA bundle to use to validate the certificate of the database server.
+	// +optional
+	CAProvider *esv1.CAProvider `json:"caProvider,omitempty"`
+
+	// Auth contains the credentials of an admin user that
+	// is allowed to create and drop users.
+	Auth DatabaseUserAuth `json:"auth"`
+
+	// UsernamePrefix is prepended to the random part of the generated username.
+	// +kubebuilder:default="eso_"
+	// +kubebuilder:validation:MaxLength=16
+	// +kubebuilder:validation:Pattern=`^[a-z_][a-z0-9_]*$`
+	// +optional
+	UsernamePrefix *string `json:"usernamePrefix,omitempty"`
+
+	// PasswordLength is the length of the generated password.
+	// +kubebuilder:default=32
+	// +kubebuilder:validation:Minimum=16
+	// +kubebuilder:validation:Maximum=128
+	// +optional
+	PasswordLength *int `json:"passwordLength,omitempty"`
+
+	// Roles are granted to the generated user.
+	// +optional
+	Roles []string `json:"roles,omitempty"`
+
+	// Statements are run after the user has been created, e.g. to grant privileges.
+	// They are Go templates with the fields `.Username`, `.Password` and `.Database`.
+	// +optional
+	Statements []string `json:"statements,omitempty"`
+
+	// CleanupStatements are run before the user is dropped.
+	// They are Go templates with the fields `.Username` and `.Database`.
+	// +optional
+	CleanupStatements []string `json:"cleanupStatements,omitempty"`
+
+	// Inputs makes the values of other generators available to this generator
+	// as if they were Secrets.
+	// +optional
+	Inputs []GeneratorInput `json:"inputs,omitempty"`
+}
+
+// DatabaseDriver is the type of the database server.
+// +kubebuilder:validation:Enum=postgres;mysql
+type DatabaseDriver string
+
+const (
+	DatabaseDriverPostgres DatabaseDriver = "postgres"
+	DatabaseDriverMySQL    DatabaseDriver = "mysql"
+)
+
+// DatabaseSSLMode controls the TLS connection to the database server.
+// +kubebuilder:validation:Enum=disable;require;verify-full
+type DatabaseSSLMode string
+
+const (
+	// DatabaseSSLModeDisable connects without TLS.
+	DatabaseSSLModeDisable DatabaseSSLMode = "disable"
+	// DatabaseSSLModeRequire connects with TLS but does not verify the server certificate.
+	DatabaseSSLModeRequire DatabaseSSLMode = "require"
+	// DatabaseSSLModeVerifyFull connects with TLS and verifies the server certificate and hostname.
+	DatabaseSSLModeVerifyFull DatabaseSSLMode = "verify-full"
+)
+
+type DatabaseUserAuth struct {
+	// Username of the admin user.
+	Username string `json:"username"`
+	// Password of the admin user.
+	Password SecretKeySelector `json:"password"`
+}
+
+// DatabaseUserState is the state type produced by the DatabaseUser generator.
+// It contains the name of the generated user, which is dropped on cleanup.
+type DatabaseUserState struct {
+	Username string `json:"username"`
+}
+
+// DatabaseUser generates a user with a random password in a PostgreSQL or MySQL database.
+// The user is dropped once the generated value is garbage collected.
+// +kubebuilder:object:root=true
+// +kubebuilder:storageversion
+// +kubebuilder:subresource:status
+// +kubebuilder:metadata:labels="external-secrets.io/component=controller"
+// +kubebuilder:resource:scope=Namespaced,categories={external-secrets, external-secrets-generators}
+type DatabaseUser struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec DatabaseUserSpec `json:"spec,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// DatabaseUserList contains a list of DatabaseUser resources.
+type DatabaseUserList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []DatabaseUser `json:"items"`
+}
@@ -37,6 +37,11 @@ type ECRAuthorizationTokenSpec struct {
 	// Valid options are private and public.
 	// +optional
 	Scope string `json:"scope,omitempty"`
+
+	// Inputs makes the values of other generators available to this generator
+	// as if they were Secrets.
+	// +optional
+	Inputs []GeneratorInput `json:"inputs,omitempty"`
 }
 
 // AWSAuth tells the controller how to do authentication with aws.
@@ -25,6 +25,11 @@ type GCRAccessTokenSpec struct {
 	Auth GCPSMAuth `json:"auth"`
 	// ProjectID defines which project to use to authenticate with
 	ProjectID string `json:"projectID"`
+
+	// Inputs makes the values of other generators available to this generator
+	// as if they were Secrets.
+	// +optional
+	Inputs []GeneratorInput `json:"inputs,omitempty"`
 }
 
 type GCPSMAuth struct {
@@ -22,16 +22,52 @@ import (
 
 type GithubAccessTokenSpec struct {
 	// URL configures the Github instance URL. Defaults to https://github.com/.
-	URL       string `json:"url,omitempty"`
-	AppID     string `json:"appID"`
-	InstallID string `json:"installID"`
+	URL   string `json:"url,omitempty"`
+	AppID string `json:"appID"`
+	// InstallID is the ID of the installation of the GitHub App.
+	// If omitted, it is looked up with installation.
+	// +optional
+	InstallID string `json:"installID,omitempty"`
+	// Installation looks up the installID by the organization, user or repository
+	// the GitHub App is installed to. It is only used if installID is omitted.
+	// +optional
+	Installation *GithubInstallation `json:"installation,omitempty"`
 	// List of repositories the token will have access to. If omitted, defaults to all repositories the GitHub App
 	// is installed to.
 	Repositories []string `json:"repositories,omitempty"`
+	// List of IDs of repositories the token will have access to.
+	// Can be combined with repositories.
+	// +optional
+	RepositoryIDs []int64 `json:"repositoryIDs,omitempty"`
 	// Map of permissions the token will have. If omitted, defaults to all permissions the GitHub App has.
 	Permissions map[string]string `json:"permissions,omitempty"`
 	// Auth configures how ESO authenticates with a Github instance.
 	Auth GithubAuth `json:"auth"`
+
+	// Inputs makes the values of other generators available to this generator
+	// as if they were Secrets.
+	// +optional
+	Inputs []GeneratorInput `json:"inputs,omitempty"`
+}
+
+// GithubInstallation identifies an installation of a GitHub App.
+// Exactly one of the fields must be set.
+type GithubInstallation struct {
+	// Organization the GitHub App is installed to.
+	// +optional
+	Organization string `json:"organization,omitempty"`
+	// User the GitHub App is installed to.
+	// +optional
+	User string `json:"user,omitempty"`
+	// Repository the GitHub App is installed to, in the form owner/name.
+	// +optional
+	Repository string `json:"repository,omitempty"`
+}
+
+// GithubAccessTokenState is the state type produced by the GithubAccessToken generator.
+// It contains the installation access token, which is revoked on cleanup.
+type GithubAccessTokenState struct {
+	Token string `json:"token"`
 }
 
 type GithubAuth struct {
@@ -0,0 +1,149 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	esv1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1"
+)
+
+// GitlabAccessTokenSpec controls the behavior of the GitLab access token generator.
+type GitlabAccessTokenSpec struct {
+	// URL configures the GitLab instance URL. Defaults to https://gitlab.com/.
+	// +optional
+	URL string `json:"url,omitempty"`
+
+	// Base64 encoded certificate for the GitLab server.
+	// +optional
+	CABundle []byte `json:"caBundle,omitempty"`
+	// see: https://external-secrets.io/latest/spec/#external-secrets.io/v1alpha1.CAProvider
+	// +optional
+	CAProvider *esv1.CAProvider `json:"caProvider,omitempty"`
+
+	// Auth configures how ESO authenticates with the GitLab instance.
+	Auth GitlabAccessTokenAuth `json:"auth"`
+
+	// Type of the access token.
+	// Project and group tokens belong to a bot user of the project or group,
+	// personal tokens can only be created by administrators.
+	Type GitlabAccessTokenType `json:"type"`
+
+	// ProjectID is the ID or the path of the project. Required for project tokens.
+	// +optional
+	ProjectID string `json:"projectID,omitempty"`
+
+	// GroupID is the ID or the path of the group. Required for group tokens.
+	// +optional
+	GroupID string `json:"groupID,omitempty"`
+
+	// UserID is the ID of the user. Required for personal tokens.
+	// +optional
+	UserID *int `json:"userID,omitempty"`
+
+	// Name of the access token.
+	// +kubebuilder:default="external-secrets"
+	// +optional
+	Name string `json:"name,omitempty"`
+
+	// Description of the access token.
+	// +optional
+	Description string `json:"description,omitempty"`
+
+	// Scopes of the access token, e.g. read_api or read_registry.
+	// +kubebuilder:validation:MinItems=1
+	Scopes []string `json:"scopes"`
+
+	// AccessLevel of the bot user of project and group tokens.
+	// Defaults to maintainer.
+	// +optional
+	AccessLevel GitlabAccessLevel `json:"accessLevel,omitempty"`
+
+	// ExpiresAfter is the lifetime of the access token.
+	// GitLab expires tokens at the start of a day, so it is rounded up to whole days.
+	// +kubebuilder:default="720h"
+	// +optional
+	ExpiresAfter *metav1.Duration `json:"expiresAfter,omitempty"`
+
+	// RenewBeforePercentage is the percentage of the lifetime of the access token
+	// that is left when it is renewed (default: 20).
+	// The access token is only renewed in an ExternalSecret with refreshPolicy Periodic.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=99
+	// +optional
+	RenewBeforePercentage *int32 `json:"renewBeforePercentage,omitempty"`
+
+	// Inputs makes the values of other generators available to this generator
+	// as if they were Secrets.
+	// +optional
+	Inputs []GeneratorInput `json:"inputs,omitempty"`
+}
+
+// +kubebuilder:validation:Enum=project;group;personal
+type GitlabAccessTokenType string
+
+const (
+	GitlabAccessTokenTypeProject  GitlabAccessTokenType = "project"
+	GitlabAccessTokenTypeGroup    GitlabAccessTokenType = "group"
+	GitlabAccessTokenTypePersonal GitlabAccessTokenType = "personal"
+)
+
+// +kubebuilder:validation:Enum=guest;reporter;developer;maintainer;owner
+type GitlabAccessLevel string
+
+const (
+	GitlabAccessLevelGuest      GitlabAccessLevel = "guest"
+	GitlabAccessLevelReporter   GitlabAccessLevel = "reporter"
+	GitlabAccessLevelDeveloper  GitlabAccessLevel = "developer"
+	GitlabAccessLevelMaintainer GitlabAccessLevel = "maintainer"
+	GitlabAccessLevelOwner      GitlabAccessLevel = "owner"
+)
+
+type GitlabAccessTokenAuth struct {
+	// AccessToken is used to create the access token. It needs the api scope.
+	AccessToken SecretKeySelector `json:"accessToken"`
+}
+
+// GitlabAccessTokenState is the state type produced by the GitlabAccessToken generator.
+// It identifies the access token, which is revoked on cleanup.
+type GitlabAccessTokenState struct {
+	ID        int                   `json:"id"`
+	Type      GitlabAccessTokenType `json:"type"`
+	ProjectID string                `json:"projectID,omitempty"`
+	GroupID   string                `json:"groupID,omitempty"`
+}
+
+// GitlabAccessToken generates project, group or personal access tokens.
+// The access token is revoked once the generated value is garbage collected.
+// +kubebuilder:object:root=true
+// +kubebuilder:storageversion
+// +kubebuilder:subresource:status
+// +kubebuilder:metadata:labels="external-secrets.io/component=controller"
+// +kubebuilder:resource:scope=Namespaced,categories={external-secrets, external-secrets-generators}
+type GitlabAccessToken struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec GitlabAccessTokenSpec `json:"spec,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// GitlabAccessTokenList contains a list of GitlabAccessToken resources.
+type GitlabAccessTokenList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []GitlabAccessToken `json:"items"`
+}
@@ -28,6 +28,11 @@ type GrafanaSpec struct {
 	// ServiceAccount is the configuration for the service account that
 	// is supposed to be generated by the generator.
 	ServiceAccount GrafanaServiceAccount `json:"serviceAccount"`
+
+	// Inputs makes the values of other generators available to this generator
+	// as if they were Secrets.
+	// +optional
+	Inputs []GeneratorInput `json:"inputs,omitempty"`
 }
 
 type GrafanaServiceAccount struct {
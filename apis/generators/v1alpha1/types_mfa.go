@@ -31,6 +31,11 @@ type MFASpec struct {
 	Algorithm string `json:"algorithm,omitempty"`
 	// When defines a time parameter that can be used to pin the origin time of the generated token.
 	When *metav1.Time `json:"when,omitempty"`
+
+	// Inputs makes the values of other generators available to this generator
+	// as if they were Secrets.
+	// +optional
+	Inputs []GeneratorInput `json:"inputs,omitempty"`
 }
 
 // MFA generates a new TOTP token that is compliant with RFC 6238.
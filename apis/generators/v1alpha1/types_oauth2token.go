@@ -0,0 +1,134 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	esv1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1"
+)
+
+// OAuth2TokenSpec controls the behavior of the OAuth2 token generator.
+type OAuth2TokenSpec struct {
+	// TokenURL is the URL of the token endpoint of the authorization server.
+	TokenURL string `json:"tokenURL"`
+
+	// ClientID is the ID of the OAuth2 client.
+	ClientID string `json:"clientID"`
+
+	// Auth configures how the client authenticates with the token endpoint.
+	Auth OAuth2ClientAuth `json:"auth"`
+
+	// Scopes requested for the access token.
+	// +optional
+	Scopes []string `json:"scopes,omitempty"`
+
+	// Audience of the access token, sent as the audience parameter.
+	// +optional
+	Audience string `json:"audience,omitempty"`
+
+	// Parameters are added to the token request, e.g. resource.
+	// +optional
+	Parameters map[string]string `json:"parameters,omitempty"`
+
+	// RenewBeforePercentage is the percentage of the lifetime of the access token
+	// that is left when it is renewed (default: 20).
+	// The access token is only renewed in an ExternalSecret with refreshPolicy Periodic.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=99
+	// +optional
+	RenewBeforePercentage *int32 `json:"renewBeforePercentage,omitempty"`
+
+	// PEM encoded CA bundle used to validate the certificate of the authorization server.
+	// If not set the system root certificates are used.
+	// +optional
+	CABundle []byte `json:"caBundle,omitempty"`
+
+	// The provider for the CA bundle to use to validate the certificate of the authorization server.
+	// +optional
+	CAProvider *esv1.CAProvider `json:"caProvider,omitempty"`
+
+	// Inputs makes the values of other generators available to this generator
+	// as if they were Secrets.
+	// +optional
+	Inputs []GeneratorInput `json:"inputs,omitempty"`
+}
+
+// OAuth2ClientAuth configures the client authentication method. Only one method may be set.
+// +kubebuilder:validation:MinProperties=1
+// +kubebuilder:validation:MaxProperties=1
+type OAuth2ClientAuth struct {
+	// ClientSecretBasic sends the client secret in the Authorization header.
+	// +optional
+	ClientSecretBasic *SecretKeySelector `json:"clientSecretBasic,omitempty"`
+
+	// ClientSecretPost sends the client secret in the request body.
+	// +optional
+	ClientSecretPost *SecretKeySelector `json:"clientSecretPost,omitempty"`
+
+	// PrivateKeyJWT authenticates with a JWT that is signed by the private key of the client,
+	// as described in RFC 7523.
+	// +optional
+	PrivateKeyJWT *OAuth2PrivateKeyJWT `json:"privateKeyJWT,omitempty"`
+
+	// TLSClientAuth authenticates with a client certificate,
+	// as described in RFC 8705.
+	// +optional
+	TLSClientAuth *OAuth2TLSClientAuth `json:"tlsClientAuth,omitempty"`
+}
+
+type OAuth2PrivateKeyJWT struct {
+	// PrivateKey is a PEM encoded RSA, ECDSA or Ed25519 private key.
+	// The signing algorithm is derived from the type of the key.
+	PrivateKey SecretKeySelector `json:"privateKey"`
+
+	// KeyID is set as the kid header of the JWT.
+	// +optional
+	KeyID string `json:"keyID,omitempty"`
+
+	// Audience of the JWT. Defaults to the token URL.
+	// +optional
+	Audience string `json:"audience,omitempty"`
+}
+
+type OAuth2TLSClientAuth struct {
+	// Certificate is the PEM encoded client certificate.
+	Certificate SecretKeySelector `json:"certificate"`
+
+	// PrivateKey is the PEM encoded private key of the client certificate.
+	PrivateKey SecretKeySelector `json:"privateKey"`
+}
+
+// OAuth2Token generates access tokens with the OAuth2 client credentials grant.
+// +kubebuilder:object:root=true
+// +kubebuilder:storageversion
+// +kubebuilder:subresource:status
+// +kubebuilder:metadata:labels="external-secrets.io/component=controller"
+// +kubebuilder:resource:scope=Namespaced,categories={external-secrets, external-secrets-generators}
+type OAuth2Token struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec OAuth2TokenSpec `json:"spec,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// OAuth2TokenList contains a list of OAuth2Token resources.
+type OAuth2TokenList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []OAuth2Token `json:"items"`
+}
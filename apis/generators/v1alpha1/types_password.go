@@ -44,6 +44,100 @@ type PasswordSpec struct {
 	// set AllowRepeat to true to allow repeating characters.
 	// +kubebuilder:default=false
 	AllowRepeat bool `json:"allowRepeat"`
+
+	// MinLowercase specifies the minimum number of lowercase characters
+	// in the generated password.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	MinLowercase *int `json:"minLowercase,omitempty"`
+
+	// MinUppercase specifies the minimum number of uppercase characters
+	// in the generated password.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	MinUppercase *int `json:"minUppercase,omitempty"`
+
+	// LowercaseCharacters specifies the lowercase characters that should be used
+	// in the generated password. Defaults to a-z.
+	// +optional
+	LowercaseCharacters *string `json:"lowercaseCharacters,omitempty"`
+
+	// UppercaseCharacters specifies the uppercase characters that should be used
+	// in the generated password. Defaults to A-Z.
+	// +optional
+	UppercaseCharacters *string `json:"uppercaseCharacters,omitempty"`
+
+	// DigitCharacters specifies the digits that should be used
+	// in the generated password. Defaults to 0-9.
+	// +optional
+	DigitCharacters *string `json:"digitCharacters,omitempty"`
+
+	// ExcludeCharacters specifies characters that must not appear in the
+	// generated password, e.g. ambiguous characters like "0O1lI".
+	// +optional
+	ExcludeCharacters *string `json:"excludeCharacters,omitempty"`
+
+	// FirstCharacter restricts the first character of the generated password
+	// to the given character classes, e.g. to avoid a leading digit.
+	// +optional
+	FirstCharacter []PasswordCharacterClass `json:"firstCharacter,omitempty"`
+
+	// Passphrase generates a diceware style passphrase instead of a password.
+	// All other settings are ignored if set.
+	// +optional
+	Passphrase *PassphraseSpec `json:"passphrase,omitempty"`
+}
+
+// PasswordCharacterClass is a class of characters of a generated password.
+// +kubebuilder:validation:Enum=lowercase;uppercase;digit;symbol
+type PasswordCharacterClass string
+
+const (
+	PasswordCharacterClassLowercase PasswordCharacterClass = "lowercase"
+	PasswordCharacterClassUppercase PasswordCharacterClass = "uppercase"
+	PasswordCharacterClassDigit     PasswordCharacterClass = "digit"
+	PasswordCharacterClassSymbol    PasswordCharacterClass = "symbol"
+)
+
+// PassphraseWordList is an embedded word list for passphrases.
+// +kubebuilder:validation:Enum=eff-large;eff-short
+type PassphraseWordList string
+
+const (
+	// PassphraseWordListEFFLarge is the EFF large word list with 7776 words.
+	PassphraseWordListEFFLarge PassphraseWordList = "eff-large"
+	// PassphraseWordListEFFShort is the EFF short word list with 1296 words.
+	PassphraseWordListEFFShort PassphraseWordList = "eff-short"
+)
+
+// PassphraseSpec controls the generation of passphrases.
+type PassphraseSpec struct {
+	// Words is the number of words in the passphrase.
+	// Defaults to 6
+	// +kubebuilder:default=6
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=100
+	Words int `json:"words,omitempty"`
+
+	// Separator is placed between the words.
+	// Defaults to "-"
+	// +kubebuilder:default="-"
+	// +optional
+	Separator *string `json:"separator,omitempty"`
+
+	// WordList is the embedded word list the words are picked from.
+	// Defaults to eff-large
+	// +kubebuilder:default="eff-large"
+	// +optional
+	WordList PassphraseWordList `json:"wordList,omitempty"`
+
+	// Set Capitalize to capitalize the first letter of every word.
+	// +optional
+	Capitalize bool `json:"capitalize,omitempty"`
+
+	// Set IncludeNumber to append a random digit to one of the words.
+	// +optional
+	IncludeNumber bool `json:"includeNumber,omitempty"`
 }
 
 // Password generates a random password based on the
@@ -0,0 +1,117 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	esv1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1"
+	esmeta "github.com/external-secrets/external-secrets/apis/meta/v1"
+)
+
+// ServiceAccountTokenSpec controls the behavior of the ServiceAccountToken generator.
+type ServiceAccountTokenSpec struct {
+	// ServiceAccountRef references the ServiceAccount to request a token for.
+	// The ServiceAccount must reside in the namespace of the ExternalSecret,
+	// the namespace of the reference is only used for remote clusters.
+	ServiceAccountRef esmeta.ServiceAccountSelector `json:"serviceAccountRef"`
+
+	// ExpirationSeconds is the requested validity of the token.
+	// The API server may issue a token with a different validity.
+	// Defaults to 3600
+	// +kubebuilder:default=3600
+	// +kubebuilder:validation:Minimum=600
+	// +optional
+	ExpirationSeconds *int64 `json:"expirationSeconds,omitempty"`
+
+	// RenewBeforePercentage is the percentage of the lifetime of the token
+	// that is left when it is renewed (default: 20).
+	// The token is only renewed in an ExternalSecret with refreshPolicy Periodic.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=99
+	// +optional
+	RenewBeforePercentage *int32 `json:"renewBeforePercentage,omitempty"`
+
+	// RemoteCluster configures the cluster to request the token from.
+	// If omitted the token is requested from the cluster the controller runs in.
+	// +optional
+	RemoteCluster *ServiceAccountTokenCluster `json:"remoteCluster,omitempty"`
+
+	// Kubeconfig adds a kubeconfig that uses the token to the output.
+	// +optional
+	Kubeconfig *ServiceAccountTokenKubeconfig `json:"kubeconfig,omitempty"`
+
+	// Inputs makes the values of other generators available to this generator
+	// as if they were Secrets.
+	// +optional
+	Inputs []GeneratorInput `json:"inputs,omitempty"`
+}
+
+// ServiceAccountTokenCluster configures a remote cluster
+// like the Kubernetes provider of a SecretStore.
+type ServiceAccountTokenCluster struct {
+	// configures the Kubernetes server Address.
+	// +optional
+	Server esv1.KubernetesServer `json:"server,omitempty"`
+
+	// Auth configures how to authenticate with the remote cluster.
+	// +optional
+	Auth *esv1.KubernetesAuth `json:"auth,omitempty"`
+
+	// A reference to a secret that contains a kubeconfig for the remote cluster.
+	// +optional
+	AuthRef *esmeta.SecretKeySelector `json:"authRef,omitempty"`
+}
+
+// ServiceAccountTokenKubeconfig controls the generated kubeconfig.
+type ServiceAccountTokenKubeconfig struct {
+	// Server is the URL of the API server in the kubeconfig.
+	// Defaults to the URL the token was requested from.
+	// +optional
+	Server string `json:"server,omitempty"`
+
+	// CABundle is the PEM encoded CA of the API server in the kubeconfig.
+	// Defaults to the CA of the cluster the token was requested from.
+	// +optional
+	CABundle []byte `json:"caBundle,omitempty"`
+
+	// ClusterName is the name of the cluster and context in the kubeconfig.
+	// Defaults to "default"
+	// +optional
+	ClusterName string `json:"clusterName,omitempty"`
+}
+
+// ServiceAccountToken generates a token for a Kubernetes ServiceAccount
+// using the TokenRequest API.
+// +kubebuilder:object:root=true
+// +kubebuilder:storageversion
+// +kubebuilder:subresource:status
+// +kubebuilder:metadata:labels="external-secrets.io/component=controller"
+// +kubebuilder:resource:scope=Namespaced,categories={external-secrets, external-secrets-generators}
+type ServiceAccountToken struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec ServiceAccountTokenSpec `json:"spec,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ServiceAccountTokenList contains a list of ServiceAccountToken resources.
+type ServiceAccountTokenList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ServiceAccountToken `json:"items"`
+}
@@ -20,13 +20,14 @@ import (
 
 // SSHKeySpec controls the behavior of the ssh key generator.
 type SSHKeySpec struct {
-	// KeyType specifies the SSH key type (rsa, ed25519)
-	// +kubebuilder:validation:Enum=rsa;ed25519
+	// KeyType specifies the SSH key type (rsa, ecdsa, ed25519)
+	// +kubebuilder:validation:Enum=rsa;ecdsa;ed25519
 	// +kubebuilder:default="rsa"
 	KeyType string `json:"keyType,omitempty"`
 
-	// KeySize specifies the key size for RSA keys (default: 2048)
-	// For RSA keys: 2048, 3072, 4096
+	// KeySize specifies the key size for RSA and ECDSA keys
+	// For RSA keys: 2048, 3072, 4096 (default: 2048)
+	// For ECDSA keys: 256, 384, 521 (default: 256)
 	// Ignored for ed25519 keys
 	// +kubebuilder:validation:Minimum=256
 	// +kubebuilder:validation:Maximum=8192
@@ -34,6 +35,63 @@ type SSHKeySpec struct {
 
 	// Comment specifies an optional comment for the SSH key
 	Comment string `json:"comment,omitempty"`
+
+	// Certificate signs the generated public key with an SSH certificate authority.
+	// +optional
+	Certificate *SSHCertificateSpec `json:"certificate,omitempty"`
+
+	// Inputs makes the values of other generators available to this generator
+	// as if they were Secrets.
+	// +optional
+	Inputs []GeneratorInput `json:"inputs,omitempty"`
+}
+
+// SSHCertificateSpec controls the OpenSSH certificate issued for the generated key.
+type SSHCertificateSpec struct {
+	// CAKeySecretRef references the private key of the SSH certificate authority
+	// in OpenSSH or PEM format.
+	CAKeySecretRef SecretKeySelector `json:"caKeySecretRef"`
+
+	// CertType specifies the certificate type (user, host)
+	// +kubebuilder:validation:Enum=user;host
+	// +kubebuilder:default="user"
+	CertType string `json:"certType,omitempty"`
+
+	// KeyID identifies the certificate in the logs of the SSH server.
+	// +optional
+	KeyID string `json:"keyID,omitempty"`
+
+	// Principals are the user names or host names the certificate is valid for.
+	// If empty, the certificate is valid for any principal.
+	// +optional
+	Principals []string `json:"principals,omitempty"`
+
+	// ValidFor is the validity period of the certificate (default: 24h).
+	// +optional
+	ValidFor *metav1.Duration `json:"validFor,omitempty"`
+
+	// RenewBeforePercentage is the percentage of the validity period of the certificate
+	// that is left when it is renewed (default: 33).
+	// The certificate is only renewed in an ExternalSecret with refreshPolicy Periodic.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=99
+	// +optional
+	RenewBeforePercentage *int32 `json:"renewBeforePercentage,omitempty"`
+
+	// ClockSkew is subtracted from the start of the validity period to tolerate
+	// clocks of SSH servers that are behind (default: 5m).
+	// +optional
+	ClockSkew *metav1.Duration `json:"clockSkew,omitempty"`
+
+	// CriticalOptions of the certificate, e.g. force-command or source-address.
+	// +optional
+	CriticalOptions map[string]string `json:"criticalOptions,omitempty"`
+
+	// Extensions of the certificate, e.g. permit-pty.
+	// Defaults to the extensions set by ssh-keygen for user certificates.
+	// Host certificates have no extensions.
+	// +optional
+	Extensions map[string]string `json:"extensions,omitempty"`
 }
 
 // SSHKey generates SSH key pairs.
@@ -56,6 +56,11 @@ type STSSessionTokenSpec struct {
 	// RequestParameters contains parameters that can be passed to the STS service.
 	// +optional
 	RequestParameters *RequestParameters `json:"requestParameters,omitempty"`
+
+	// Inputs makes the values of other generators available to this generator
+	// as if they were Secrets.
+	// +optional
+	Inputs []GeneratorInput `json:"inputs,omitempty"`
 }
 
 // STSSessionToken uses the GetSessionToken API to retrieve an authorization token.
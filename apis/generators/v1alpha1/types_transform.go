@@ -0,0 +1,56 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// TransformSpec controls the behavior of the transform generator.
+type TransformSpec struct {
+	// Inputs are the generators whose values are transformed.
+	// The values of an input are available in the templates
+	// as a map of the keys of the input, e.g. `.password.password`.
+	// +kubebuilder:validation:MinItems=1
+	Inputs []GeneratorInput `json:"inputs"`
+
+	// Data maps the keys of the output to templates, which are
+	// rendered with the functions of the template engine v2.
+	// +kubebuilder:validation:MinProperties=1
+	Data map[string]string `json:"data"`
+}
+
+// Transform renders the values of other generators with templates,
+// e.g. to hash a generated password or to convert a certificate.
+// +kubebuilder:object:root=true
+// +kubebuilder:storageversion
+// +kubebuilder:subresource:status
+// +kubebuilder:metadata:labels="external-secrets.io/component=controller"
+// +kubebuilder:resource:scope=Namespaced,categories={external-secrets, external-secrets-generators}
+type Transform struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec TransformSpec `json:"spec,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// TransformList contains a list of Transform resources.
+type TransformList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Transform `json:"items"`
+}
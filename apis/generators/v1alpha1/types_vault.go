@@ -56,6 +56,11 @@ type VaultDynamicSecretSpec struct {
 	// +optional
 	// +kubebuilder:default=false
 	AllowEmptyResponse bool `json:"allowEmptyResponse,omitempty"`
+
+	// Inputs makes the values of other generators available to this generator
+	// as if they were Secrets.
+	// +optional
+	Inputs []GeneratorInput `json:"inputs,omitempty"`
 }
 
 // +kubebuilder:validation:Enum=Data;Auth;Raw
@@ -0,0 +1,49 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// WireGuardKeySpec controls the behavior of the WireGuard key generator.
+type WireGuardKeySpec struct {
+	// PresharedKey adds a random preshared key to the output,
+	// which adds a layer of symmetric encryption to a peer.
+	// +optional
+	PresharedKey bool `json:"presharedKey,omitempty"`
+}
+
+// WireGuardKey generates a WireGuard (Curve25519) key pair.
+// +kubebuilder:object:root=true
+// +kubebuilder:storageversion
+// +kubebuilder:subresource:status
+// +kubebuilder:metadata:labels="external-secrets.io/component=controller"
+// +kubebuilder:resource:scope=Namespaced,categories={external-secrets, external-secrets-generators}
+type WireGuardKey struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec WireGuardKeySpec `json:"spec,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// WireGuardKeyList contains a list of WireGuardKey resources.
+type WireGuardKeyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []WireGuardKey `json:"items"`
+}
@@ -88,6 +88,11 @@ func (in *ACRAccessTokenList) DeepCopyObject() runtime.Object {
 func (in *ACRAccessTokenSpec) DeepCopyInto(out *ACRAccessTokenSpec) {
 	*out = *in
 	in.Auth.DeepCopyInto(&out.Auth)
+	if in.Inputs != nil {
+		in, out := &in.Inputs, &out.Inputs
+		*out = make([]GeneratorInput, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ACRAccessTokenSpec.
@@ -197,6 +202,89 @@ func (in *AWSJWTAuth) DeepCopy() *AWSJWTAuth {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AgeKey) DeepCopyInto(out *AgeKey) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AgeKey.
+func (in *AgeKey) DeepCopy() *AgeKey {
+	if in == nil {
+		return nil
+	}
+	out := new(AgeKey)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AgeKey) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AgeKeyList) DeepCopyInto(out *AgeKeyList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]AgeKey, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AgeKeyList.
+func (in *AgeKeyList) DeepCopy() *AgeKeyList {
+	if in == nil {
+		return nil
+	}
+	out := new(AgeKeyList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AgeKeyList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AgeKeySpec) DeepCopyInto(out *AgeKeySpec) {
+	*out = *in
+	if in.SSHKeySecretRef != nil {
+		in, out := &in.SSHKeySecretRef, &out.SSHKeySecretRef
+		*out = new(SecretKeySelector)
+		**out = **in
+	}
+	if in.Inputs != nil {
+		in, out := &in.Inputs, &out.Inputs
+		*out = make([]GeneratorInput, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AgeKeySpec.
+func (in *AgeKeySpec) DeepCopy() *AgeKeySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AgeKeySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AuthorizationProtocol) DeepCopyInto(out *AuthorizationProtocol) {
 	*out = *in
@@ -286,25 +374,25 @@ func (in *AzureACRWorkloadIdentityAuth) DeepCopy() *AzureACRWorkloadIdentityAuth
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ClusterGenerator) DeepCopyInto(out *ClusterGenerator) {
+func (in *Certificate) DeepCopyInto(out *Certificate) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterGenerator.
-func (in *ClusterGenerator) DeepCopy() *ClusterGenerator {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Certificate.
+func (in *Certificate) DeepCopy() *Certificate {
 	if in == nil {
 		return nil
 	}
-	out := new(ClusterGenerator)
+	out := new(Certificate)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *ClusterGenerator) DeepCopyObject() runtime.Object {
+func (in *Certificate) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -312,31 +400,48 @@ func (in *ClusterGenerator) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ClusterGeneratorList) DeepCopyInto(out *ClusterGeneratorList) {
+func (in *CertificateCA) DeepCopyInto(out *CertificateCA) {
+	*out = *in
+	out.CertSecretRef = in.CertSecretRef
+	out.KeySecretRef = in.KeySecretRef
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertificateCA.
+func (in *CertificateCA) DeepCopy() *CertificateCA {
+	if in == nil {
+		return nil
+	}
+	out := new(CertificateCA)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CertificateList) DeepCopyInto(out *CertificateList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]ClusterGenerator, len(*in))
+		*out = make([]Certificate, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterGeneratorList.
-func (in *ClusterGeneratorList) DeepCopy() *ClusterGeneratorList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertificateList.
+func (in *CertificateList) DeepCopy() *CertificateList {
 	if in == nil {
 		return nil
 	}
-	out := new(ClusterGeneratorList)
+	out := new(CertificateList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *ClusterGeneratorList) DeepCopyObject() runtime.Object {
+func (in *CertificateList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -344,57 +449,135 @@ func (in *ClusterGeneratorList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ClusterGeneratorSpec) DeepCopyInto(out *ClusterGeneratorSpec) {
+func (in *CertificateSpec) DeepCopyInto(out *CertificateSpec) {
 	*out = *in
-	in.Generator.DeepCopyInto(&out.Generator)
+	if in.KeySize != nil {
+		in, out := &in.KeySize, &out.KeySize
+		*out = new(int)
+		**out = **in
+	}
+	if in.Subject != nil {
+		in, out := &in.Subject, &out.Subject
+		*out = new(CertificateSubject)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DNSNames != nil {
+		in, out := &in.DNSNames, &out.DNSNames
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.IPAddresses != nil {
+		in, out := &in.IPAddresses, &out.IPAddresses
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.URIs != nil {
+		in, out := &in.URIs, &out.URIs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.EmailAddresses != nil {
+		in, out := &in.EmailAddresses, &out.EmailAddresses
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Usages != nil {
+		in, out := &in.Usages, &out.Usages
+		*out = make([]KeyUsage, len(*in))
+		copy(*out, *in)
+	}
+	if in.Duration != nil {
+		in, out := &in.Duration, &out.Duration
+		*out = new(apismetav1.Duration)
+		**out = **in
+	}
+	if in.RenewBeforePercentage != nil {
+		in, out := &in.RenewBeforePercentage, &out.RenewBeforePercentage
+		*out = new(int32)
+		**out = **in
+	}
+	if in.CA != nil {
+		in, out := &in.CA, &out.CA
+		*out = new(CertificateCA)
+		**out = **in
+	}
+	if in.Inputs != nil {
+		in, out := &in.Inputs, &out.Inputs
+		*out = make([]GeneratorInput, len(*in))
+		copy(*out, *in)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterGeneratorSpec.
-func (in *ClusterGeneratorSpec) DeepCopy() *ClusterGeneratorSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertificateSpec.
+func (in *CertificateSpec) DeepCopy() *CertificateSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(ClusterGeneratorSpec)
+	out := new(CertificateSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ControllerClassResource) DeepCopyInto(out *ControllerClassResource) {
+func (in *CertificateSubject) DeepCopyInto(out *CertificateSubject) {
 	*out = *in
-	out.Spec = in.Spec
+	if in.Organizations != nil {
+		in, out := &in.Organizations, &out.Organizations
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.OrganizationalUnits != nil {
+		in, out := &in.OrganizationalUnits, &out.OrganizationalUnits
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Countries != nil {
+		in, out := &in.Countries, &out.Countries
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Provinces != nil {
+		in, out := &in.Provinces, &out.Provinces
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Localities != nil {
+		in, out := &in.Localities, &out.Localities
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ControllerClassResource.
-func (in *ControllerClassResource) DeepCopy() *ControllerClassResource {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertificateSubject.
+func (in *CertificateSubject) DeepCopy() *CertificateSubject {
 	if in == nil {
 		return nil
 	}
-	out := new(ControllerClassResource)
+	out := new(CertificateSubject)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ECRAuthorizationToken) DeepCopyInto(out *ECRAuthorizationToken) {
+func (in *ClusterGenerator) DeepCopyInto(out *ClusterGenerator) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ECRAuthorizationToken.
-func (in *ECRAuthorizationToken) DeepCopy() *ECRAuthorizationToken {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterGenerator.
+func (in *ClusterGenerator) DeepCopy() *ClusterGenerator {
 	if in == nil {
 		return nil
 	}
-	out := new(ECRAuthorizationToken)
+	out := new(ClusterGenerator)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *ECRAuthorizationToken) DeepCopyObject() runtime.Object {
+func (in *ClusterGenerator) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -402,31 +585,31 @@ func (in *ECRAuthorizationToken) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ECRAuthorizationTokenList) DeepCopyInto(out *ECRAuthorizationTokenList) {
+func (in *ClusterGeneratorList) DeepCopyInto(out *ClusterGeneratorList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]ECRAuthorizationToken, len(*in))
+		*out = make([]ClusterGenerator, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ECRAuthorizationTokenList.
-func (in *ECRAuthorizationTokenList) DeepCopy() *ECRAuthorizationTokenList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterGeneratorList.
+func (in *ClusterGeneratorList) DeepCopy() *ClusterGeneratorList {
 	if in == nil {
 		return nil
 	}
-	out := new(ECRAuthorizationTokenList)
+	out := new(ClusterGeneratorList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *ECRAuthorizationTokenList) DeepCopyObject() runtime.Object {
+func (in *ClusterGeneratorList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -434,41 +617,57 @@ func (in *ECRAuthorizationTokenList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ECRAuthorizationTokenSpec) DeepCopyInto(out *ECRAuthorizationTokenSpec) {
+func (in *ClusterGeneratorSpec) DeepCopyInto(out *ClusterGeneratorSpec) {
 	*out = *in
-	in.Auth.DeepCopyInto(&out.Auth)
+	in.Generator.DeepCopyInto(&out.Generator)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ECRAuthorizationTokenSpec.
-func (in *ECRAuthorizationTokenSpec) DeepCopy() *ECRAuthorizationTokenSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterGeneratorSpec.
+func (in *ClusterGeneratorSpec) DeepCopy() *ClusterGeneratorSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(ECRAuthorizationTokenSpec)
+	out := new(ClusterGeneratorSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Fake) DeepCopyInto(out *Fake) {
+func (in *ControllerClassResource) DeepCopyInto(out *ControllerClassResource) {
+	*out = *in
+	out.Spec = in.Spec
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ControllerClassResource.
+func (in *ControllerClassResource) DeepCopy() *ControllerClassResource {
+	if in == nil {
+		return nil
+	}
+	out := new(ControllerClassResource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DatabaseUser) DeepCopyInto(out *DatabaseUser) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Fake.
-func (in *Fake) DeepCopy() *Fake {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatabaseUser.
+func (in *DatabaseUser) DeepCopy() *DatabaseUser {
 	if in == nil {
 		return nil
 	}
-	out := new(Fake)
+	out := new(DatabaseUser)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *Fake) DeepCopyObject() runtime.Object {
+func (in *DatabaseUser) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -476,31 +675,47 @@ func (in *Fake) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *FakeList) DeepCopyInto(out *FakeList) {
+func (in *DatabaseUserAuth) DeepCopyInto(out *DatabaseUserAuth) {
+	*out = *in
+	out.Password = in.Password
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatabaseUserAuth.
+func (in *DatabaseUserAuth) DeepCopy() *DatabaseUserAuth {
+	if in == nil {
+		return nil
+	}
+	out := new(DatabaseUserAuth)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DatabaseUserList) DeepCopyInto(out *DatabaseUserList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]Fake, len(*in))
+		*out = make([]DatabaseUser, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FakeList.
-func (in *FakeList) DeepCopy() *FakeList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatabaseUserList.
+func (in *DatabaseUserList) DeepCopy() *DatabaseUserList {
 	if in == nil {
 		return nil
 	}
-	out := new(FakeList)
+	out := new(DatabaseUserList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *FakeList) DeepCopyObject() runtime.Object {
+func (in *DatabaseUserList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -508,33 +723,246 @@ func (in *FakeList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *FakeSpec) DeepCopyInto(out *FakeSpec) {
+func (in *DatabaseUserSpec) DeepCopyInto(out *DatabaseUserSpec) {
 	*out = *in
-	if in.Data != nil {
-		in, out := &in.Data, &out.Data
-		*out = make(map[string]string, len(*in))
-		for key, val := range *in {
-			(*out)[key] = val
-		}
+	if in.Port != nil {
+		in, out := &in.Port, &out.Port
+		*out = new(int32)
+		**out = **in
+	}
+	if in.CABundle != nil {
+		in, out := &in.CABundle, &out.CABundle
+		*out = make([]byte, len(*in))
+		copy(*out, *in)
+	}
+	if in.CAProvider != nil {
+		in, out := &in.CAProvider, &out.CAProvider
+		*out = new(externalsecretsv1.CAProvider)
+		(*in).DeepCopyInto(*out)
+	}
+	out.Auth = in.Auth
+	if in.UsernamePrefix != nil {
+		in, out := &in.UsernamePrefix, &out.UsernamePrefix
+		*out = new(string)
+		**out = **in
+	}
+	if in.PasswordLength != nil {
+		in, out := &in.PasswordLength, &out.PasswordLength
+		*out = new(int)
+		**out = **in
+	}
+	if in.Roles != nil {
+		in, out := &in.Roles, &out.Roles
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Statements != nil {
+		in, out := &in.Statements, &out.Statements
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.CleanupStatements != nil {
+		in, out := &in.CleanupStatements, &out.CleanupStatements
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Inputs != nil {
+		in, out := &in.Inputs, &out.Inputs
+		*out = make([]GeneratorInput, len(*in))
+		copy(*out, *in)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FakeSpec.
-func (in *FakeSpec) DeepCopy() *FakeSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatabaseUserSpec.
+func (in *DatabaseUserSpec) DeepCopy() *DatabaseUserSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(FakeSpec)
+	out := new(DatabaseUserSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *GCPSMAuth) DeepCopyInto(out *GCPSMAuth) {
+func (in *DatabaseUserState) DeepCopyInto(out *DatabaseUserState) {
 	*out = *in
-	if in.SecretRef != nil {
-		in, out := &in.SecretRef, &out.SecretRef
-		*out = new(GCPSMAuthSecretRef)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatabaseUserState.
+func (in *DatabaseUserState) DeepCopy() *DatabaseUserState {
+	if in == nil {
+		return nil
+	}
+	out := new(DatabaseUserState)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ECRAuthorizationToken) DeepCopyInto(out *ECRAuthorizationToken) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ECRAuthorizationToken.
+func (in *ECRAuthorizationToken) DeepCopy() *ECRAuthorizationToken {
+	if in == nil {
+		return nil
+	}
+	out := new(ECRAuthorizationToken)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ECRAuthorizationToken) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ECRAuthorizationTokenList) DeepCopyInto(out *ECRAuthorizationTokenList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ECRAuthorizationToken, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ECRAuthorizationTokenList.
+func (in *ECRAuthorizationTokenList) DeepCopy() *ECRAuthorizationTokenList {
+	if in == nil {
+		return nil
+	}
+	out := new(ECRAuthorizationTokenList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ECRAuthorizationTokenList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ECRAuthorizationTokenSpec) DeepCopyInto(out *ECRAuthorizationTokenSpec) {
+	*out = *in
+	in.Auth.DeepCopyInto(&out.Auth)
+	if in.Inputs != nil {
+		in, out := &in.Inputs, &out.Inputs
+		*out = make([]GeneratorInput, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ECRAuthorizationTokenSpec.
+func (in *ECRAuthorizationTokenSpec) DeepCopy() *ECRAuthorizationTokenSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ECRAuthorizationTokenSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Fake) DeepCopyInto(out *Fake) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Fake.
+func (in *Fake) DeepCopy() *Fake {
+	if in == nil {
+		return nil
+	}
+	out := new(Fake)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Fake) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FakeList) DeepCopyInto(out *FakeList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Fake, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FakeList.
+func (in *FakeList) DeepCopy() *FakeList {
+	if in == nil {
+		return nil
+	}
+	out := new(FakeList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *FakeList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FakeSpec) DeepCopyInto(out *FakeSpec) {
+	*out = *in
+	if in.Data != nil {
+		in, out := &in.Data, &out.Data
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FakeSpec.
+func (in *FakeSpec) DeepCopy() *FakeSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(FakeSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GCPSMAuth) DeepCopyInto(out *GCPSMAuth) {
+	*out = *in
+	if in.SecretRef != nil {
+		in, out := &in.SecretRef, &out.SecretRef
+		*out = new(GCPSMAuthSecretRef)
 		(*in).DeepCopyInto(*out)
 	}
 	if in.WorkloadIdentity != nil {
@@ -648,6 +1076,11 @@ func (in *GCRAccessTokenList) DeepCopyObject() runtime.Object {
 func (in *GCRAccessTokenSpec) DeepCopyInto(out *GCRAccessTokenSpec) {
 	*out = *in
 	in.Auth.DeepCopyInto(&out.Auth)
+	if in.Inputs != nil {
+		in, out := &in.Inputs, &out.Inputs
+		*out = make([]GeneratorInput, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GCRAccessTokenSpec.
@@ -660,6 +1093,22 @@ func (in *GCRAccessTokenSpec) DeepCopy() *GCRAccessTokenSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GeneratorInput) DeepCopyInto(out *GeneratorInput) {
+	*out = *in
+	out.GeneratorRef = in.GeneratorRef
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GeneratorInput.
+func (in *GeneratorInput) DeepCopy() *GeneratorInput {
+	if in == nil {
+		return nil
+	}
+	out := new(GeneratorInput)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *GeneratorSpec) DeepCopyInto(out *GeneratorSpec) {
 	*out = *in
@@ -733,6 +1182,46 @@ func (in *GeneratorSpec) DeepCopyInto(out *GeneratorSpec) {
 		*out = new(MFASpec)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.CertificateSpec != nil {
+		in, out := &in.CertificateSpec, &out.CertificateSpec
+		*out = new(CertificateSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ServiceAccountTokenSpec != nil {
+		in, out := &in.ServiceAccountTokenSpec, &out.ServiceAccountTokenSpec
+		*out = new(ServiceAccountTokenSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.WireGuardKeySpec != nil {
+		in, out := &in.WireGuardKeySpec, &out.WireGuardKeySpec
+		*out = new(WireGuardKeySpec)
+		**out = **in
+	}
+	if in.AgeKeySpec != nil {
+		in, out := &in.AgeKeySpec, &out.AgeKeySpec
+		*out = new(AgeKeySpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DatabaseUserSpec != nil {
+		in, out := &in.DatabaseUserSpec, &out.DatabaseUserSpec
+		*out = new(DatabaseUserSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.GitlabAccessTokenSpec != nil {
+		in, out := &in.GitlabAccessTokenSpec, &out.GitlabAccessTokenSpec
+		*out = new(GitlabAccessTokenSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.OAuth2TokenSpec != nil {
+		in, out := &in.OAuth2TokenSpec, &out.OAuth2TokenSpec
+		*out = new(OAuth2TokenSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TransformSpec != nil {
+		in, out := &in.TransformSpec, &out.TransformSpec
+		*out = new(TransformSpec)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GeneratorSpec.
@@ -932,11 +1421,21 @@ func (in *GithubAccessTokenList) DeepCopyObject() runtime.Object {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *GithubAccessTokenSpec) DeepCopyInto(out *GithubAccessTokenSpec) {
 	*out = *in
+	if in.Installation != nil {
+		in, out := &in.Installation, &out.Installation
+		*out = new(GithubInstallation)
+		**out = **in
+	}
 	if in.Repositories != nil {
 		in, out := &in.Repositories, &out.Repositories
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.RepositoryIDs != nil {
+		in, out := &in.RepositoryIDs, &out.RepositoryIDs
+		*out = make([]int64, len(*in))
+		copy(*out, *in)
+	}
 	if in.Permissions != nil {
 		in, out := &in.Permissions, &out.Permissions
 		*out = make(map[string]string, len(*in))
@@ -945,6 +1444,11 @@ func (in *GithubAccessTokenSpec) DeepCopyInto(out *GithubAccessTokenSpec) {
 		}
 	}
 	in.Auth.DeepCopyInto(&out.Auth)
+	if in.Inputs != nil {
+		in, out := &in.Inputs, &out.Inputs
+		*out = make([]GeneratorInput, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GithubAccessTokenSpec.
@@ -957,6 +1461,21 @@ func (in *GithubAccessTokenSpec) DeepCopy() *GithubAccessTokenSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GithubAccessTokenState) DeepCopyInto(out *GithubAccessTokenState) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GithubAccessTokenState.
+func (in *GithubAccessTokenState) DeepCopy() *GithubAccessTokenState {
+	if in == nil {
+		return nil
+	}
+	out := new(GithubAccessTokenState)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *GithubAuth) DeepCopyInto(out *GithubAuth) {
 	*out = *in
@@ -973,6 +1492,21 @@ func (in *GithubAuth) DeepCopy() *GithubAuth {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GithubInstallation) DeepCopyInto(out *GithubInstallation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GithubInstallation.
+func (in *GithubInstallation) DeepCopy() *GithubInstallation {
+	if in == nil {
+		return nil
+	}
+	out := new(GithubInstallation)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *GithubSecretRef) DeepCopyInto(out *GithubSecretRef) {
 	*out = *in
@@ -990,25 +1524,25 @@ func (in *GithubSecretRef) DeepCopy() *GithubSecretRef {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Grafana) DeepCopyInto(out *Grafana) {
+func (in *GitlabAccessToken) DeepCopyInto(out *GitlabAccessToken) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Grafana.
-func (in *Grafana) DeepCopy() *Grafana {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GitlabAccessToken.
+func (in *GitlabAccessToken) DeepCopy() *GitlabAccessToken {
 	if in == nil {
 		return nil
 	}
-	out := new(Grafana)
+	out := new(GitlabAccessToken)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *Grafana) DeepCopyObject() runtime.Object {
+func (in *GitlabAccessToken) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -1016,41 +1550,181 @@ func (in *Grafana) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *GrafanaAuth) DeepCopyInto(out *GrafanaAuth) {
+func (in *GitlabAccessTokenAuth) DeepCopyInto(out *GitlabAccessTokenAuth) {
 	*out = *in
-	if in.Token != nil {
-		in, out := &in.Token, &out.Token
-		*out = new(SecretKeySelector)
-		**out = **in
-	}
-	if in.Basic != nil {
-		in, out := &in.Basic, &out.Basic
-		*out = new(GrafanaBasicAuth)
-		**out = **in
-	}
+	out.AccessToken = in.AccessToken
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GrafanaAuth.
-func (in *GrafanaAuth) DeepCopy() *GrafanaAuth {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GitlabAccessTokenAuth.
+func (in *GitlabAccessTokenAuth) DeepCopy() *GitlabAccessTokenAuth {
 	if in == nil {
 		return nil
 	}
-	out := new(GrafanaAuth)
+	out := new(GitlabAccessTokenAuth)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *GrafanaBasicAuth) DeepCopyInto(out *GrafanaBasicAuth) {
+func (in *GitlabAccessTokenList) DeepCopyInto(out *GitlabAccessTokenList) {
 	*out = *in
-	out.Password = in.Password
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GrafanaBasicAuth.
-func (in *GrafanaBasicAuth) DeepCopy() *GrafanaBasicAuth {
-	if in == nil {
-		return nil
-	}
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]GitlabAccessToken, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GitlabAccessTokenList.
+func (in *GitlabAccessTokenList) DeepCopy() *GitlabAccessTokenList {
+	if in == nil {
+		return nil
+	}
+	out := new(GitlabAccessTokenList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *GitlabAccessTokenList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GitlabAccessTokenSpec) DeepCopyInto(out *GitlabAccessTokenSpec) {
+	*out = *in
+	if in.CABundle != nil {
+		in, out := &in.CABundle, &out.CABundle
+		*out = make([]byte, len(*in))
+		copy(*out, *in)
+	}
+	if in.CAProvider != nil {
+		in, out := &in.CAProvider, &out.CAProvider
+		*out = new(externalsecretsv1.CAProvider)
+		(*in).DeepCopyInto(*out)
+	}
+	out.Auth = in.Auth
+	if in.UserID != nil {
+		in, out := &in.UserID, &out.UserID
+		*out = new(int)
+		**out = **in
+	}
+	if in.Scopes != nil {
+		in, out := &in.Scopes, &out.Scopes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ExpiresAfter != nil {
+		in, out := &in.ExpiresAfter, &out.ExpiresAfter
+		*out = new(apismetav1.Duration)
+		**out = **in
+	}
+	if in.RenewBeforePercentage != nil {
+		in, out := &in.RenewBeforePercentage, &out.RenewBeforePercentage
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Inputs != nil {
+		in, out := &in.Inputs, &out.Inputs
+		*out = make([]GeneratorInput, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GitlabAccessTokenSpec.
+func (in *GitlabAccessTokenSpec) DeepCopy() *GitlabAccessTokenSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(GitlabAccessTokenSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GitlabAccessTokenState) DeepCopyInto(out *GitlabAccessTokenState) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GitlabAccessTokenState.
+func (in *GitlabAccessTokenState) DeepCopy() *GitlabAccessTokenState {
+	if in == nil {
+		return nil
+	}
+	out := new(GitlabAccessTokenState)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Grafana) DeepCopyInto(out *Grafana) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Grafana.
+func (in *Grafana) DeepCopy() *Grafana {
+	if in == nil {
+		return nil
+	}
+	out := new(Grafana)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Grafana) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GrafanaAuth) DeepCopyInto(out *GrafanaAuth) {
+	*out = *in
+	if in.Token != nil {
+		in, out := &in.Token, &out.Token
+		*out = new(SecretKeySelector)
+		**out = **in
+	}
+	if in.Basic != nil {
+		in, out := &in.Basic, &out.Basic
+		*out = new(GrafanaBasicAuth)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GrafanaAuth.
+func (in *GrafanaAuth) DeepCopy() *GrafanaAuth {
+	if in == nil {
+		return nil
+	}
+	out := new(GrafanaAuth)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GrafanaBasicAuth) DeepCopyInto(out *GrafanaBasicAuth) {
+	*out = *in
+	out.Password = in.Password
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GrafanaBasicAuth.
+func (in *GrafanaBasicAuth) DeepCopy() *GrafanaBasicAuth {
+	if in == nil {
+		return nil
+	}
 	out := new(GrafanaBasicAuth)
 	in.DeepCopyInto(out)
 	return out
@@ -1124,6 +1798,11 @@ func (in *GrafanaSpec) DeepCopyInto(out *GrafanaSpec) {
 	*out = *in
 	in.Auth.DeepCopyInto(&out.Auth)
 	out.ServiceAccount = in.ServiceAccount
+	if in.Inputs != nil {
+		in, out := &in.Inputs, &out.Inputs
+		*out = make([]GeneratorInput, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GrafanaSpec.
@@ -1232,6 +1911,11 @@ func (in *MFASpec) DeepCopyInto(out *MFASpec) {
 		in, out := &in.When, &out.When
 		*out = (*in).DeepCopy()
 	}
+	if in.Inputs != nil {
+		in, out := &in.Inputs, &out.Inputs
+		*out = make([]GeneratorInput, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MFASpec.
@@ -1262,25 +1946,93 @@ func (in *NTLMProtocol) DeepCopy() *NTLMProtocol {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Password) DeepCopyInto(out *Password) {
+func (in *OAuth2ClientAuth) DeepCopyInto(out *OAuth2ClientAuth) {
+	*out = *in
+	if in.ClientSecretBasic != nil {
+		in, out := &in.ClientSecretBasic, &out.ClientSecretBasic
+		*out = new(SecretKeySelector)
+		**out = **in
+	}
+	if in.ClientSecretPost != nil {
+		in, out := &in.ClientSecretPost, &out.ClientSecretPost
+		*out = new(SecretKeySelector)
+		**out = **in
+	}
+	if in.PrivateKeyJWT != nil {
+		in, out := &in.PrivateKeyJWT, &out.PrivateKeyJWT
+		*out = new(OAuth2PrivateKeyJWT)
+		**out = **in
+	}
+	if in.TLSClientAuth != nil {
+		in, out := &in.TLSClientAuth, &out.TLSClientAuth
+		*out = new(OAuth2TLSClientAuth)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OAuth2ClientAuth.
+func (in *OAuth2ClientAuth) DeepCopy() *OAuth2ClientAuth {
+	if in == nil {
+		return nil
+	}
+	out := new(OAuth2ClientAuth)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OAuth2PrivateKeyJWT) DeepCopyInto(out *OAuth2PrivateKeyJWT) {
+	*out = *in
+	out.PrivateKey = in.PrivateKey
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OAuth2PrivateKeyJWT.
+func (in *OAuth2PrivateKeyJWT) DeepCopy() *OAuth2PrivateKeyJWT {
+	if in == nil {
+		return nil
+	}
+	out := new(OAuth2PrivateKeyJWT)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OAuth2TLSClientAuth) DeepCopyInto(out *OAuth2TLSClientAuth) {
+	*out = *in
+	out.Certificate = in.Certificate
+	out.PrivateKey = in.PrivateKey
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OAuth2TLSClientAuth.
+func (in *OAuth2TLSClientAuth) DeepCopy() *OAuth2TLSClientAuth {
+	if in == nil {
+		return nil
+	}
+	out := new(OAuth2TLSClientAuth)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OAuth2Token) DeepCopyInto(out *OAuth2Token) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Password.
-func (in *Password) DeepCopy() *Password {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OAuth2Token.
+func (in *OAuth2Token) DeepCopy() *OAuth2Token {
 	if in == nil {
 		return nil
 	}
-	out := new(Password)
+	out := new(OAuth2Token)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *Password) DeepCopyObject() runtime.Object {
+func (in *OAuth2Token) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -1288,31 +2040,439 @@ func (in *Password) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PasswordList) DeepCopyInto(out *PasswordList) {
+func (in *OAuth2TokenList) DeepCopyInto(out *OAuth2TokenList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]Password, len(*in))
+		*out = make([]OAuth2Token, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PasswordList.
-func (in *PasswordList) DeepCopy() *PasswordList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OAuth2TokenList.
+func (in *OAuth2TokenList) DeepCopy() *OAuth2TokenList {
+	if in == nil {
+		return nil
+	}
+	out := new(OAuth2TokenList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *OAuth2TokenList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OAuth2TokenSpec) DeepCopyInto(out *OAuth2TokenSpec) {
+	*out = *in
+	in.Auth.DeepCopyInto(&out.Auth)
+	if in.Scopes != nil {
+		in, out := &in.Scopes, &out.Scopes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Parameters != nil {
+		in, out := &in.Parameters, &out.Parameters
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.RenewBeforePercentage != nil {
+		in, out := &in.RenewBeforePercentage, &out.RenewBeforePercentage
+		*out = new(int32)
+		**out = **in
+	}
+	if in.CABundle != nil {
+		in, out := &in.CABundle, &out.CABundle
+		*out = make([]byte, len(*in))
+		copy(*out, *in)
+	}
+	if in.CAProvider != nil {
+		in, out := &in.CAProvider, &out.CAProvider
+		*out = new(externalsecretsv1.CAProvider)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Inputs != nil {
+		in, out := &in.Inputs, &out.Inputs
+		*out = make([]GeneratorInput, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OAuth2TokenSpec.
+func (in *OAuth2TokenSpec) DeepCopy() *OAuth2TokenSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(OAuth2TokenSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PassphraseSpec) DeepCopyInto(out *PassphraseSpec) {
+	*out = *in
+	if in.Separator != nil {
+		in, out := &in.Separator, &out.Separator
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PassphraseSpec.
+func (in *PassphraseSpec) DeepCopy() *PassphraseSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(PassphraseSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Password) DeepCopyInto(out *Password) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Password.
+func (in *Password) DeepCopy() *Password {
+	if in == nil {
+		return nil
+	}
+	out := new(Password)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Password) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PasswordList) DeepCopyInto(out *PasswordList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Password, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PasswordList.
+func (in *PasswordList) DeepCopy() *PasswordList {
+	if in == nil {
+		return nil
+	}
+	out := new(PasswordList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *PasswordList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PasswordSpec) DeepCopyInto(out *PasswordSpec) {
+	*out = *in
+	if in.Digits != nil {
+		in, out := &in.Digits, &out.Digits
+		*out = new(int)
+		**out = **in
+	}
+	if in.Symbols != nil {
+		in, out := &in.Symbols, &out.Symbols
+		*out = new(int)
+		**out = **in
+	}
+	if in.SymbolCharacters != nil {
+		in, out := &in.SymbolCharacters, &out.SymbolCharacters
+		*out = new(string)
+		**out = **in
+	}
+	if in.MinLowercase != nil {
+		in, out := &in.MinLowercase, &out.MinLowercase
+		*out = new(int)
+		**out = **in
+	}
+	if in.MinUppercase != nil {
+		in, out := &in.MinUppercase, &out.MinUppercase
+		*out = new(int)
+		**out = **in
+	}
+	if in.LowercaseCharacters != nil {
+		in, out := &in.LowercaseCharacters, &out.LowercaseCharacters
+		*out = new(string)
+		**out = **in
+	}
+	if in.UppercaseCharacters != nil {
+		in, out := &in.UppercaseCharacters, &out.UppercaseCharacters
+		*out = new(string)
+		**out = **in
+	}
+	if in.DigitCharacters != nil {
+		in, out := &in.DigitCharacters, &out.DigitCharacters
+		*out = new(string)
+		**out = **in
+	}
+	if in.ExcludeCharacters != nil {
+		in, out := &in.ExcludeCharacters, &out.ExcludeCharacters
+		*out = new(string)
+		**out = **in
+	}
+	if in.FirstCharacter != nil {
+		in, out := &in.FirstCharacter, &out.FirstCharacter
+		*out = make([]PasswordCharacterClass, len(*in))
+		copy(*out, *in)
+	}
+	if in.Passphrase != nil {
+		in, out := &in.Passphrase, &out.Passphrase
+		*out = new(PassphraseSpec)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PasswordSpec.
+func (in *PasswordSpec) DeepCopy() *PasswordSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(PasswordSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QuayAccessToken) DeepCopyInto(out *QuayAccessToken) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QuayAccessToken.
+func (in *QuayAccessToken) DeepCopy() *QuayAccessToken {
+	if in == nil {
+		return nil
+	}
+	out := new(QuayAccessToken)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *QuayAccessToken) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QuayAccessTokenList) DeepCopyInto(out *QuayAccessTokenList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]QuayAccessToken, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QuayAccessTokenList.
+func (in *QuayAccessTokenList) DeepCopy() *QuayAccessTokenList {
+	if in == nil {
+		return nil
+	}
+	out := new(QuayAccessTokenList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *QuayAccessTokenList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QuayAccessTokenSpec) DeepCopyInto(out *QuayAccessTokenSpec) {
+	*out = *in
+	in.ServiceAccountRef.DeepCopyInto(&out.ServiceAccountRef)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QuayAccessTokenSpec.
+func (in *QuayAccessTokenSpec) DeepCopy() *QuayAccessTokenSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(QuayAccessTokenSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RequestParameters) DeepCopyInto(out *RequestParameters) {
+	*out = *in
+	if in.SessionDuration != nil {
+		in, out := &in.SessionDuration, &out.SessionDuration
+		*out = new(int32)
+		**out = **in
+	}
+	if in.SerialNumber != nil {
+		in, out := &in.SerialNumber, &out.SerialNumber
+		*out = new(string)
+		**out = **in
+	}
+	if in.TokenCode != nil {
+		in, out := &in.TokenCode, &out.TokenCode
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RequestParameters.
+func (in *RequestParameters) DeepCopy() *RequestParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(RequestParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SSHCertificateSpec) DeepCopyInto(out *SSHCertificateSpec) {
+	*out = *in
+	out.CAKeySecretRef = in.CAKeySecretRef
+	if in.Principals != nil {
+		in, out := &in.Principals, &out.Principals
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ValidFor != nil {
+		in, out := &in.ValidFor, &out.ValidFor
+		*out = new(apismetav1.Duration)
+		**out = **in
+	}
+	if in.RenewBeforePercentage != nil {
+		in, out := &in.RenewBeforePercentage, &out.RenewBeforePercentage
+		*out = new(int32)
+		**out = **in
+	}
+	if in.ClockSkew != nil {
+		in, out := &in.ClockSkew, &out.ClockSkew
+		*out = new(apismetav1.Duration)
+		**out = **in
+	}
+	if in.CriticalOptions != nil {
+		in, out := &in.CriticalOptions, &out.CriticalOptions
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Extensions != nil {
+		in, out := &in.Extensions, &out.Extensions
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SSHCertificateSpec.
+func (in *SSHCertificateSpec) DeepCopy() *SSHCertificateSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(SSHCertificateSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SSHKey) DeepCopyInto(out *SSHKey) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SSHKey.
+func (in *SSHKey) DeepCopy() *SSHKey {
+	if in == nil {
+		return nil
+	}
+	out := new(SSHKey)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *SSHKey) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SSHKeyList) DeepCopyInto(out *SSHKeyList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]SSHKey, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SSHKeyList.
+func (in *SSHKeyList) DeepCopy() *SSHKeyList {
 	if in == nil {
 		return nil
 	}
-	out := new(PasswordList)
+	out := new(SSHKeyList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *PasswordList) DeepCopyObject() runtime.Object {
+func (in *SSHKeyList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -1320,55 +2480,55 @@ func (in *PasswordList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PasswordSpec) DeepCopyInto(out *PasswordSpec) {
+func (in *SSHKeySpec) DeepCopyInto(out *SSHKeySpec) {
 	*out = *in
-	if in.Digits != nil {
-		in, out := &in.Digits, &out.Digits
+	if in.KeySize != nil {
+		in, out := &in.KeySize, &out.KeySize
 		*out = new(int)
 		**out = **in
 	}
-	if in.Symbols != nil {
-		in, out := &in.Symbols, &out.Symbols
-		*out = new(int)
-		**out = **in
+	if in.Certificate != nil {
+		in, out := &in.Certificate, &out.Certificate
+		*out = new(SSHCertificateSpec)
+		(*in).DeepCopyInto(*out)
 	}
-	if in.SymbolCharacters != nil {
-		in, out := &in.SymbolCharacters, &out.SymbolCharacters
-		*out = new(string)
-		**out = **in
+	if in.Inputs != nil {
+		in, out := &in.Inputs, &out.Inputs
+		*out = make([]GeneratorInput, len(*in))
+		copy(*out, *in)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PasswordSpec.
-func (in *PasswordSpec) DeepCopy() *PasswordSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SSHKeySpec.
+func (in *SSHKeySpec) DeepCopy() *SSHKeySpec {
 	if in == nil {
 		return nil
 	}
-	out := new(PasswordSpec)
+	out := new(SSHKeySpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *QuayAccessToken) DeepCopyInto(out *QuayAccessToken) {
+func (in *STSSessionToken) DeepCopyInto(out *STSSessionToken) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QuayAccessToken.
-func (in *QuayAccessToken) DeepCopy() *QuayAccessToken {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new STSSessionToken.
+func (in *STSSessionToken) DeepCopy() *STSSessionToken {
 	if in == nil {
 		return nil
 	}
-	out := new(QuayAccessToken)
+	out := new(STSSessionToken)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *QuayAccessToken) DeepCopyObject() runtime.Object {
+func (in *STSSessionToken) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -1376,31 +2536,31 @@ func (in *QuayAccessToken) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *QuayAccessTokenList) DeepCopyInto(out *QuayAccessTokenList) {
+func (in *STSSessionTokenList) DeepCopyInto(out *STSSessionTokenList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]QuayAccessToken, len(*in))
+		*out = make([]STSSessionToken, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QuayAccessTokenList.
-func (in *QuayAccessTokenList) DeepCopy() *QuayAccessTokenList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new STSSessionTokenList.
+func (in *STSSessionTokenList) DeepCopy() *STSSessionTokenList {
 	if in == nil {
 		return nil
 	}
-	out := new(QuayAccessTokenList)
+	out := new(STSSessionTokenList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *QuayAccessTokenList) DeepCopyObject() runtime.Object {
+func (in *STSSessionTokenList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -1408,71 +2568,66 @@ func (in *QuayAccessTokenList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *QuayAccessTokenSpec) DeepCopyInto(out *QuayAccessTokenSpec) {
+func (in *STSSessionTokenSpec) DeepCopyInto(out *STSSessionTokenSpec) {
 	*out = *in
-	in.ServiceAccountRef.DeepCopyInto(&out.ServiceAccountRef)
+	in.Auth.DeepCopyInto(&out.Auth)
+	if in.RequestParameters != nil {
+		in, out := &in.RequestParameters, &out.RequestParameters
+		*out = new(RequestParameters)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Inputs != nil {
+		in, out := &in.Inputs, &out.Inputs
+		*out = make([]GeneratorInput, len(*in))
+		copy(*out, *in)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QuayAccessTokenSpec.
-func (in *QuayAccessTokenSpec) DeepCopy() *QuayAccessTokenSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new STSSessionTokenSpec.
+func (in *STSSessionTokenSpec) DeepCopy() *STSSessionTokenSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(QuayAccessTokenSpec)
+	out := new(STSSessionTokenSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RequestParameters) DeepCopyInto(out *RequestParameters) {
+func (in *SecretKeySelector) DeepCopyInto(out *SecretKeySelector) {
 	*out = *in
-	if in.SessionDuration != nil {
-		in, out := &in.SessionDuration, &out.SessionDuration
-		*out = new(int32)
-		**out = **in
-	}
-	if in.SerialNumber != nil {
-		in, out := &in.SerialNumber, &out.SerialNumber
-		*out = new(string)
-		**out = **in
-	}
-	if in.TokenCode != nil {
-		in, out := &in.TokenCode, &out.TokenCode
-		*out = new(string)
-		**out = **in
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RequestParameters.
-func (in *RequestParameters) DeepCopy() *RequestParameters {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecretKeySelector.
+func (in *SecretKeySelector) DeepCopy() *SecretKeySelector {
 	if in == nil {
 		return nil
 	}
-	out := new(RequestParameters)
+	out := new(SecretKeySelector)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *SSHKey) DeepCopyInto(out *SSHKey) {
+func (in *ServiceAccountToken) DeepCopyInto(out *ServiceAccountToken) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SSHKey.
-func (in *SSHKey) DeepCopy() *SSHKey {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceAccountToken.
+func (in *ServiceAccountToken) DeepCopy() *ServiceAccountToken {
 	if in == nil {
 		return nil
 	}
-	out := new(SSHKey)
+	out := new(ServiceAccountToken)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *SSHKey) DeepCopyObject() runtime.Object {
+func (in *ServiceAccountToken) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -1480,31 +2635,77 @@ func (in *SSHKey) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *SSHKeyList) DeepCopyInto(out *SSHKeyList) {
+func (in *ServiceAccountTokenCluster) DeepCopyInto(out *ServiceAccountTokenCluster) {
+	*out = *in
+	in.Server.DeepCopyInto(&out.Server)
+	if in.Auth != nil {
+		in, out := &in.Auth, &out.Auth
+		*out = new(externalsecretsv1.KubernetesAuth)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AuthRef != nil {
+		in, out := &in.AuthRef, &out.AuthRef
+		*out = new(metav1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceAccountTokenCluster.
+func (in *ServiceAccountTokenCluster) DeepCopy() *ServiceAccountTokenCluster {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceAccountTokenCluster)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServiceAccountTokenKubeconfig) DeepCopyInto(out *ServiceAccountTokenKubeconfig) {
+	*out = *in
+	if in.CABundle != nil {
+		in, out := &in.CABundle, &out.CABundle
+		*out = make([]byte, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceAccountTokenKubeconfig.
+func (in *ServiceAccountTokenKubeconfig) DeepCopy() *ServiceAccountTokenKubeconfig {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceAccountTokenKubeconfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServiceAccountTokenList) DeepCopyInto(out *ServiceAccountTokenList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]SSHKey, len(*in))
+		*out = make([]ServiceAccountToken, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SSHKeyList.
-func (in *SSHKeyList) DeepCopy() *SSHKeyList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceAccountTokenList.
+func (in *ServiceAccountTokenList) DeepCopy() *ServiceAccountTokenList {
 	if in == nil {
 		return nil
 	}
-	out := new(SSHKeyList)
+	out := new(ServiceAccountTokenList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *SSHKeyList) DeepCopyObject() runtime.Object {
+func (in *ServiceAccountTokenList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -1512,45 +2713,66 @@ func (in *SSHKeyList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *SSHKeySpec) DeepCopyInto(out *SSHKeySpec) {
+func (in *ServiceAccountTokenSpec) DeepCopyInto(out *ServiceAccountTokenSpec) {
 	*out = *in
-	if in.KeySize != nil {
-		in, out := &in.KeySize, &out.KeySize
-		*out = new(int)
+	in.ServiceAccountRef.DeepCopyInto(&out.ServiceAccountRef)
+	if in.ExpirationSeconds != nil {
+		in, out := &in.ExpirationSeconds, &out.ExpirationSeconds
+		*out = new(int64)
+		**out = **in
+	}
+	if in.RenewBeforePercentage != nil {
+		in, out := &in.RenewBeforePercentage, &out.RenewBeforePercentage
+		*out = new(int32)
 		**out = **in
 	}
+	if in.RemoteCluster != nil {
+		in, out := &in.RemoteCluster, &out.RemoteCluster
+		*out = new(ServiceAccountTokenCluster)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Kubeconfig != nil {
+		in, out := &in.Kubeconfig, &out.Kubeconfig
+		*out = new(ServiceAccountTokenKubeconfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Inputs != nil {
+		in, out := &in.Inputs, &out.Inputs
+		*out = make([]GeneratorInput, len(*in))
+		copy(*out, *in)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SSHKeySpec.
-func (in *SSHKeySpec) DeepCopy() *SSHKeySpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceAccountTokenSpec.
+func (in *ServiceAccountTokenSpec) DeepCopy() *ServiceAccountTokenSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(SSHKeySpec)
+	out := new(ServiceAccountTokenSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *STSSessionToken) DeepCopyInto(out *STSSessionToken) {
+func (in *Transform) DeepCopyInto(out *Transform) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new STSSessionToken.
-func (in *STSSessionToken) DeepCopy() *STSSessionToken {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Transform.
+func (in *Transform) DeepCopy() *Transform {
 	if in == nil {
 		return nil
 	}
-	out := new(STSSessionToken)
+	out := new(Transform)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *STSSessionToken) DeepCopyObject() runtime.Object {
+func (in *Transform) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -1558,31 +2780,31 @@ func (in *STSSessionToken) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *STSSessionTokenList) DeepCopyInto(out *STSSessionTokenList) {
+func (in *TransformList) DeepCopyInto(out *TransformList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]STSSessionToken, len(*in))
+		*out = make([]Transform, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new STSSessionTokenList.
-func (in *STSSessionTokenList) DeepCopy() *STSSessionTokenList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TransformList.
+func (in *TransformList) DeepCopy() *TransformList {
 	if in == nil {
 		return nil
 	}
-	out := new(STSSessionTokenList)
+	out := new(TransformList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *STSSessionTokenList) DeepCopyObject() runtime.Object {
+func (in *TransformList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -1590,37 +2812,28 @@ func (in *STSSessionTokenList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *STSSessionTokenSpec) DeepCopyInto(out *STSSessionTokenSpec) {
+func (in *TransformSpec) DeepCopyInto(out *TransformSpec) {
 	*out = *in
-	in.Auth.DeepCopyInto(&out.Auth)
-	if in.RequestParameters != nil {
-		in, out := &in.RequestParameters, &out.RequestParameters
-		*out = new(RequestParameters)
-		(*in).DeepCopyInto(*out)
+	if in.Inputs != nil {
+		in, out := &in.Inputs, &out.Inputs
+		*out = make([]GeneratorInput, len(*in))
+		copy(*out, *in)
 	}
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new STSSessionTokenSpec.
-func (in *STSSessionTokenSpec) DeepCopy() *STSSessionTokenSpec {
-	if in == nil {
-		return nil
+	if in.Data != nil {
+		in, out := &in.Data, &out.Data
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
 	}
-	out := new(STSSessionTokenSpec)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *SecretKeySelector) DeepCopyInto(out *SecretKeySelector) {
-	*out = *in
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecretKeySelector.
-func (in *SecretKeySelector) DeepCopy() *SecretKeySelector {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TransformSpec.
+func (in *TransformSpec) DeepCopy() *TransformSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(SecretKeySelector)
+	out := new(TransformSpec)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -1774,6 +2987,11 @@ func (in *VaultDynamicSecretSpec) DeepCopyInto(out *VaultDynamicSecretSpec) {
 		*out = new(externalsecretsv1.VaultProvider)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Inputs != nil {
+		in, out := &in.Inputs, &out.Inputs
+		*out = make([]GeneratorInput, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VaultDynamicSecretSpec.
@@ -1931,6 +3149,11 @@ func (in *WebhookSpec) DeepCopyInto(out *WebhookSpec) {
 		*out = new(WebhookCAProvider)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Inputs != nil {
+		in, out := &in.Inputs, &out.Inputs
+		*out = make([]GeneratorInput, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WebhookSpec.
@@ -1942,3 +3165,76 @@ func (in *WebhookSpec) DeepCopy() *WebhookSpec {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WireGuardKey) DeepCopyInto(out *WireGuardKey) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	out.Spec = in.Spec
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WireGuardKey.
+func (in *WireGuardKey) DeepCopy() *WireGuardKey {
+	if in == nil {
+		return nil
+	}
+	out := new(WireGuardKey)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *WireGuardKey) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WireGuardKeyList) DeepCopyInto(out *WireGuardKeyList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]WireGuardKey, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WireGuardKeyList.
+func (in *WireGuardKeyList) DeepCopy() *WireGuardKeyList {
+	if in == nil {
+		return nil
+	}
+	out := new(WireGuardKeyList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *WireGuardKeyList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WireGuardKeySpec) DeepCopyInto(out *WireGuardKeySpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WireGuardKeySpec.
+func (in *WireGuardKeySpec) DeepCopy() *WireGuardKeySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(WireGuardKeySpec)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -17,6 +17,7 @@ limitations under the License.
 package controller
 
 import (
+	"context"
 	"os"
 	"time"
 
@@ -36,6 +37,7 @@ import (
 	esv1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1"
 	esv1alpha1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1alpha1"
 	genv1alpha1 "github.com/external-secrets/external-secrets/apis/generators/v1alpha1"
+	"github.com/external-secrets/external-secrets/pkg/audit"
 	"github.com/external-secrets/external-secrets/pkg/controllers/clusterexternalsecret"
 	"github.com/external-secrets/external-secrets/pkg/controllers/clusterexternalsecret/cesmetrics"
 	"github.com/external-secrets/external-secrets/pkg/controllers/clusterpushsecret"
@@ -51,6 +53,7 @@ import (
 	"github.com/external-secrets/external-secrets/pkg/controllers/secretstore/cssmetrics"
 	"github.com/external-secrets/external-secrets/pkg/controllers/secretstore/ssmetrics"
 	"github.com/external-secrets/external-secrets/pkg/feature"
+	"github.com/external-secrets/external-secrets/pkg/tracing"
 
 	// To allow using gcp auth.
 	_ "k8s.io/client-go/plugin/pkg/client/auth"
@@ -83,6 +86,12 @@ var (
 	enableFloodGate                       bool
 	enableGeneratorState                  bool
 	enableExtendedMetricLabels            bool
+	enableCertificateExpiry               bool
+	certificateExpiryThresholds           []time.Duration
+	tracingEndpoint                       string
+	tracingProtocol                       string
+	tracingInsecure                       bool
+	tracingSamplingRatio                  float64
 	storeRequeueInterval                  time.Duration
 	serviceName, serviceNamespace         string
 	secretName, secretNamespace           string
@@ -116,6 +125,18 @@ var rootCmd = &cobra.Command{
 	Run: func(cmd *cobra.Command, args []string) {
 		setupLogger()
 
+		shutdownTracing, err := tracing.Setup(context.Background(), tracing.Options{
+			Endpoint:      tracingEndpoint,
+			Protocol:      tracingProtocol,
+			Insecure:      tracingInsecure,
+			SamplingRatio: tracingSamplingRatio,
+			ServiceName:   "external-secrets",
+		})
+		if err != nil {
+			setupLog.Error(err, "unable to set up tracing")
+			os.Exit(1)
+		}
+
 		ctrlmetrics.SetUpLabelNames(enableExtendedMetricLabels)
 		esmetrics.SetUpMetrics()
 		config := ctrl.GetConfigOrDie()
@@ -219,16 +240,18 @@ var rootCmd = &cobra.Command{
 			os.Exit(1)
 		}
 		if err = (&externalsecret.Reconciler{
-			Client:                    mgr.GetClient(),
-			SecretClient:              secretClient,
-			Log:                       ctrl.Log.WithName("controllers").WithName("ExternalSecret"),
-			Scheme:                    mgr.GetScheme(),
-			RestConfig:                mgr.GetConfig(),
-			ControllerClass:           controllerClass,
-			RequeueInterval:           time.Hour,
-			ClusterSecretStoreEnabled: enableClusterStoreReconciler,
-			EnableFloodGate:           enableFloodGate,
-			EnableGeneratorState:      enableGeneratorState,
+			Client:                      mgr.GetClient(),
+			SecretClient:                secretClient,
+			Log:                         ctrl.Log.WithName("controllers").WithName("ExternalSecret"),
+			Scheme:                      mgr.GetScheme(),
+			RestConfig:                  mgr.GetConfig(),
+			ControllerClass:             controllerClass,
+			RequeueInterval:             time.Hour,
+			ClusterSecretStoreEnabled:   enableClusterStoreReconciler,
+			EnableFloodGate:             enableFloodGate,
+			EnableGeneratorState:        enableGeneratorState,
+			EnableCertificateExpiry:     enableCertificateExpiry,
+			CertificateExpiryThresholds: certificateExpiryThresholds,
 		}).SetupWithManager(mgr, controller.Options{
 			MaxConcurrentReconciles: concurrent,
 			RateLimiter:             ctrlcommon.BuildRateLimiter(),
@@ -295,7 +318,14 @@ var rootCmd = &cobra.Command{
 			f.Initialize()
 		}
 		setupLog.Info("starting manager")
-		if err := mgr.Start(ctrl.SetupSignalHandler()); err != nil {
+		err = mgr.Start(ctrl.SetupSignalHandler())
+		if closeErr := audit.Close(context.Background()); closeErr != nil {
+			setupLog.Error(closeErr, "unable to flush audit log")
+		}
+		if shutdownErr := shutdownTracing(context.Background()); shutdownErr != nil {
+			setupLog.Error(shutdownErr, "unable to flush traces")
+		}
+		if err != nil {
 			setupLog.Error(err, "problem running manager")
 			os.Exit(1)
 		}
@@ -329,6 +359,12 @@ func init() {
 	rootCmd.Flags().BoolVar(&enableFloodGate, "enable-flood-gate", true, "Enable flood gate. External secret will be reconciled only if the ClusterStore or Store have an healthy or unknown state.")
 	rootCmd.Flags().BoolVar(&enableGeneratorState, "enable-generator-state", true, "Whether the Controller should manage GeneratorState")
 	rootCmd.Flags().BoolVar(&enableExtendedMetricLabels, "enable-extended-metric-labels", false, "Enable recommended kubernetes annotations as labels in metrics.")
+	rootCmd.Flags().BoolVar(&enableCertificateExpiry, "enable-certificate-expiry-tracking", false, "Enable parsing PEM, DER and PKCS#12 certificates in target secrets to track their expiry in the ExternalSecret status and metrics.")
+	rootCmd.Flags().DurationSliceVar(&certificateExpiryThresholds, "certificate-expiry-warning-thresholds", externalsecret.DefaultCertificateExpiryThresholds, "Remaining certificate lifetimes at which a warning event is emitted on the ExternalSecret.")
+	rootCmd.Flags().StringVar(&tracingEndpoint, "tracing-otlp-endpoint", "", "host:port of the OTLP receiver to export traces to. Tracing is disabled if empty.")
+	rootCmd.Flags().StringVar(&tracingProtocol, "tracing-otlp-protocol", tracing.ProtocolGRPC, "Protocol used to export traces, one of: grpc, http/protobuf")
+	rootCmd.Flags().BoolVar(&tracingInsecure, "tracing-otlp-insecure", false, "Disable TLS when exporting traces.")
+	rootCmd.Flags().Float64Var(&tracingSamplingRatio, "tracing-sampling-ratio", 1, "Ratio of reconciles that are traced, between 0 and 1.")
 	fs := feature.Features()
 	for _, f := range fs {
 		rootCmd.Flags().AddFlagSet(f.Flags)
@@ -0,0 +1,160 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"time"
+
+	"github.com/spf13/cobra"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	esv1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1"
+
+	// Loading registered providers.
+	_ "github.com/external-secrets/external-secrets/pkg/provider/register"
+)
+
+var (
+	getStore        string
+	getClusterStore bool
+	getKey          string
+	getProperty     string
+	getVersion      string
+	getFindRegexp   string
+	getShowValues   bool
+)
+
+func init() {
+	rootCmd.AddCommand(getCmd)
+	addKubeFlags(getCmd)
+	getCmd.Flags().StringVar(&getStore, "store", "", "Name of the SecretStore to fetch the secret from")
+	getCmd.Flags().BoolVar(&getClusterStore, "cluster-store", false, "If set, --store refers to a ClusterSecretStore")
+	getCmd.Flags().StringVar(&getKey, "key", "", "Key of the secret in the provider")
+	getCmd.Flags().StringVar(&getProperty, "property", "", "Property of the secret to fetch")
+	getCmd.Flags().StringVar(&getVersion, "version", "", "Version of the secret to fetch")
+	getCmd.Flags().StringVar(&getFindRegexp, "find-regexp", "", "Fetch all secrets with a name matching this regular expression")
+	getCmd.Flags().BoolVar(&getShowValues, "show-values", false, "If set, secret values are printed instead of being redacted")
+	_ = getCmd.MarkFlagRequired("store")
+	getCmd.MarkFlagsOneRequired("key", "find-regexp")
+	getCmd.MarkFlagsMutuallyExclusive("key", "find-regexp")
+}
+
+var getCmd = &cobra.Command{
+	Use:   "get",
+	Short: "fetches secrets from a SecretStore",
+	Long: `Fetches secrets from a SecretStore or ClusterSecretStore of a cluster using the credentials of the local kubeconfig.
+The provider client is created the same way as in the controller, so the result matches what the controller sees.
+Secret values are redacted unless --show-values is set.`,
+	RunE: getRun,
+}
+
+func getRun(_ *cobra.Command, _ []string) error {
+	ctx := context.Background()
+	scheme, err := newScheme()
+	if err != nil {
+		return err
+	}
+	kube, ns, err := newKubeClient(scheme)
+	if err != nil {
+		return err
+	}
+
+	var store esv1.GenericStore = &esv1.SecretStore{}
+	if getClusterStore {
+		store = &esv1.ClusterSecretStore{}
+	}
+	if err := kube.Get(ctx, client.ObjectKey{Namespace: ns, Name: getStore}, store); err != nil {
+		return fmt.Errorf("could not get store: %w", err)
+	}
+	// the kind is not set on objects returned by the client
+	kind := esv1.SecretStoreKind
+	if getClusterStore {
+		kind = esv1.ClusterSecretStoreKind
+	}
+	store.GetObjectKind().SetGroupVersionKind(esv1.SchemeGroupVersion.WithKind(kind))
+
+	providerName, err := esv1.GetProviderName(store)
+	if err != nil {
+		return err
+	}
+	provider, err := esv1.GetProvider(store)
+	if err != nil {
+		return err
+	}
+	out := os.Stdout
+	_, _ = fmt.Fprintf(out, "store: %s %s (provider %s)\n", kind, getStore, providerName)
+
+	start := time.Now()
+	secretsClient, err := provider.NewClient(ctx, store, kube, ns)
+	if err != nil {
+		return fmt.Errorf("could not create provider client: %w", err)
+	}
+	defer func() {
+		_ = secretsClient.Close(ctx)
+	}()
+	_, _ = fmt.Fprintf(out, "client created in %s\n", time.Since(start).Round(time.Millisecond))
+
+	start = time.Now()
+	result, err := secretsClient.Validate()
+	if err != nil {
+		_, _ = fmt.Fprintf(out, "validate: %s in %s: %v\n", result, time.Since(start).Round(time.Millisecond), err)
+	} else {
+		_, _ = fmt.Fprintf(out, "validate: %s in %s\n", result, time.Since(start).Round(time.Millisecond))
+	}
+
+	start = time.Now()
+	var secrets map[string][]byte
+	if getFindRegexp != "" {
+		secrets, err = secretsClient.GetAllSecrets(ctx, esv1.ExternalSecretFind{
+			Name: &esv1.FindName{RegExp: getFindRegexp},
+		})
+	} else {
+		var value []byte
+		value, err = secretsClient.GetSecret(ctx, esv1.ExternalSecretDataRemoteRef{
+			Key:      getKey,
+			Property: getProperty,
+			Version:  getVersion,
+		})
+		secrets = map[string][]byte{getKey: value}
+	}
+	if err != nil {
+		return fmt.Errorf("could not get secret after %s: %w", time.Since(start).Round(time.Millisecond), err)
+	}
+	_, _ = fmt.Fprintf(out, "fetched %d secret(s) in %s\n", len(secrets), time.Since(start).Round(time.Millisecond))
+	return printSecrets(out, secrets, getShowValues)
+}
+
+func printSecrets(out io.Writer, secrets map[string][]byte, showValues bool) error {
+	keys := make([]string, 0, len(secrets))
+	for key := range secrets {
+		keys = append(keys, key)
+	}
+	slices.Sort(keys)
+	for _, key := range keys {
+		value := fmt.Sprintf("<redacted, %d bytes>", len(secrets[key]))
+		if showValues {
+			value = string(secrets[key])
+		}
+		if _, err := fmt.Fprintf(out, "%s: %s\n", key, value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,52 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPrintSecrets(t *testing.T) {
+	secrets := map[string][]byte{
+		"db/password": []byte("s3cret"),
+		"api/token":   []byte("t0ken-value"),
+	}
+	tests := []struct {
+		name       string
+		showValues bool
+		want       string
+	}{
+		{
+			name: "values are redacted",
+			want: "api/token: <redacted, 11 bytes>\ndb/password: <redacted, 6 bytes>\n",
+		},
+		{
+			name:       "values are shown",
+			showValues: true,
+			want:       "api/token: t0ken-value\ndb/password: s3cret\n",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var out bytes.Buffer
+			require.NoError(t, printSecrets(&out, secrets, tt.showValues))
+			assert.Equal(t, tt.want, out.String())
+		})
+	}
+}
@@ -0,0 +1,61 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/clientcmd"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+var (
+	kubeconfig  string
+	kubeContext string
+	namespace   string
+)
+
+// addKubeFlags adds the flags to select the cluster and namespace to a command.
+func addKubeFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVar(&kubeconfig, "kubeconfig", "", "Path to the kubeconfig file. Defaults to $KUBECONFIG or ~/.kube/config")
+	cmd.Flags().StringVar(&kubeContext, "context", "", "The kubeconfig context to use. Defaults to the current context")
+	cmd.Flags().StringVarP(&namespace, "namespace", "n", "", "The namespace to use. Defaults to the namespace of the kubeconfig context")
+}
+
+// newKubeClient returns a client for the cluster selected by the kube flags
+// and the namespace to use.
+func newKubeClient(scheme *runtime.Scheme) (client.Client, string, error) {
+	rules := clientcmd.NewDefaultClientConfigLoadingRules()
+	rules.ExplicitPath = kubeconfig
+	overrides := &clientcmd.ConfigOverrides{CurrentContext: kubeContext}
+	overrides.Context.Namespace = namespace
+	config := clientcmd.NewNonInteractiveDeferredLoadingClientConfig(rules, overrides)
+
+	restConfig, err := config.ClientConfig()
+	if err != nil {
+		return nil, "", fmt.Errorf("could not load kubeconfig: %w", err)
+	}
+	ns, _, err := config.Namespace()
+	if err != nil {
+		return nil, "", fmt.Errorf("could not get namespace: %w", err)
+	}
+	kube, err := client.New(restConfig, client.Options{Scheme: scheme})
+	if err != nil {
+		return nil, "", fmt.Errorf("could not create kubernetes client: %w", err)
+	}
+	return kube, ns, nil
+}
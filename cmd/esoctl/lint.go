@@ -0,0 +1,511 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"io/fs"
+	"maps"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/spf13/cobra"
+	apiextensionsinternal "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	structuralschema "k8s.io/apiextensions-apiserver/pkg/apiserver/schema"
+	structuraldefaulting "k8s.io/apiextensions-apiserver/pkg/apiserver/schema/defaulting"
+	"k8s.io/apiextensions-apiserver/pkg/apiserver/schema/pruning"
+	"k8s.io/apiextensions-apiserver/pkg/apiserver/validation"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+	"sigs.k8s.io/yaml"
+
+	esv1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1"
+	"github.com/external-secrets/external-secrets/apis/externalsecrets/v1alpha1"
+	genv1alpha1 "github.com/external-secrets/external-secrets/apis/generators/v1alpha1"
+	"github.com/external-secrets/external-secrets/deploy/crds"
+	templatev2 "github.com/external-secrets/external-secrets/pkg/template/v2"
+
+	// Loading registered providers.
+	_ "github.com/external-secrets/external-secrets/pkg/provider/register"
+)
+
+const (
+	lintFormatText  = "text"
+	lintFormatJSON  = "json"
+	lintFormatSARIF = "sarif"
+)
+
+var lintFormat string
+
+func init() {
+	rootCmd.AddCommand(lintCmd)
+	lintCmd.Flags().StringVarP(&lintFormat, "format", "o", lintFormatText, "Output format, one of text, json or sarif")
+}
+
+var lintCmd = &cobra.Command{
+	Use:   "lint [file or directory]...",
+	Short: "validates ExternalSecret, SecretStore and PushSecret manifests",
+	Long: `Validates manifests without a cluster. Directories are searched recursively for yaml and json files.
+Objects are validated against the CRD schema and with the same checks as the admission webhooks.
+Templates are parsed, references to stores and generators are resolved within the linted files
+and deprecated api versions and fields are reported.
+The command fails if any error is found.`,
+	Args:         cobra.MinimumNArgs(1),
+	SilenceUsage: true,
+	RunE:         lintRun,
+}
+
+func lintRun(_ *cobra.Command, args []string) error {
+	if !slices.Contains([]string{lintFormatText, lintFormatJSON, lintFormatSARIF}, lintFormat) {
+		return fmt.Errorf("unsupported format %q", lintFormat)
+	}
+	l, err := newLinter()
+	if err != nil {
+		return err
+	}
+	files, err := collectFiles(args)
+	if err != nil {
+		return err
+	}
+	for _, file := range files {
+		documents, err := readDocuments(file)
+		if err != nil {
+			l.report(lintObject{file: file, line: 1}, ruleParse, severityError, "%v", err)
+			continue
+		}
+		for _, doc := range documents {
+			l.add(file, doc)
+		}
+	}
+	findings := l.lint()
+
+	if err := writeFindings(os.Stdout, lintFormat, findings); err != nil {
+		return err
+	}
+	errorCount := 0
+	for _, f := range findings {
+		if f.Severity == severityError {
+			errorCount++
+		}
+	}
+	if errorCount > 0 {
+		return fmt.Errorf("found %d error(s)", errorCount)
+	}
+	return nil
+}
+
+// collectFiles returns the yaml and json files of the given files and directories.
+func collectFiles(paths []string) ([]string, error) {
+	var files []string
+	for _, path := range paths {
+		err := filepath.WalkDir(path, func(p string, d fs.DirEntry, err error) error {
+			if err != nil {
+				return err
+			}
+			if d.IsDir() {
+				return nil
+			}
+			// explicitly passed files are linted regardless of their extension
+			if p == path || slices.Contains([]string{".yaml", ".yml", ".json"}, filepath.Ext(p)) {
+				files = append(files, p)
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	return files, nil
+}
+
+type severity string
+
+const (
+	severityError   severity = "error"
+	severityWarning severity = "warning"
+)
+
+const (
+	ruleParse            = "parse"
+	ruleSchema           = "schema"
+	ruleUnknownField     = "unknown-field"
+	ruleValidation       = "validation"
+	ruleTemplate         = "template"
+	ruleUnknownFunction  = "unknown-function"
+	ruleMissingReference = "missing-reference"
+	ruleDeprecated       = "deprecated"
+)
+
+// lintRules describes all rules, in the order they are reported in sarif.
+var lintRules = []struct {
+	id          string
+	description string
+}{
+	{ruleParse, "The file is not valid yaml or json."},
+	{ruleSchema, "The object does not match the schema of its CustomResourceDefinition."},
+	{ruleUnknownField, "The object contains a field that is not part of its CustomResourceDefinition and would be dropped."},
+	{ruleValidation, "The object is rejected or warned about by the admission webhook."},
+	{ruleTemplate, "A template can not be parsed."},
+	{ruleUnknownFunction, "A template calls a function that does not exist."},
+	{ruleMissingReference, "A referenced store or generator is not part of the linted files."},
+	{ruleDeprecated, "The object uses a deprecated api version or field."},
+}
+
+// finding is a problem found in a manifest.
+type finding struct {
+	File      string   `json:"file"`
+	Line      int      `json:"line"`
+	Kind      string   `json:"kind,omitempty"`
+	Namespace string   `json:"namespace,omitempty"`
+	Name      string   `json:"name,omitempty"`
+	Rule      string   `json:"rule"`
+	Severity  severity `json:"severity"`
+	Message   string   `json:"message"`
+}
+
+type lintObject struct {
+	file string
+	line int
+	obj  *unstructured.Unstructured
+}
+
+type crdVersion struct {
+	schema     *apiextensionsinternal.JSONSchemaProps
+	structural *structuralschema.Structural
+	validator  validation.SchemaCreateValidator
+	deprecated bool
+	warning    string
+}
+
+type linter struct {
+	scheme   *runtime.Scheme
+	versions map[schema.GroupVersionKind]*crdVersion
+	objects  []lintObject
+	findings []finding
+}
+
+func newLinter() (*linter, error) {
+	scheme, err := newScheme()
+	if err != nil {
+		return nil, err
+	}
+	l := &linter{
+		scheme:   scheme,
+		versions: make(map[schema.GroupVersionKind]*crdVersion),
+		findings: []finding{},
+	}
+	for _, raw := range strings.Split(string(crds.Bundle), "\n---\n") {
+		crd := &apiextensionsv1.CustomResourceDefinition{}
+		if err := yaml.Unmarshal([]byte(raw), crd); err != nil {
+			return nil, fmt.Errorf("could not read CustomResourceDefinitions: %w", err)
+		}
+		if crd.Name == "" {
+			continue
+		}
+		for _, v := range crd.Spec.Versions {
+			if v.Schema == nil || v.Schema.OpenAPIV3Schema == nil {
+				continue
+			}
+			props := &apiextensionsinternal.JSONSchemaProps{}
+			if err := apiextensionsv1.Convert_v1_JSONSchemaProps_To_apiextensions_JSONSchemaProps(v.Schema.OpenAPIV3Schema, props, nil); err != nil {
+				return nil, err
+			}
+			structural, err := structuralschema.NewStructural(props)
+			if err != nil {
+				return nil, err
+			}
+			validator, _, err := validation.NewSchemaValidator(props)
+			if err != nil {
+				return nil, err
+			}
+			gvk := schema.GroupVersionKind{Group: crd.Spec.Group, Version: v.Name, Kind: crd.Spec.Names.Kind}
+			l.versions[gvk] = &crdVersion{
+				schema:     props,
+				structural: structural,
+				validator:  validator,
+				deprecated: v.Deprecated,
+				warning:    ptrValue(v.DeprecationWarning),
+			}
+		}
+	}
+	return l, nil
+}
+
+func ptrValue(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
+
+func (l *linter) add(file string, doc document) {
+	l.objects = append(l.objects, lintObject{file: file, line: doc.line, obj: doc.object})
+}
+
+func (l *linter) report(o lintObject, rule string, sev severity, format string, args ...any) {
+	f := finding{
+		File:     o.file,
+		Line:     o.line,
+		Rule:     rule,
+		Severity: sev,
+		Message:  fmt.Sprintf(format, args...),
+	}
+	if o.obj != nil {
+		f.Kind = o.obj.GetKind()
+		f.Namespace = o.obj.GetNamespace()
+		f.Name = o.obj.GetName()
+	}
+	l.findings = append(l.findings, f)
+}
+
+// lint checks all added objects and returns the findings.
+func (l *linter) lint() []finding {
+	for _, o := range l.objects {
+		gvk := o.obj.GroupVersionKind()
+		// objects of other api groups may be part of the same bundle
+		if gvk.Group != esv1.Group && gvk.Group != genv1alpha1.Group {
+			continue
+		}
+		version, ok := l.versions[gvk]
+		if !ok {
+			l.report(o, ruleSchema, severityError, "unknown kind %s", gvk)
+			continue
+		}
+		if !l.lintSchema(o, version) {
+			continue
+		}
+		l.lintDeprecated(o, version)
+
+		typed, err := toTyped(l.scheme, o.obj)
+		if err != nil {
+			l.report(o, ruleSchema, severityError, "%v", err)
+			continue
+		}
+		switch obj := typed.(type) {
+		case *esv1.ExternalSecret:
+			setDefaults(obj)
+			l.lintValidator(o, &esv1.ExternalSecretValidator{}, obj)
+			l.lintExternalSecretSpec(o, "spec", obj.Spec, obj.Namespace)
+		case *esv1.ClusterExternalSecret:
+			es := &esv1.ExternalSecret{ObjectMeta: obj.ObjectMeta, Spec: obj.Spec.ExternalSecretSpec}
+			setDefaults(es)
+			l.lintValidator(o, &esv1.ExternalSecretValidator{}, es)
+			l.lintExternalSecretSpec(o, "spec.externalSecretSpec", obj.Spec.ExternalSecretSpec, "")
+		case *esv1.SecretStore, *esv1.ClusterSecretStore:
+			l.lintValidator(o, &esv1.GenericStoreValidator{}, obj)
+		case *v1alpha1.PushSecret:
+			l.lintPushSecret(o, obj)
+		}
+	}
+	return l.findings
+}
+
+// lintSchema validates the object against the schema of its CRD.
+// It returns false if the object is invalid.
+func (l *linter) lintSchema(o lintObject, version *crdVersion) bool {
+	valid := true
+	if o.obj.GetName() == "" {
+		l.report(o, ruleSchema, severityError, "metadata.name: Required value")
+		valid = false
+	}
+	// the API server applies the defaults of the CRD before validating the object
+	defaulted := o.obj.DeepCopy().Object
+	structuraldefaulting.Default(defaulted, version.structural)
+	for _, err := range validation.ValidateCustomResource(nil, defaulted, version.validator) {
+		l.report(o, ruleSchema, severityError, "%s", err.Error())
+		valid = false
+	}
+	pruned := pruning.PruneWithOptions(o.obj.DeepCopy().Object, version.structural, true, structuralschema.UnknownFieldPathOptions{
+		TrackUnknownFieldPaths: true,
+	})
+	// unknown fields are dropped by the API server, so the remaining checks still apply
+	for _, path := range pruned {
+		l.report(o, ruleUnknownField, severityError, "unknown field %q", path)
+	}
+	return valid
+}
+
+// lintDeprecated reports deprecated api versions and fields
+// which are marked as deprecated in the CRD schema.
+func (l *linter) lintDeprecated(o lintObject, version *crdVersion) {
+	if version.deprecated {
+		msg := version.warning
+		if msg == "" {
+			msg = fmt.Sprintf("%s is deprecated", o.obj.GetAPIVersion())
+		}
+		l.report(o, ruleDeprecated, severityWarning, "%s", msg)
+	}
+	var walk func(path string, value any, props *apiextensionsinternal.JSONSchemaProps)
+	walk = func(path string, value any, props *apiextensionsinternal.JSONSchemaProps) {
+		if props == nil {
+			return
+		}
+		if path != "" && strings.Contains(props.Description, "Deprecated") {
+			l.report(o, ruleDeprecated, severityWarning, "%s is deprecated: %s", path, deprecationNotice(props.Description))
+		}
+		switch v := value.(type) {
+		case map[string]any:
+			for _, key := range slices.Sorted(maps.Keys(v)) {
+				if childProps, ok := props.Properties[key]; ok {
+					walk(joinPath(path, key), v[key], &childProps)
+				}
+			}
+		case []any:
+			if props.Items == nil || props.Items.Schema == nil {
+				return
+			}
+			for i, child := range v {
+				walk(fmt.Sprintf("%s[%d]", path, i), child, props.Items.Schema)
+			}
+		}
+	}
+	walk("", o.obj.Object, version.schema)
+}
+
+func joinPath(path, key string) string {
+	if path == "" {
+		return key
+	}
+	return path + "." + key
+}
+
+// deprecationNotice returns the deprecation notice of a field description.
+func deprecationNotice(description string) string {
+	notice := description[strings.Index(description, "Deprecated"):]
+	notice = strings.TrimLeft(strings.TrimPrefix(notice, "Deprecated"), ": ")
+	return strings.Join(strings.Fields(notice), " ")
+}
+
+func (l *linter) lintValidator(o lintObject, validator admission.CustomValidator, obj runtime.Object) {
+	warnings, err := validator.ValidateCreate(context.Background(), obj)
+	for _, warning := range warnings {
+		l.report(o, ruleValidation, severityWarning, "%s", warning)
+	}
+	if err != nil {
+		for _, err := range unjoin(err) {
+			l.report(o, ruleValidation, severityError, "%v", err)
+		}
+	}
+}
+
+// unjoin returns the errors joined by errors.Join.
+func unjoin(err error) []error {
+	if joined, ok := err.(interface{ Unwrap() []error }); ok {
+		return joined.Unwrap()
+	}
+	return []error{err}
+}
+
+func (l *linter) lintExternalSecretSpec(o lintObject, path string, spec esv1.ExternalSecretSpec, namespace string) {
+	if spec.SecretStoreRef.Name != "" {
+		l.lintStoreRef(o, path+".secretStoreRef", spec.SecretStoreRef.Kind, spec.SecretStoreRef.Name, namespace)
+	}
+	for i, data := range spec.Data {
+		if data.SourceRef != nil && data.SourceRef.SecretStoreRef.Name != "" {
+			l.lintStoreRef(o, fmt.Sprintf("%s.data[%d].sourceRef.storeRef", path, i), data.SourceRef.SecretStoreRef.Kind, data.SourceRef.SecretStoreRef.Name, namespace)
+		}
+	}
+	for i, dataFrom := range spec.DataFrom {
+		if dataFrom.SourceRef == nil {
+			continue
+		}
+		refPath := fmt.Sprintf("%s.dataFrom[%d].sourceRef", path, i)
+		if ref := dataFrom.SourceRef.SecretStoreRef; ref != nil && ref.Name != "" {
+			l.lintStoreRef(o, refPath+".storeRef", ref.Kind, ref.Name, namespace)
+		}
+		if ref := dataFrom.SourceRef.GeneratorRef; ref != nil {
+			l.lintGeneratorRef(o, refPath+".generatorRef", ref, namespace)
+		}
+	}
+	l.lintTemplate(o, path+".target.template", spec.Target.Template)
+}
+
+func (l *linter) lintPushSecret(o lintObject, ps *v1alpha1.PushSecret) {
+	for i, ref := range ps.Spec.SecretStoreRefs {
+		if ref.Name != "" {
+			l.lintStoreRef(o, fmt.Sprintf("spec.secretStoreRefs[%d]", i), ref.Kind, ref.Name, ps.Namespace)
+		}
+	}
+	if ref := ps.Spec.Selector.GeneratorRef; ref != nil {
+		l.lintGeneratorRef(o, "spec.selector.generatorRef", ref, ps.Namespace)
+	}
+	l.lintTemplate(o, "spec.template", ps.Spec.Template)
+}
+
+func (l *linter) lintStoreRef(o lintObject, path, kind, name, namespace string) {
+	if kind == "" {
+		kind = esv1.SecretStoreKind
+	}
+	if kind == esv1.ClusterSecretStoreKind {
+		namespace = ""
+	}
+	if !l.exists(esv1.Group, kind, name, namespace) {
+		l.report(o, ruleMissingReference, severityWarning, "%s: %s %q not found", path, kind, name)
+	}
+}
+
+func (l *linter) lintGeneratorRef(o lintObject, path string, ref *esv1.GeneratorRef, namespace string) {
+	if ref.Kind == genv1alpha1.ClusterGeneratorKind {
+		namespace = ""
+	}
+	if !l.exists(genv1alpha1.Group, ref.Kind, ref.Name, namespace) {
+		l.report(o, ruleMissingReference, severityWarning, "%s: %s %q not found", path, ref.Kind, ref.Name)
+	}
+}
+
+// exists returns true if an object with the given kind and name has been added.
+// An empty namespace matches all namespaces, as manifests often omit the namespace.
+func (l *linter) exists(group, kind, name, namespace string) bool {
+	return slices.ContainsFunc(l.objects, func(o lintObject) bool {
+		gvk := o.obj.GroupVersionKind()
+		ns := o.obj.GetNamespace()
+		return gvk.Group == group && gvk.Kind == kind && o.obj.GetName() == name &&
+			(namespace == "" || ns == "" || ns == namespace)
+	})
+}
+
+// lintTemplate parses all templates of a v2 template.
+func (l *linter) lintTemplate(o lintObject, path string, tmpl *esv1.ExternalSecretTemplate) {
+	if tmpl == nil || (tmpl.EngineVersion != "" && tmpl.EngineVersion != esv1.TemplateEngineV2) {
+		return
+	}
+	parse := func(fieldPath string, templates map[string]string) {
+		for _, key := range slices.Sorted(maps.Keys(templates)) {
+			err := templatev2.Parse(key, templates[key])
+			if err == nil {
+				continue
+			}
+			rule := ruleTemplate
+			if strings.Contains(err.Error(), "not defined") {
+				rule = ruleUnknownFunction
+			}
+			l.report(o, rule, severityError, "%s.%s: %v", fieldPath, key, err)
+		}
+	}
+	parse(path+".data", tmpl.Data)
+	parse(path+".metadata.labels", tmpl.Metadata.Labels)
+	parse(path+".metadata.annotations", tmpl.Metadata.Annotations)
+	for i, from := range tmpl.TemplateFrom {
+		if from.Literal != nil {
+			parse(fmt.Sprintf("%s.templateFrom[%d]", path, i), map[string]string{"literal": *from.Literal})
+		}
+	}
+}
@@ -0,0 +1,252 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const lintStore = `apiVersion: external-secrets.io/v1
+kind: SecretStore
+metadata:
+  name: vault
+  namespace: default
+spec:
+  provider:
+    aws:
+      service: SecretsManager
+      region: eu-west-1
+`
+
+// lintManifest lints the manifest as if it was read from a file.
+func lintManifest(t *testing.T, manifest string) []finding {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "manifest.yaml")
+	require.NoError(t, os.WriteFile(path, []byte(manifest), 0o600))
+	documents, err := readDocuments(path)
+	require.NoError(t, err)
+	l, err := newLinter()
+	require.NoError(t, err)
+	for _, doc := range documents {
+		l.add("manifest.yaml", doc)
+	}
+	return l.lint()
+}
+
+func TestLint(t *testing.T) {
+	tests := []struct {
+		name     string
+		manifest string
+		want     []finding
+	}{
+		{
+			name: "valid ExternalSecret",
+			manifest: lintStore + `---
+apiVersion: external-secrets.io/v1
+kind: ExternalSecret
+metadata:
+  name: app
+  namespace: default
+spec:
+  secretStoreRef:
+    name: vault
+  target:
+    template:
+      data:
+        url: "https://{{ .user }}@example.com"
+  data:
+  - secretKey: user
+    remoteRef:
+      key: app/user
+`,
+			want: []finding{},
+		},
+		{
+			name: "missing store",
+			manifest: `apiVersion: external-secrets.io/v1
+kind: ExternalSecret
+metadata:
+  name: app
+spec:
+  secretStoreRef:
+    name: vault
+  data:
+  - secretKey: user
+    remoteRef:
+      key: app/user
+`,
+			want: []finding{{
+				File: "manifest.yaml", Line: 1, Kind: "ExternalSecret", Name: "app",
+				Rule: ruleMissingReference, Severity: severityWarning,
+				Message: `spec.secretStoreRef: SecretStore "vault" not found`,
+			}},
+		},
+		{
+			name: "unknown field and missing name",
+			manifest: lintStore + `---
+apiVersion: external-secrets.io/v1
+kind: ExternalSecret
+metadata:
+  namespace: default
+spec:
+  secretStoreRef:
+    name: vault
+  refreshIntervall: 1h
+  data:
+  - secretKey: user
+    remoteRef:
+      key: app/user
+`,
+			want: []finding{
+				{
+					File: "manifest.yaml", Line: 12, Kind: "ExternalSecret", Namespace: "default",
+					Rule: ruleSchema, Severity: severityError,
+					Message: "metadata.name: Required value",
+				},
+				{
+					File: "manifest.yaml", Line: 12, Kind: "ExternalSecret", Namespace: "default",
+					Rule: ruleUnknownField, Severity: severityError,
+					Message: `unknown field "spec.refreshIntervall"`,
+				},
+			},
+		},
+		{
+			name: "invalid templates",
+			manifest: lintStore + `---
+apiVersion: external-secrets.io/v1
+kind: ExternalSecret
+metadata:
+  name: app
+  namespace: default
+spec:
+  secretStoreRef:
+    name: vault
+  target:
+    template:
+      data:
+        parse: "{{ .user "
+        unknown: "{{ .user | nosuchfunc }}"
+  data:
+  - secretKey: user
+    remoteRef:
+      key: app/user
+`,
+			want: []finding{
+				{
+					File: "manifest.yaml", Line: 12, Kind: "ExternalSecret", Namespace: "default", Name: "app",
+					Rule: ruleTemplate, Severity: severityError,
+					Message: `spec.target.template.data.parse: unable to parse template at key parse: template: parse:1: unclosed action`,
+				},
+				{
+					File: "manifest.yaml", Line: 12, Kind: "ExternalSecret", Namespace: "default", Name: "app",
+					Rule: ruleUnknownFunction, Severity: severityError,
+					Message: `spec.target.template.data.unknown: unable to parse template at key unknown: template: unknown:1: function "nosuchfunc" not defined`,
+				},
+			},
+		},
+		{
+			name: "missing generator of a PushSecret",
+			manifest: lintStore + `---
+apiVersion: external-secrets.io/v1alpha1
+kind: PushSecret
+metadata:
+  name: push
+  namespace: default
+spec:
+  secretStoreRefs:
+  - name: vault
+  selector:
+    generatorRef:
+      apiVersion: generators.external-secrets.io/v1alpha1
+      kind: Password
+      name: pw
+  data:
+  - match:
+      secretKey: password
+      remoteRef:
+        remoteKey: app/password
+`,
+			want: []finding{{
+				File: "manifest.yaml", Line: 12, Kind: "PushSecret", Namespace: "default", Name: "push",
+				Rule: ruleMissingReference, Severity: severityWarning,
+				Message: `spec.selector.generatorRef: Password "pw" not found`,
+			}},
+		},
+		{
+			name: "deprecated field",
+			manifest: `apiVersion: external-secrets.io/v1
+kind: SecretStore
+metadata:
+  name: vault
+spec:
+  provider:
+    vault:
+      server: https://vault.example.com
+      auth:
+        jwt:
+          path: jwt
+          kubernetesServiceAccountToken:
+            audiences: [vault]
+            serviceAccountRef:
+              name: default
+`,
+			want: []finding{{
+				File: "manifest.yaml", Line: 1, Kind: "SecretStore", Name: "vault",
+				Rule: ruleDeprecated, Severity: severityWarning,
+				Message: "spec.provider.vault.auth.jwt.kubernetesServiceAccountToken.audiences is deprecated: use serviceAccountRef.Audiences instead",
+			}},
+		},
+		{
+			name: "objects of other api groups are ignored",
+			manifest: `apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: config
+`,
+			want: []finding{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, lintManifest(t, tt.manifest))
+		})
+	}
+}
+
+func TestWriteText(t *testing.T) {
+	findings := []finding{
+		{
+			File: "es.yaml", Line: 3, Kind: "ExternalSecret", Name: "app",
+			Rule: ruleMissingReference, Severity: severityWarning,
+			Message: `spec.secretStoreRef: SecretStore "vault" not found`,
+		},
+		{
+			File: "broken.yaml", Line: 1,
+			Rule: ruleParse, Severity: severityError,
+			Message: "yaml: line 1: did not find expected key",
+		},
+	}
+	var out bytes.Buffer
+	require.NoError(t, writeText(&out, findings))
+	assert.Equal(t, `es.yaml:3: ExternalSecret/app warning [missing-reference] spec.secretStoreRef: SecretStore "vault" not found
+broken.yaml:1: error [parse] yaml: line 1: did not find expected key
+`, out.String())
+}
@@ -0,0 +1,402 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	sigsyaml "sigs.k8s.io/yaml"
+
+	esv1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1"
+)
+
+const (
+	apiVersionV1Beta1 = "external-secrets.io/v1beta1"
+	apiVersionV1      = "external-secrets.io/v1"
+
+	// namespaceNameLabel is set on every namespace by the API server.
+	namespaceNameLabel = "kubernetes.io/metadata.name"
+)
+
+// migratedKinds are the kinds which have been promoted from v1beta1 to v1.
+var migratedKinds = []string{
+	esv1.ExtSecretKind,
+	esv1.ClusterExtSecretKind,
+	esv1.SecretStoreKind,
+	esv1.ClusterSecretStoreKind,
+}
+
+var (
+	migrateInPlace       bool
+	migrateApply         bool
+	migrateAllNamespaces bool
+)
+
+func init() {
+	rootCmd.AddCommand(migrateCmd)
+	addKubeFlags(migrateCmd)
+	migrateCmd.Flags().BoolVarP(&migrateInPlace, "in-place", "i", false, "If set, files are rewritten instead of printing the migrated manifests")
+	migrateCmd.Flags().BoolVar(&migrateApply, "apply", false, "If set and no files are given, the migrated objects are written to the cluster")
+	migrateCmd.Flags().BoolVarP(&migrateAllNamespaces, "all-namespaces", "A", false, "If set and no files are given, ExternalSecrets and SecretStores of all namespaces are migrated")
+}
+
+var migrateCmd = &cobra.Command{
+	Use:   "migrate [file or directory]...",
+	Short: "migrates v1beta1 and deprecated fields to v1",
+	Long: `Migrates ExternalSecret, ClusterExternalSecret, SecretStore and ClusterSecretStore manifests
+to external-secrets.io/v1 and replaces deprecated fields where possible. Comments and key order are preserved.
+Fields that need manual changes are reported.
+
+If files or directories are given, the migrated manifests are printed or, with --in-place, written back.
+Otherwise the objects of the cluster are migrated: changes are reported and only written with --apply.
+--apply writes every object, even without changes, so that the API server stores it as v1.`,
+	RunE: migrateRun,
+}
+
+// migration records the changes made to an object.
+type migration struct {
+	changes []string
+	manual  []string
+}
+
+func (m *migration) changed(format string, args ...any) {
+	m.changes = append(m.changes, fmt.Sprintf(format, args...))
+}
+
+func (m *migration) needsManualChange(format string, args ...any) {
+	m.manual = append(m.manual, fmt.Sprintf(format, args...))
+}
+
+func migrateRun(_ *cobra.Command, args []string) error {
+	if len(args) == 0 {
+		return migrateCluster(context.Background())
+	}
+	files, err := collectFiles(args)
+	if err != nil {
+		return err
+	}
+	for _, file := range files {
+		if err := migrateFile(file); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func migrateFile(path string) error {
+	content, err := os.ReadFile(filepath.Clean(path))
+	if err != nil {
+		return fmt.Errorf("could not read %s: %w", path, err)
+	}
+	var docs []*yaml.Node
+	decoder := yaml.NewDecoder(bytes.NewReader(content))
+	for {
+		doc := &yaml.Node{}
+		err := decoder.Decode(doc)
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return fmt.Errorf("could not decode %s: %w", path, err)
+		}
+		docs = append(docs, doc)
+	}
+
+	changed := false
+	for _, doc := range docs {
+		if len(doc.Content) == 0 {
+			continue
+		}
+		root := doc.Content[0]
+		m := migrateObject(root)
+		if m == nil {
+			continue
+		}
+		changed = changed || len(m.changes) > 0
+		reportMigration(fmt.Sprintf("%s:%d", path, root.Line), root, m)
+	}
+
+	buf := &bytes.Buffer{}
+	encoder := yaml.NewEncoder(buf)
+	encoder.SetIndent(2)
+	for _, doc := range docs {
+		if err := encoder.Encode(doc); err != nil {
+			return fmt.Errorf("could not encode %s: %w", path, err)
+		}
+	}
+	if err := encoder.Close(); err != nil {
+		return err
+	}
+
+	if !migrateInPlace {
+		_, err := os.Stdout.Write(buf.Bytes())
+		return err
+	}
+	if !changed {
+		return nil
+	}
+	info, err := os.Stat(path)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, buf.Bytes(), info.Mode())
+}
+
+func migrateCluster(ctx context.Context) error {
+	scheme, err := newScheme()
+	if err != nil {
+		return err
+	}
+	kube, ns, err := newKubeClient(scheme)
+	if err != nil {
+		return err
+	}
+	for _, kind := range migratedKinds {
+		list := &unstructured.UnstructuredList{}
+		list.SetGroupVersionKind(esv1.SchemeGroupVersion.WithKind(kind + "List"))
+		var opts []client.ListOption
+		if !migrateAllNamespaces && (kind == esv1.ExtSecretKind || kind == esv1.SecretStoreKind) {
+			opts = append(opts, client.InNamespace(ns))
+		}
+		if err := kube.List(ctx, list, opts...); err != nil {
+			return fmt.Errorf("could not list %s: %w", kind, err)
+		}
+		for i := range list.Items {
+			if err := migrateClusterObject(ctx, kube, &list.Items[i]); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func migrateClusterObject(ctx context.Context, kube client.Client, obj *unstructured.Unstructured) error {
+	raw, err := sigsyaml.Marshal(obj.Object)
+	if err != nil {
+		return err
+	}
+	doc := &yaml.Node{}
+	if err := yaml.Unmarshal(raw, doc); err != nil {
+		return err
+	}
+	root := doc.Content[0]
+	m := migrateObject(root)
+	location := obj.GetKind() + " " + client.ObjectKeyFromObject(obj).String()
+	reportMigration(location, root, m)
+	if !migrateApply {
+		return nil
+	}
+	// objects are always written, as the API server returns v1 even for
+	// objects which are still stored as v1beta1 and only rewrites them on update.
+	if len(m.changes) > 0 {
+		migrated := map[string]any{}
+		if err := root.Decode(&migrated); err != nil {
+			return err
+		}
+		obj.Object = migrated
+	}
+	if err := kube.Update(ctx, obj); err != nil {
+		return fmt.Errorf("could not update %s: %w", location, err)
+	}
+	return nil
+}
+
+func reportMigration(location string, root *yaml.Node, m *migration) {
+	name := scalarValue(lookup(root, "metadata", "name"))
+	kind := scalarValue(lookup(root, "kind"))
+	for _, change := range m.changes {
+		_, _ = fmt.Fprintf(os.Stderr, "%s: %s/%s: migrated %s\n", location, kind, name, change)
+	}
+	for _, manual := range m.manual {
+		_, _ = fmt.Fprintf(os.Stderr, "%s: %s/%s: manual change required: %s\n", location, kind, name, manual)
+	}
+}
+
+// migrateObject migrates the object in place.
+// It returns nil if the object is not migrated by esoctl.
+func migrateObject(root *yaml.Node) *migration {
+	apiVersion := scalarValue(lookup(root, "apiVersion"))
+	kind := scalarValue(lookup(root, "kind"))
+	if (apiVersion != apiVersionV1Beta1 && apiVersion != apiVersionV1) || !slices.Contains(migratedKinds, kind) {
+		return nil
+	}
+	m := &migration{}
+	if apiVersion == apiVersionV1Beta1 {
+		lookup(root, "apiVersion").Value = apiVersionV1
+		m.changed("apiVersion %s to %s", apiVersionV1Beta1, apiVersionV1)
+	}
+	switch kind {
+	case esv1.ExtSecretKind:
+		migrateExternalSecretSpec(lookup(root, "spec"), "spec", m)
+	case esv1.ClusterExtSecretKind:
+		migrateClusterExternalSecretSpec(lookup(root, "spec"), m)
+		migrateExternalSecretSpec(lookup(root, "spec", "externalSecretSpec"), "spec.externalSecretSpec", m)
+	case esv1.SecretStoreKind, esv1.ClusterSecretStoreKind:
+		migrateStoreSpec(lookup(root, "spec"), m)
+	}
+	return m
+}
+
+func migrateExternalSecretSpec(spec *yaml.Node, path string, m *migration) {
+	data := lookup(spec, "data")
+	if data == nil || data.Kind != yaml.SequenceNode {
+		return
+	}
+	for i, item := range data.Content {
+		if lookup(item, "sourceRef", "generatorRef") != nil {
+			m.needsManualChange("%s.data[%d].sourceRef.generatorRef is not implemented, use %s.dataFrom[].sourceRef.generatorRef instead", path, i, path)
+		}
+	}
+}
+
+// migrateClusterExternalSecretSpec replaces namespaceSelector and namespaces by namespaceSelectors.
+func migrateClusterExternalSecretSpec(spec *yaml.Node, m *migration) {
+	if spec == nil || spec.Kind != yaml.MappingNode {
+		return
+	}
+	// namespaceSelectors takes the place of the first deprecated field
+	position := len(spec.Content)
+	for _, key := range []string{"namespaceSelector", "namespaces"} {
+		if i := keyIndex(spec, key); i >= 0 && i < position {
+			position = i
+		}
+	}
+	var selectors []*yaml.Node
+	if selector := removeKey(spec, "namespaceSelector"); selector != nil && selector.Tag != "!!null" {
+		selectors = append(selectors, selector)
+		m.changed("spec.namespaceSelector to spec.namespaceSelectors")
+	}
+	if namespaces := removeKey(spec, "namespaces"); namespaces != nil && len(namespaces.Content) > 0 {
+		selectors = append(selectors, mappingNode(
+			"matchExpressions", &yaml.Node{Kind: yaml.SequenceNode, Content: []*yaml.Node{mappingNode(
+				"key", scalarNode(namespaceNameLabel),
+				"operator", scalarNode("In"),
+				"values", namespaces,
+			)}},
+		))
+		m.changed("spec.namespaces to spec.namespaceSelectors using the %s label", namespaceNameLabel)
+	}
+	if len(selectors) == 0 {
+		return
+	}
+	namespaceSelectors := lookup(spec, "namespaceSelectors")
+	if namespaceSelectors != nil && namespaceSelectors.Kind != yaml.SequenceNode {
+		// an explicit null
+		*namespaceSelectors = yaml.Node{Kind: yaml.SequenceNode}
+	}
+	if namespaceSelectors == nil {
+		namespaceSelectors = &yaml.Node{Kind: yaml.SequenceNode}
+		position = min(position, len(spec.Content))
+		spec.Content = slices.Insert(spec.Content, position, scalarNode("namespaceSelectors"), namespaceSelectors)
+	}
+	namespaceSelectors.Content = append(namespaceSelectors.Content, selectors...)
+}
+
+// migrateStoreSpec replaces the deprecated fields of the vault provider.
+func migrateStoreSpec(spec *yaml.Node, m *migration) {
+	const path = "spec.provider.vault.auth.jwt.kubernetesServiceAccountToken"
+	token := lookup(spec, "provider", "vault", "auth", "jwt", "kubernetesServiceAccountToken")
+	if token == nil {
+		return
+	}
+	if audiences := lookup(token, "audiences"); audiences != nil {
+		serviceAccountRef := lookup(token, "serviceAccountRef")
+		switch {
+		case serviceAccountRef == nil || serviceAccountRef.Kind != yaml.MappingNode:
+			m.needsManualChange("%s.audiences is deprecated, use %s.serviceAccountRef.audiences instead", path, path)
+		case lookup(serviceAccountRef, "audiences") != nil:
+			m.needsManualChange("%s.audiences is deprecated and overridden by %s.serviceAccountRef.audiences, remove it", path, path)
+		default:
+			removeKey(token, "audiences")
+			serviceAccountRef.Content = append(serviceAccountRef.Content, scalarNode("audiences"), audiences)
+			m.changed("%s.audiences to %s.serviceAccountRef.audiences", path, path)
+		}
+	}
+	if lookup(token, "expirationSeconds") != nil {
+		m.needsManualChange("%s.expirationSeconds is deprecated and will be removed", path)
+	}
+}
+
+// lookup returns the node at the path of mapping keys or nil.
+func lookup(node *yaml.Node, path ...string) *yaml.Node {
+	for _, key := range path {
+		if node == nil || node.Kind != yaml.MappingNode {
+			return nil
+		}
+		i := keyIndex(node, key)
+		if i < 0 {
+			return nil
+		}
+		node = node.Content[i+1]
+	}
+	return node
+}
+
+// keyIndex returns the index of key in the content of a mapping node or -1.
+func keyIndex(node *yaml.Node, key string) int {
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		if node.Content[i].Value == key {
+			return i
+		}
+	}
+	return -1
+}
+
+// removeKey removes key from a mapping node and returns its value.
+// Comments of the key are moved to the value.
+func removeKey(node *yaml.Node, key string) *yaml.Node {
+	i := keyIndex(node, key)
+	if i < 0 {
+		return nil
+	}
+	k, value := node.Content[i], node.Content[i+1]
+	node.Content = slices.Delete(node.Content, i, i+2)
+	if value.HeadComment == "" {
+		value.HeadComment = k.HeadComment
+	}
+	return value
+}
+
+func scalarValue(node *yaml.Node) string {
+	if node == nil || node.Kind != yaml.ScalarNode {
+		return ""
+	}
+	return strings.TrimSpace(node.Value)
+}
+
+func scalarNode(value string) *yaml.Node {
+	return &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: value}
+}
+
+// mappingNode returns a mapping node of alternating keys and values.
+func mappingNode(keysAndValues ...any) *yaml.Node {
+	node := &yaml.Node{Kind: yaml.MappingNode, Tag: "!!map"}
+	for i := 0; i+1 < len(keysAndValues); i += 2 {
+		node.Content = append(node.Content, scalarNode(keysAndValues[i].(string)), keysAndValues[i+1].(*yaml.Node))
+	}
+	return node
+}
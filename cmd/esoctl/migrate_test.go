@@ -0,0 +1,249 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+)
+
+func TestMigrateObject(t *testing.T) {
+	const tokenPath = "spec.provider.vault.auth.jwt.kubernetesServiceAccountToken"
+	tests := []struct {
+		name     string
+		manifest string
+		want     string
+		// wantNil is set if the object is not migrated by esoctl.
+		wantNil     bool
+		wantChanges []string
+		wantManual  []string
+	}{
+		{
+			name: "other kinds are ignored",
+			manifest: `apiVersion: external-secrets.io/v1alpha1
+kind: PushSecret
+metadata:
+  name: push
+`,
+			wantNil: true,
+		},
+		{
+			name: "v1 objects without deprecated fields are unchanged",
+			manifest: `apiVersion: external-secrets.io/v1
+kind: ExternalSecret
+metadata:
+  name: app
+spec:
+  refreshInterval: 1h
+`,
+			want: `apiVersion: external-secrets.io/v1
+kind: ExternalSecret
+metadata:
+  name: app
+spec:
+  refreshInterval: 1h
+`,
+		},
+		{
+			name: "v1beta1 ExternalSecret",
+			manifest: `apiVersion: external-secrets.io/v1beta1 # promoted
+kind: ExternalSecret
+metadata:
+  name: app
+spec:
+  data:
+  - secretKey: password
+    sourceRef:
+      generatorRef:
+        kind: Password
+        name: pw
+`,
+			want: `apiVersion: external-secrets.io/v1 # promoted
+kind: ExternalSecret
+metadata:
+  name: app
+spec:
+  data:
+    - secretKey: password
+      sourceRef:
+        generatorRef:
+          kind: Password
+          name: pw
+`,
+			wantChanges: []string{"apiVersion external-secrets.io/v1beta1 to external-secrets.io/v1"},
+			wantManual:  []string{"spec.data[0].sourceRef.generatorRef is not implemented, use spec.dataFrom[].sourceRef.generatorRef instead"},
+		},
+		{
+			name: "ClusterExternalSecret namespaces",
+			manifest: `apiVersion: external-secrets.io/v1
+kind: ClusterExternalSecret
+metadata:
+  name: app
+spec:
+  externalSecretName: app
+  # selects the team namespaces
+  namespaceSelector:
+    matchLabels:
+      team: a
+  namespaces: [team-b]
+  refreshTime: 1m
+`,
+			want: `apiVersion: external-secrets.io/v1
+kind: ClusterExternalSecret
+metadata:
+  name: app
+spec:
+  externalSecretName: app
+  namespaceSelectors:
+    # selects the team namespaces
+    - matchLabels:
+        team: a
+    - matchExpressions:
+        - key: kubernetes.io/metadata.name
+          operator: In
+          values: [team-b]
+  refreshTime: 1m
+`,
+			wantChanges: []string{
+				"spec.namespaceSelector to spec.namespaceSelectors",
+				"spec.namespaces to spec.namespaceSelectors using the kubernetes.io/metadata.name label",
+			},
+		},
+		{
+			name: "ClusterExternalSecret with existing namespaceSelectors",
+			manifest: `apiVersion: external-secrets.io/v1
+kind: ClusterExternalSecret
+metadata:
+  name: app
+spec:
+  namespaceSelectors:
+  - matchLabels:
+      team: a
+  namespaceSelector:
+    matchLabels:
+      team: b
+`,
+			want: `apiVersion: external-secrets.io/v1
+kind: ClusterExternalSecret
+metadata:
+  name: app
+spec:
+  namespaceSelectors:
+    - matchLabels:
+        team: a
+    - matchLabels:
+        team: b
+`,
+			wantChanges: []string{"spec.namespaceSelector to spec.namespaceSelectors"},
+		},
+		{
+			name: "vault audiences are moved to the serviceAccountRef",
+			manifest: `apiVersion: external-secrets.io/v1beta1
+kind: ClusterSecretStore
+metadata:
+  name: vault
+spec:
+  provider:
+    vault:
+      auth:
+        jwt:
+          kubernetesServiceAccountToken:
+            audiences: [vault]
+            expirationSeconds: 600
+            serviceAccountRef:
+              name: default
+`,
+			want: `apiVersion: external-secrets.io/v1
+kind: ClusterSecretStore
+metadata:
+  name: vault
+spec:
+  provider:
+    vault:
+      auth:
+        jwt:
+          kubernetesServiceAccountToken:
+            expirationSeconds: 600
+            serviceAccountRef:
+              name: default
+              audiences: [vault]
+`,
+			wantChanges: []string{
+				"apiVersion external-secrets.io/v1beta1 to external-secrets.io/v1",
+				"spec.provider.vault.auth.jwt.kubernetesServiceAccountToken.audiences to " + tokenPath + ".serviceAccountRef.audiences",
+			},
+			wantManual: []string{tokenPath + ".expirationSeconds is deprecated and will be removed"},
+		},
+		{
+			name: "vault audiences overridden by the serviceAccountRef",
+			manifest: `apiVersion: external-secrets.io/v1
+kind: SecretStore
+metadata:
+  name: vault
+spec:
+  provider:
+    vault:
+      auth:
+        jwt:
+          kubernetesServiceAccountToken:
+            audiences: [vault]
+            serviceAccountRef:
+              name: default
+              audiences: [other]
+`,
+			want: `apiVersion: external-secrets.io/v1
+kind: SecretStore
+metadata:
+  name: vault
+spec:
+  provider:
+    vault:
+      auth:
+        jwt:
+          kubernetesServiceAccountToken:
+            audiences: [vault]
+            serviceAccountRef:
+              name: default
+              audiences: [other]
+`,
+			wantManual: []string{tokenPath + ".audiences is deprecated and overridden by " + tokenPath + ".serviceAccountRef.audiences, remove it"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			doc := &yaml.Node{}
+			require.NoError(t, yaml.Unmarshal([]byte(tt.manifest), doc))
+			m := migrateObject(doc.Content[0])
+			if tt.wantNil {
+				assert.Nil(t, m)
+				return
+			}
+			require.NotNil(t, m)
+			assert.Equal(t, tt.wantChanges, m.changes)
+			assert.Equal(t, tt.wantManual, m.manual)
+
+			var out bytes.Buffer
+			encoder := yaml.NewEncoder(&out)
+			encoder.SetIndent(2)
+			require.NoError(t, encoder.Encode(doc))
+			require.NoError(t, encoder.Close())
+			assert.Equal(t, tt.want, out.String())
+		})
+	}
+}
@@ -0,0 +1,115 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+
+	esv1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1"
+	"github.com/external-secrets/external-secrets/apis/externalsecrets/v1alpha1"
+	esv1beta1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1beta1"
+	genv1alpha1 "github.com/external-secrets/external-secrets/apis/generators/v1alpha1"
+)
+
+// newScheme returns a scheme with all types esoctl works with.
+func newScheme() (*runtime.Scheme, error) {
+	scheme := runtime.NewScheme()
+	for _, add := range []func(*runtime.Scheme) error{
+		corev1.AddToScheme,
+		esv1.AddToScheme,
+		esv1beta1.AddToScheme,
+		v1alpha1.AddToScheme,
+		genv1alpha1.AddToScheme,
+	} {
+		if err := add(scheme); err != nil {
+			return nil, err
+		}
+	}
+	return scheme, nil
+}
+
+// document is an object of a multi document file.
+type document struct {
+	// line is the line the document starts at.
+	line   int
+	object *unstructured.Unstructured
+}
+
+// readObjects reads all objects of a multi document yaml or json file.
+func readObjects(path string) ([]*unstructured.Unstructured, error) {
+	documents, err := readDocuments(path)
+	if err != nil {
+		return nil, err
+	}
+	objects := make([]*unstructured.Unstructured, 0, len(documents))
+	for _, doc := range documents {
+		objects = append(objects, doc.object)
+	}
+	return objects, nil
+}
+
+// readDocuments reads all documents of a multi document yaml or json file.
+// Empty documents are skipped.
+func readDocuments(path string) ([]document, error) {
+	content, err := os.ReadFile(filepath.Clean(path))
+	if err != nil {
+		return nil, fmt.Errorf("could not read %s: %w", path, err)
+	}
+
+	var documents []document
+	lines := strings.SplitAfter(string(content), "\n")
+	start := 0
+	for i := 0; i <= len(lines); i++ {
+		if i < len(lines) && !isDocumentSeparator(lines[i]) {
+			continue
+		}
+		obj := &unstructured.Unstructured{}
+		if err := yaml.Unmarshal([]byte(strings.Join(lines[start:i], "")), &obj.Object); err != nil {
+			return nil, fmt.Errorf("could not decode %s at line %d: %w", path, start+1, err)
+		}
+		// skip empty documents
+		if len(obj.Object) > 0 {
+			documents = append(documents, document{line: start + 1, object: obj})
+		}
+		start = i + 1
+	}
+	return documents, nil
+}
+
+func isDocumentSeparator(line string) bool {
+	line = strings.TrimRight(line, " \t\r\n")
+	return line == "---" || strings.HasPrefix(line, "--- ")
+}
+
+// toTyped converts obj to the type registered in scheme for its kind.
+func toTyped(scheme *runtime.Scheme, obj *unstructured.Unstructured) (client.Object, error) {
+	typed, err := scheme.New(obj.GroupVersionKind())
+	if err != nil {
+		return nil, fmt.Errorf("unsupported object %s %q: %w", obj.GetKind(), obj.GetName(), err)
+	}
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(obj.Object, typed); err != nil {
+		return nil, fmt.Errorf("could not convert %s %q: %w", obj.GetKind(), obj.GetName(), err)
+	}
+	return typed.(client.Object), nil
+}
@@ -0,0 +1,309 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+
+	"github.com/go-logr/logr"
+	"github.com/pmezard/go-difflib/difflib"
+	"github.com/spf13/cobra"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/yaml"
+
+	esv1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1"
+	genv1alpha1 "github.com/external-secrets/external-secrets/apis/generators/v1alpha1"
+	"github.com/external-secrets/external-secrets/pkg/controllers/externalsecret"
+)
+
+const defaultNamespace = "default"
+
+// offlineGenerators are the generator kinds which do not call any external API.
+var offlineGenerators = []genv1alpha1.GeneratorKind{
+	genv1alpha1.GeneratorKindFake,
+	genv1alpha1.GeneratorKindPassword,
+	genv1alpha1.GeneratorKindSSHKey,
+	genv1alpha1.GeneratorKindCertificate,
+	genv1alpha1.GeneratorKindUUID,
+	genv1alpha1.GeneratorKindWireGuardKey,
+	genv1alpha1.GeneratorKindAgeKey,
+	genv1alpha1.GeneratorKindTransform,
+}
+
+// clusterScopedKinds are the kinds of fixtures which are not namespaced.
+var clusterScopedKinds = []string{
+	esv1.ClusterSecretStoreKind,
+	genv1alpha1.ClusterGeneratorKind,
+}
+
+var (
+	renderFile       string
+	renderStoreFile  string
+	renderDiffFile   string
+	renderOutputFile string
+)
+
+func init() {
+	rootCmd.AddCommand(renderCmd)
+	renderCmd.Flags().StringVarP(&renderFile, "file", "f", "", "Link to a file containing the ExternalSecret to render")
+	renderCmd.Flags().StringVar(&renderStoreFile, "store", "", "Link to a file containing the stores, generators, secrets and config maps used by the ExternalSecret. Stores must use the fake provider")
+	renderCmd.Flags().StringVar(&renderDiffFile, "diff", "", "If set, a diff of the rendered secret against the secret in this file is printed instead of the rendered secret")
+	renderCmd.Flags().StringVar(&renderOutputFile, "output", "", "If set, the output will be written to this file")
+	_ = renderCmd.MarkFlagRequired("file")
+	_ = renderCmd.MarkFlagRequired("store")
+}
+
+var renderCmd = &cobra.Command{
+	Use:   "render",
+	Short: "renders the secret of an ExternalSecret offline",
+	Long: `Renders the secret of an ExternalSecret without a cluster.
+The secret data is fetched from the stores in the fixtures file, which must use the fake provider.
+data, dataFrom, rewrites, decoding and conversion strategies, generators that do not call an external API
+and templates are processed the same way as in the controller.`,
+	RunE: renderRun,
+}
+
+func renderRun(_ *cobra.Command, _ []string) error {
+	ctx := context.Background()
+	scheme, err := newScheme()
+	if err != nil {
+		return err
+	}
+
+	es, err := readExternalSecret(scheme, renderFile)
+	if err != nil {
+		return err
+	}
+
+	fixtures, err := readFixtures(scheme, renderStoreFile, es.Namespace)
+	if err != nil {
+		return err
+	}
+
+	if err := assertOfflineGenerators(es); err != nil {
+		return err
+	}
+
+	r := &externalsecret.Reconciler{
+		Client: fake.NewClientBuilder().WithScheme(scheme).WithObjects(fixtures...).Build(),
+		Scheme: scheme,
+		Log:    logr.Discard(),
+	}
+	r.SetEventRecorder(&record.FakeRecorder{})
+
+	dataMap, err := r.GetProviderSecretData(ctx, es)
+	if err != nil {
+		return fmt.Errorf("could not get secret data: %w", err)
+	}
+
+	var existing *corev1.Secret
+	secret := &corev1.Secret{}
+	if renderDiffFile != "" {
+		existing, err = readSecret(renderDiffFile)
+		if err != nil {
+			return err
+		}
+		secret = existing.DeepCopy()
+	}
+	secret.TypeMeta.APIVersion = "v1"
+	secret.TypeMeta.Kind = "Secret"
+	secret.Namespace = es.Namespace
+	secret.Name = es.Spec.Target.Name
+	if secret.Name == "" {
+		secret.Name = es.Name
+	}
+
+	if err := r.ApplyTemplate(ctx, es, secret, dataMap); err != nil {
+		return fmt.Errorf("could not apply template: %w", err)
+	}
+	if es.Spec.Target.Immutable {
+		secret.Immutable = ptr.To(true)
+	}
+
+	out := io.Writer(os.Stdout)
+	if renderOutputFile != "" {
+		f, err := os.Create(filepath.Clean(renderOutputFile))
+		if err != nil {
+			return fmt.Errorf("could not create output file: %w", err)
+		}
+		defer func() {
+			_ = f.Close()
+		}()
+
+		out = f
+	}
+
+	rendered, err := yaml.Marshal(secret)
+	if err != nil {
+		return fmt.Errorf("could not marshal secret: %w", err)
+	}
+	if existing == nil {
+		_, err = fmt.Fprint(out, string(rendered))
+		return err
+	}
+
+	current, err := yaml.Marshal(existing)
+	if err != nil {
+		return fmt.Errorf("could not marshal secret: %w", err)
+	}
+	return difflib.WriteUnifiedDiff(out, difflib.UnifiedDiff{
+		A:        difflib.SplitLines(string(current)),
+		B:        difflib.SplitLines(string(rendered)),
+		FromFile: renderDiffFile,
+		ToFile:   "rendered",
+		Context:  3,
+	})
+}
+
+func readExternalSecret(scheme *runtime.Scheme, path string) (*esv1.ExternalSecret, error) {
+	objects, err := readObjects(path)
+	if err != nil {
+		return nil, err
+	}
+	for _, obj := range objects {
+		if obj.GetKind() != esv1.ExtSecretKind {
+			continue
+		}
+		typed, err := toTyped(scheme, obj)
+		if err != nil {
+			return nil, err
+		}
+		es := typed.(*esv1.ExternalSecret)
+		if es.Namespace == "" {
+			es.Namespace = defaultNamespace
+		}
+		setDefaults(es)
+		return es, nil
+	}
+	return nil, fmt.Errorf("no ExternalSecret found in %s", path)
+}
+
+// setDefaults sets the defaults of the ExternalSecret CRD, which are otherwise set by the API server.
+func setDefaults(es *esv1.ExternalSecret) {
+	target := &es.Spec.Target
+	target.CreationPolicy = defaultTo(target.CreationPolicy, esv1.CreatePolicyOwner)
+	target.DeletionPolicy = defaultTo(target.DeletionPolicy, esv1.DeletionPolicyRetain)
+	if tmpl := target.Template; tmpl != nil {
+		tmpl.EngineVersion = defaultTo(tmpl.EngineVersion, esv1.TemplateEngineV2)
+		tmpl.MergePolicy = defaultTo(tmpl.MergePolicy, esv1.MergePolicyReplace)
+		for i := range tmpl.TemplateFrom {
+			from := &tmpl.TemplateFrom[i]
+			from.Target = defaultTo(from.Target, esv1.TemplateTargetData)
+			for _, ref := range []*esv1.TemplateRef{from.ConfigMap, from.Secret} {
+				if ref == nil {
+					continue
+				}
+				for j := range ref.Items {
+					ref.Items[j].TemplateAs = defaultTo(ref.Items[j].TemplateAs, esv1.TemplateScopeValues)
+				}
+			}
+		}
+	}
+	for i := range es.Spec.Data {
+		setRemoteRefDefaults(&es.Spec.Data[i].RemoteRef)
+	}
+	for i := range es.Spec.DataFrom {
+		ref := &es.Spec.DataFrom[i]
+		if ref.Extract != nil {
+			setRemoteRefDefaults(ref.Extract)
+		}
+		if ref.Find != nil {
+			ref.Find.ConversionStrategy = defaultTo(ref.Find.ConversionStrategy, esv1.ExternalSecretConversionDefault)
+			ref.Find.DecodingStrategy = defaultTo(ref.Find.DecodingStrategy, esv1.ExternalSecretDecodeNone)
+		}
+		for j := range ref.Rewrite {
+			if merge := ref.Rewrite[j].Merge; merge != nil {
+				merge.ConflictPolicy = defaultTo(merge.ConflictPolicy, esv1.ExternalSecretRewriteMergeConflictPolicyError)
+				merge.Strategy = defaultTo(merge.Strategy, esv1.ExternalSecretRewriteMergeStrategyExtract)
+			}
+		}
+		if ref.SourceRef != nil && ref.SourceRef.GeneratorRef != nil {
+			ref.SourceRef.GeneratorRef.APIVersion = defaultTo(ref.SourceRef.GeneratorRef.APIVersion, genv1alpha1.SchemeGroupVersion.String())
+		}
+	}
+}
+
+func setRemoteRefDefaults(ref *esv1.ExternalSecretDataRemoteRef) {
+	ref.MetadataPolicy = defaultTo(ref.MetadataPolicy, esv1.ExternalSecretMetadataPolicyNone)
+	ref.ConversionStrategy = defaultTo(ref.ConversionStrategy, esv1.ExternalSecretConversionDefault)
+	ref.DecodingStrategy = defaultTo(ref.DecodingStrategy, esv1.ExternalSecretDecodeNone)
+}
+
+func defaultTo[T ~string](value, defaultValue T) T {
+	if value == "" {
+		return defaultValue
+	}
+	return value
+}
+
+// readFixtures reads the objects the ExternalSecret depends on.
+// Namespaced objects without a namespace are put into the namespace of the ExternalSecret.
+func readFixtures(scheme *runtime.Scheme, path, namespace string) ([]client.Object, error) {
+	objects, err := readObjects(path)
+	if err != nil {
+		return nil, err
+	}
+	fixtures := make([]client.Object, 0, len(objects))
+	for _, obj := range objects {
+		typed, err := toTyped(scheme, obj)
+		if err != nil {
+			return nil, err
+		}
+		if store, ok := typed.(esv1.GenericStore); ok {
+			if store.GetSpec().Provider == nil || store.GetSpec().Provider.Fake == nil {
+				return nil, fmt.Errorf("%s %q must use the fake provider", store.GetKind(), store.GetName())
+			}
+		}
+		if !slices.Contains(clusterScopedKinds, obj.GetKind()) && typed.GetNamespace() == "" {
+			typed.SetNamespace(namespace)
+		}
+		fixtures = append(fixtures, typed)
+	}
+	return fixtures, nil
+}
+
+func assertOfflineGenerators(es *esv1.ExternalSecret) error {
+	for i, ref := range es.Spec.DataFrom {
+		if ref.SourceRef == nil || ref.SourceRef.GeneratorRef == nil {
+			continue
+		}
+		if !slices.Contains(offlineGenerators, genv1alpha1.GeneratorKind(ref.SourceRef.GeneratorRef.Kind)) {
+			return fmt.Errorf("spec.dataFrom[%d]: generator %s calls an external API and can not be rendered offline", i, ref.SourceRef.GeneratorRef.Kind)
+		}
+	}
+	return nil
+}
+
+func readSecret(path string) (*corev1.Secret, error) {
+	content, err := os.ReadFile(filepath.Clean(path))
+	if err != nil {
+		return nil, fmt.Errorf("could not read secret file: %w", err)
+	}
+	secret := &corev1.Secret{}
+	if err := yaml.Unmarshal(content, secret); err != nil {
+		return nil, fmt.Errorf("could not unmarshal secret: %w", err)
+	}
+	return secret, nil
+}
@@ -0,0 +1,207 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	esv1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1"
+	genv1alpha1 "github.com/external-secrets/external-secrets/apis/generators/v1alpha1"
+)
+
+func TestSetDefaults(t *testing.T) {
+	tests := []struct {
+		name string
+		spec esv1.ExternalSecretSpec
+		want esv1.ExternalSecretSpec
+	}{
+		{
+			name: "empty spec",
+			want: esv1.ExternalSecretSpec{
+				Target: esv1.ExternalSecretTarget{
+					CreationPolicy: esv1.CreatePolicyOwner,
+					DeletionPolicy: esv1.DeletionPolicyRetain,
+				},
+			},
+		},
+		{
+			name: "set values are kept",
+			spec: esv1.ExternalSecretSpec{
+				Target: esv1.ExternalSecretTarget{
+					CreationPolicy: esv1.CreatePolicyMerge,
+					DeletionPolicy: esv1.DeletionPolicyDelete,
+				},
+				Data: []esv1.ExternalSecretData{{
+					RemoteRef: esv1.ExternalSecretDataRemoteRef{
+						Key:              "key",
+						DecodingStrategy: esv1.ExternalSecretDecodeBase64,
+					},
+				}},
+			},
+			want: esv1.ExternalSecretSpec{
+				Target: esv1.ExternalSecretTarget{
+					CreationPolicy: esv1.CreatePolicyMerge,
+					DeletionPolicy: esv1.DeletionPolicyDelete,
+				},
+				Data: []esv1.ExternalSecretData{{
+					RemoteRef: esv1.ExternalSecretDataRemoteRef{
+						Key:                "key",
+						MetadataPolicy:     esv1.ExternalSecretMetadataPolicyNone,
+						ConversionStrategy: esv1.ExternalSecretConversionDefault,
+						DecodingStrategy:   esv1.ExternalSecretDecodeBase64,
+					},
+				}},
+			},
+		},
+		{
+			name: "template",
+			spec: esv1.ExternalSecretSpec{
+				Target: esv1.ExternalSecretTarget{
+					Template: &esv1.ExternalSecretTemplate{
+						TemplateFrom: []esv1.TemplateFrom{{
+							ConfigMap: &esv1.TemplateRef{
+								Name:  "tpl",
+								Items: []esv1.TemplateRefItem{{Key: "config"}},
+							},
+						}},
+					},
+				},
+			},
+			want: esv1.ExternalSecretSpec{
+				Target: esv1.ExternalSecretTarget{
+					CreationPolicy: esv1.CreatePolicyOwner,
+					DeletionPolicy: esv1.DeletionPolicyRetain,
+					Template: &esv1.ExternalSecretTemplate{
+						EngineVersion: esv1.TemplateEngineV2,
+						MergePolicy:   esv1.MergePolicyReplace,
+						TemplateFrom: []esv1.TemplateFrom{{
+							Target: esv1.TemplateTargetData,
+							ConfigMap: &esv1.TemplateRef{
+								Name:  "tpl",
+								Items: []esv1.TemplateRefItem{{Key: "config", TemplateAs: esv1.TemplateScopeValues}},
+							},
+						}},
+					},
+				},
+			},
+		},
+		{
+			name: "dataFrom",
+			spec: esv1.ExternalSecretSpec{
+				DataFrom: []esv1.ExternalSecretDataFromRemoteRef{
+					{
+						Extract: &esv1.ExternalSecretDataRemoteRef{Key: "key"},
+					},
+					{
+						Find: &esv1.ExternalSecretFind{},
+						Rewrite: []esv1.ExternalSecretRewrite{{
+							Merge: &esv1.ExternalSecretRewriteMerge{},
+						}},
+					},
+					{
+						SourceRef: &esv1.StoreGeneratorSourceRef{
+							GeneratorRef: &esv1.GeneratorRef{Kind: "Password", Name: "pw"},
+						},
+					},
+				},
+			},
+			want: esv1.ExternalSecretSpec{
+				Target: esv1.ExternalSecretTarget{
+					CreationPolicy: esv1.CreatePolicyOwner,
+					DeletionPolicy: esv1.DeletionPolicyRetain,
+				},
+				DataFrom: []esv1.ExternalSecretDataFromRemoteRef{
+					{
+						Extract: &esv1.ExternalSecretDataRemoteRef{
+							Key:                "key",
+							MetadataPolicy:     esv1.ExternalSecretMetadataPolicyNone,
+							ConversionStrategy: esv1.ExternalSecretConversionDefault,
+							DecodingStrategy:   esv1.ExternalSecretDecodeNone,
+						},
+					},
+					{
+						Find: &esv1.ExternalSecretFind{
+							ConversionStrategy: esv1.ExternalSecretConversionDefault,
+							DecodingStrategy:   esv1.ExternalSecretDecodeNone,
+						},
+						Rewrite: []esv1.ExternalSecretRewrite{{
+							Merge: &esv1.ExternalSecretRewriteMerge{
+								ConflictPolicy: esv1.ExternalSecretRewriteMergeConflictPolicyError,
+								Strategy:       esv1.ExternalSecretRewriteMergeStrategyExtract,
+							},
+						}},
+					},
+					{
+						SourceRef: &esv1.StoreGeneratorSourceRef{
+							GeneratorRef: &esv1.GeneratorRef{
+								APIVersion: genv1alpha1.SchemeGroupVersion.String(),
+								Kind:       "Password",
+								Name:       "pw",
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			es := &esv1.ExternalSecret{Spec: tt.spec}
+			setDefaults(es)
+			assert.Equal(t, tt.want, es.Spec)
+		})
+	}
+}
+
+func TestAssertOfflineGenerators(t *testing.T) {
+	tests := []struct {
+		name        string
+		dataFrom    []esv1.ExternalSecretDataFromRemoteRef
+		expectedErr string
+	}{
+		{
+			name: "provider data",
+			dataFrom: []esv1.ExternalSecretDataFromRemoteRef{
+				{Extract: &esv1.ExternalSecretDataRemoteRef{Key: "key"}},
+			},
+		},
+		{
+			name: "offline generator",
+			dataFrom: []esv1.ExternalSecretDataFromRemoteRef{
+				{SourceRef: &esv1.StoreGeneratorSourceRef{GeneratorRef: &esv1.GeneratorRef{Kind: "Password", Name: "pw"}}},
+			},
+		},
+		{
+			name: "online generator",
+			dataFrom: []esv1.ExternalSecretDataFromRemoteRef{
+				{Extract: &esv1.ExternalSecretDataRemoteRef{Key: "key"}},
+				{SourceRef: &esv1.StoreGeneratorSourceRef{GeneratorRef: &esv1.GeneratorRef{Kind: "ECRAuthorizationToken", Name: "ecr"}}},
+			},
+			expectedErr: "spec.dataFrom[1]: generator ECRAuthorizationToken calls an external API",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := assertOfflineGenerators(&esv1.ExternalSecret{Spec: esv1.ExternalSecretSpec{DataFrom: tt.dataFrom}})
+			if tt.expectedErr != "" {
+				assert.ErrorContains(t, err, tt.expectedErr)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
@@ -22,6 +22,8 @@ var rootCmd = &cobra.Command{
 	Use:   "esoctl",
 	Short: "operations for external-secrets-operator",
 	Long:  `For more information visit https://external-secrets.io`,
+	// errors are printed by main
+	SilenceErrors: true,
 	Run: func(cmd *cobra.Command, args []string) {
 		_ = cmd.Usage()
 	},
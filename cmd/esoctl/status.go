@@ -0,0 +1,463 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"cmp"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/duration"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	esv1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1"
+	esv1alpha1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1alpha1"
+)
+
+const (
+	statusFormatText = "text"
+	statusFormatJSON = "json"
+)
+
+var (
+	statusFailing bool
+	statusStale   time.Duration
+	statusFormat  string
+)
+
+func init() {
+	rootCmd.AddCommand(statusCmd)
+	addKubeFlags(statusCmd)
+	statusCmd.Flags().BoolVar(&statusFailing, "failing", false, "Only show resources and stores which are not ready")
+	statusCmd.Flags().DurationVar(&statusStale, "stale", 0, "Only show resources which have not been synced successfully for this duration, e.g. 2h")
+	statusCmd.Flags().StringVarP(&statusFormat, "output", "o", statusFormatText, "Output format, one of text or json")
+}
+
+var statusCmd = &cobra.Command{
+	Use:   "status",
+	Short: "reports the sync health of the cluster",
+	Long: `Lists all ExternalSecrets, PushSecrets, ClusterExternalSecrets and stores with their Ready condition,
+the last successful sync and the health of the referenced stores, grouped by store and namespace.
+All namespaces are reported unless --namespace is set.`,
+	RunE: statusRun,
+}
+
+// storeKey identifies a store. Namespace is empty for ClusterSecretStores.
+type storeKey struct {
+	Kind      string
+	Namespace string
+	Name      string
+}
+
+func (k storeKey) String() string {
+	if k.Name == "" {
+		return "<no store>"
+	}
+	if k.Namespace == "" {
+		return k.Kind + "/" + k.Name
+	}
+	return k.Kind + "/" + k.Namespace + "/" + k.Name
+}
+
+type storeStatus struct {
+	Kind      string                 `json:"kind,omitempty"`
+	Namespace string                 `json:"namespace,omitempty"`
+	Name      string                 `json:"name,omitempty"`
+	Ready     corev1.ConditionStatus `json:"ready,omitempty"`
+	Reason    string                 `json:"reason,omitempty"`
+	Message   string                 `json:"message,omitempty"`
+	Resources []resourceStatus       `json:"resources"`
+
+	labels labels.Set
+}
+
+type resourceStatus struct {
+	Kind      string                 `json:"kind"`
+	Namespace string                 `json:"namespace,omitempty"`
+	Name      string                 `json:"name"`
+	Ready     corev1.ConditionStatus `json:"ready"`
+	Reason    string                 `json:"reason,omitempty"`
+	Message   string                 `json:"message,omitempty"`
+	// RefreshTime is the time of the last successful sync.
+	// It is nil for kinds which are not synced from a store.
+	RefreshTime *metav1.Time `json:"refreshTime,omitempty"`
+	// LastSync is the age of the last successful sync.
+	LastSync string `json:"lastSync,omitempty"`
+
+	synced bool
+	stores []storeKey
+}
+
+func (s *storeStatus) failing() bool {
+	return s.Name != "" && s.Ready != corev1.ConditionTrue
+}
+
+func (r *resourceStatus) failing() bool {
+	return r.Ready != corev1.ConditionTrue
+}
+
+func (r *resourceStatus) stale(now time.Time, after time.Duration) bool {
+	if !r.synced {
+		return false
+	}
+	return r.RefreshTime == nil || now.Sub(r.RefreshTime.Time) > after
+}
+
+func statusRun(cmd *cobra.Command, _ []string) error {
+	if statusFormat != statusFormatText && statusFormat != statusFormatJSON {
+		return fmt.Errorf("unsupported output format %q", statusFormat)
+	}
+	ctx := context.Background()
+	scheme, err := newScheme()
+	if err != nil {
+		return err
+	}
+	kube, ns, err := newKubeClient(scheme)
+	if err != nil {
+		return err
+	}
+	var opts []client.ListOption
+	if cmd.Flags().Changed("namespace") {
+		opts = append(opts, client.InNamespace(ns))
+	}
+
+	stores, err := listStoreStatus(ctx, kube, opts)
+	if err != nil {
+		return err
+	}
+	resources, err := listResourceStatus(ctx, kube, opts, stores)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	for i := range resources {
+		resource := &resources[i]
+		if resource.RefreshTime != nil {
+			resource.LastSync = duration.HumanDuration(now.Sub(resource.RefreshTime.Time))
+		}
+		if statusStale > 0 && !resource.stale(now, statusStale) {
+			continue
+		}
+		if statusFailing && !resource.failing() {
+			continue
+		}
+		refs := resource.stores
+		if len(refs) == 0 {
+			refs = []storeKey{{}}
+		}
+		for _, ref := range refs {
+			store, ok := stores[ref]
+			if !ok {
+				store = &storeStatus{Kind: ref.Kind, Namespace: ref.Namespace, Name: ref.Name}
+				if ref.Name != "" {
+					store.Ready = corev1.ConditionUnknown
+					store.Reason = "NotFound"
+					store.Message = "store does not exist"
+				}
+				stores[ref] = store
+			}
+			store.Resources = append(store.Resources, *resource)
+		}
+	}
+
+	report := make([]*storeStatus, 0, len(stores))
+	for _, store := range stores {
+		if len(store.Resources) == 0 && (statusStale > 0 || statusFailing && !store.failing()) {
+			continue
+		}
+		slices.SortFunc(store.Resources, func(a, b resourceStatus) int {
+			return cmp.Or(cmp.Compare(a.Namespace, b.Namespace), cmp.Compare(a.Kind, b.Kind), cmp.Compare(a.Name, b.Name))
+		})
+		report = append(report, store)
+	}
+	slices.SortFunc(report, func(a, b *storeStatus) int {
+		return cmp.Or(cmp.Compare(a.Kind, b.Kind), cmp.Compare(a.Namespace, b.Namespace), cmp.Compare(a.Name, b.Name))
+	})
+
+	if statusFormat == statusFormatJSON {
+		encoder := json.NewEncoder(os.Stdout)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(report)
+	}
+	return printStatus(os.Stdout, report)
+}
+
+func listStoreStatus(ctx context.Context, kube client.Client, opts []client.ListOption) (map[storeKey]*storeStatus, error) {
+	stores := map[storeKey]*storeStatus{}
+	add := func(store esv1.GenericStore) {
+		status := &storeStatus{
+			Kind:      store.GetKind(),
+			Namespace: store.GetNamespace(),
+			Name:      store.GetName(),
+			Ready:     corev1.ConditionUnknown,
+			labels:    store.GetLabels(),
+		}
+		for _, condition := range store.GetStatus().Conditions {
+			if condition.Type == esv1.SecretStoreReady {
+				status.Ready = condition.Status
+				status.Reason = condition.Reason
+				status.Message = condition.Message
+			}
+		}
+		stores[storeKey{Kind: status.Kind, Namespace: status.Namespace, Name: status.Name}] = status
+	}
+
+	var secretStores esv1.SecretStoreList
+	if err := kube.List(ctx, &secretStores, opts...); err != nil {
+		return nil, fmt.Errorf("could not list SecretStores: %w", err)
+	}
+	for i := range secretStores.Items {
+		store := &secretStores.Items[i]
+		store.Kind = esv1.SecretStoreKind
+		add(store)
+	}
+	var clusterStores esv1.ClusterSecretStoreList
+	if err := kube.List(ctx, &clusterStores); err != nil {
+		return nil, fmt.Errorf("could not list ClusterSecretStores: %w", err)
+	}
+	for i := range clusterStores.Items {
+		store := &clusterStores.Items[i]
+		store.Kind = esv1.ClusterSecretStoreKind
+		add(store)
+	}
+	return stores, nil
+}
+
+func listResourceStatus(ctx context.Context, kube client.Client, opts []client.ListOption, stores map[storeKey]*storeStatus) ([]resourceStatus, error) {
+	var resources []resourceStatus
+
+	var externalSecrets esv1.ExternalSecretList
+	if err := kube.List(ctx, &externalSecrets, opts...); err != nil {
+		return nil, fmt.Errorf("could not list ExternalSecrets: %w", err)
+	}
+	for _, es := range externalSecrets.Items {
+		resource := resourceStatus{
+			Kind:      esv1.ExtSecretKind,
+			Namespace: es.Namespace,
+			Name:      es.Name,
+			Ready:     corev1.ConditionUnknown,
+			synced:    true,
+			stores:    externalSecretStores(&es.Spec, []string{es.Namespace}),
+		}
+		paused := false
+		for _, condition := range es.Status.Conditions {
+			switch condition.Type {
+			case esv1.ExternalSecretReady:
+				resource.Ready, resource.Reason, resource.Message = condition.Status, condition.Reason, condition.Message
+			case esv1.ExternalSecretPaused:
+				paused = condition.Status == corev1.ConditionTrue
+			}
+		}
+		if paused {
+			resource.Reason = esv1.ConditionReasonPaused
+		}
+		if !es.Status.RefreshTime.IsZero() {
+			resource.RefreshTime = &es.Status.RefreshTime
+		}
+		resources = append(resources, resource)
+	}
+
+	var pushSecrets esv1alpha1.PushSecretList
+	if err := kube.List(ctx, &pushSecrets, opts...); err != nil {
+		return nil, fmt.Errorf("could not list PushSecrets: %w", err)
+	}
+	for _, ps := range pushSecrets.Items {
+		resource := resourceStatus{
+			Kind:      esv1alpha1.PushSecretKind,
+			Namespace: ps.Namespace,
+			Name:      ps.Name,
+			Ready:     corev1.ConditionUnknown,
+			synced:    true,
+			stores:    pushSecretStores(&ps, stores),
+		}
+		paused := false
+		for _, condition := range ps.Status.Conditions {
+			switch condition.Type {
+			case esv1alpha1.PushSecretReady:
+				resource.Ready, resource.Reason, resource.Message = condition.Status, condition.Reason, condition.Message
+			case esv1alpha1.PushSecretPaused:
+				paused = condition.Status == corev1.ConditionTrue
+			}
+		}
+		if paused {
+			resource.Reason = esv1.ConditionReasonPaused
+		}
+		if !ps.Status.RefreshTime.IsZero() {
+			resource.RefreshTime = &ps.Status.RefreshTime
+		}
+		resources = append(resources, resource)
+	}
+
+	var clusterExternalSecrets esv1.ClusterExternalSecretList
+	if err := kube.List(ctx, &clusterExternalSecrets); err != nil {
+		return nil, fmt.Errorf("could not list ClusterExternalSecrets: %w", err)
+	}
+	for _, ces := range clusterExternalSecrets.Items {
+		resource := resourceStatus{
+			Kind:   esv1.ClusterExtSecretKind,
+			Name:   ces.Name,
+			Ready:  corev1.ConditionUnknown,
+			stores: externalSecretStores(&ces.Spec.ExternalSecretSpec, ces.Status.ProvisionedNamespaces),
+		}
+		for _, condition := range ces.Status.Conditions {
+			if condition.Type == esv1.ClusterExternalSecretReady {
+				resource.Ready, resource.Message = condition.Status, condition.Message
+			}
+		}
+		if len(ces.Status.FailedNamespaces) > 0 {
+			failed := make([]string, 0, len(ces.Status.FailedNamespaces))
+			for _, failure := range ces.Status.FailedNamespaces {
+				failed = append(failed, failure.Namespace+": "+failure.Reason)
+			}
+			resource.Message = strings.TrimPrefix(resource.Message+"; failed namespaces: "+strings.Join(failed, ", "), "; ")
+		}
+		resources = append(resources, resource)
+	}
+	return resources, nil
+}
+
+// externalSecretStores returns the stores referenced by an ExternalSecret spec.
+// SecretStores are resolved in each of the given namespaces.
+func externalSecretStores(spec *esv1.ExternalSecretSpec, namespaces []string) []storeKey {
+	refs := []esv1.SecretStoreRef{spec.SecretStoreRef}
+	for _, data := range spec.Data {
+		if data.SourceRef != nil {
+			refs = append(refs, data.SourceRef.SecretStoreRef)
+		}
+	}
+	for _, data := range spec.DataFrom {
+		if data.SourceRef != nil && data.SourceRef.SecretStoreRef != nil {
+			refs = append(refs, *data.SourceRef.SecretStoreRef)
+		}
+	}
+
+	var keys []storeKey
+	for _, ref := range refs {
+		if ref.Name == "" {
+			continue
+		}
+		if ref.Kind == esv1.ClusterSecretStoreKind {
+			keys = append(keys, storeKey{Kind: ref.Kind, Name: ref.Name})
+			continue
+		}
+		for _, ns := range namespaces {
+			keys = append(keys, storeKey{Kind: esv1.SecretStoreKind, Namespace: ns, Name: ref.Name})
+		}
+	}
+	slices.SortFunc(keys, func(a, b storeKey) int { return strings.Compare(a.String(), b.String()) })
+	return slices.Compact(keys)
+}
+
+// pushSecretStores returns the stores referenced by a PushSecret by name or label selector.
+func pushSecretStores(ps *esv1alpha1.PushSecret, stores map[storeKey]*storeStatus) []storeKey {
+	var keys []storeKey
+	for _, ref := range ps.Spec.SecretStoreRefs {
+		kind := cmp.Or(ref.Kind, esv1.SecretStoreKind)
+		ns := ps.Namespace
+		if kind == esv1.ClusterSecretStoreKind {
+			ns = ""
+		}
+		if ref.Name != "" {
+			keys = append(keys, storeKey{Kind: kind, Namespace: ns, Name: ref.Name})
+			continue
+		}
+		if ref.LabelSelector == nil {
+			continue
+		}
+		selector, err := metav1.LabelSelectorAsSelector(ref.LabelSelector)
+		if err != nil {
+			continue
+		}
+		for key, store := range stores {
+			if key.Kind == kind && key.Namespace == ns && selector.Matches(store.labels) {
+				keys = append(keys, key)
+			}
+		}
+	}
+	slices.SortFunc(keys, func(a, b storeKey) int { return strings.Compare(a.String(), b.String()) })
+	return slices.Compact(keys)
+}
+
+func printStatus(out io.Writer, report []*storeStatus) error {
+	for i, store := range report {
+		if i > 0 {
+			if _, err := fmt.Fprintln(out); err != nil {
+				return err
+			}
+		}
+		key := storeKey{Kind: store.Kind, Namespace: store.Namespace, Name: store.Name}
+		header := key.String()
+		if store.Name != "" {
+			header += ": " + string(store.Ready)
+			if store.Reason != "" {
+				header += " (" + store.Reason + ")"
+			}
+			if store.Message != "" && store.failing() {
+				header += " " + store.Message
+			}
+		}
+		if _, err := fmt.Fprintln(out, header); err != nil {
+			return err
+		}
+		if len(store.Resources) == 0 {
+			continue
+		}
+		w := tabwriter.NewWriter(out, 0, 4, 2, ' ', 0)
+		_, _ = fmt.Fprintln(w, "  NAMESPACE\tKIND\tNAME\tREADY\tREASON\tLAST SYNC\tMESSAGE")
+		for _, resource := range store.Resources {
+			_, _ = fmt.Fprintf(w, "  %s\t%s\t%s\t%s\t%s\t%s\t%s\n",
+				cmp.Or(resource.Namespace, "-"),
+				resource.Kind,
+				resource.Name,
+				resource.Ready,
+				cmp.Or(resource.Reason, "-"),
+				lastSync(resource),
+				firstLine(resource.Message),
+			)
+		}
+		if err := w.Flush(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func lastSync(resource resourceStatus) string {
+	switch {
+	case !resource.synced:
+		return "-"
+	case resource.LastSync == "":
+		return "never"
+	default:
+		return resource.LastSync + " ago"
+	}
+}
+
+func firstLine(s string) string {
+	line, _, _ := strings.Cut(s, "\n")
+	return line
+}
@@ -0,0 +1,139 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+
+	esv1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1"
+)
+
+func TestExternalSecretStores(t *testing.T) {
+	tests := []struct {
+		name       string
+		spec       esv1.ExternalSecretSpec
+		namespaces []string
+		want       []storeKey
+	}{
+		{
+			name:       "no store",
+			namespaces: []string{"default"},
+		},
+		{
+			name: "SecretStore in the namespace",
+			spec: esv1.ExternalSecretSpec{
+				SecretStoreRef: esv1.SecretStoreRef{Name: "vault"},
+			},
+			namespaces: []string{"default"},
+			want: []storeKey{
+				{Kind: esv1.SecretStoreKind, Namespace: "default", Name: "vault"},
+			},
+		},
+		{
+			name: "SecretStore in every namespace of a ClusterExternalSecret",
+			spec: esv1.ExternalSecretSpec{
+				SecretStoreRef: esv1.SecretStoreRef{Name: "vault", Kind: esv1.SecretStoreKind},
+			},
+			namespaces: []string{"team-b", "team-a"},
+			want: []storeKey{
+				{Kind: esv1.SecretStoreKind, Namespace: "team-a", Name: "vault"},
+				{Kind: esv1.SecretStoreKind, Namespace: "team-b", Name: "vault"},
+			},
+		},
+		{
+			name: "stores of data and dataFrom are deduplicated",
+			spec: esv1.ExternalSecretSpec{
+				SecretStoreRef: esv1.SecretStoreRef{Name: "vault"},
+				Data: []esv1.ExternalSecretData{
+					{SecretKey: "a", SourceRef: &esv1.StoreSourceRef{SecretStoreRef: esv1.SecretStoreRef{Name: "aws", Kind: esv1.ClusterSecretStoreKind}}},
+					{SecretKey: "b", SourceRef: &esv1.StoreSourceRef{SecretStoreRef: esv1.SecretStoreRef{Name: "vault"}}},
+					{SecretKey: "c"},
+				},
+				DataFrom: []esv1.ExternalSecretDataFromRemoteRef{
+					{SourceRef: &esv1.StoreGeneratorSourceRef{SecretStoreRef: &esv1.SecretStoreRef{Name: "aws", Kind: esv1.ClusterSecretStoreKind}}},
+					{SourceRef: &esv1.StoreGeneratorSourceRef{GeneratorRef: &esv1.GeneratorRef{Kind: "Password", Name: "pw"}}},
+				},
+			},
+			namespaces: []string{"default"},
+			want: []storeKey{
+				{Kind: esv1.ClusterSecretStoreKind, Name: "aws"},
+				{Kind: esv1.SecretStoreKind, Namespace: "default", Name: "vault"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, externalSecretStores(&tt.spec, tt.namespaces))
+		})
+	}
+}
+
+func TestPrintStatus(t *testing.T) {
+	tests := []struct {
+		name   string
+		report []*storeStatus
+		want   string
+	}{
+		{
+			name: "ready store without resources",
+			report: []*storeStatus{
+				{Kind: esv1.ClusterSecretStoreKind, Name: "aws", Ready: corev1.ConditionTrue, Reason: "Valid", Message: "store validated"},
+			},
+			want: "ClusterSecretStore/aws: True (Valid)\n",
+		},
+		{
+			name: "failing store with resources",
+			report: []*storeStatus{
+				{
+					Kind: esv1.SecretStoreKind, Namespace: "default", Name: "vault",
+					Ready: corev1.ConditionFalse, Reason: "InvalidProviderConfig", Message: "permission denied",
+					Resources: []resourceStatus{
+						{
+							Kind: esv1.ExtSecretKind, Namespace: "default", Name: "app",
+							Ready: corev1.ConditionFalse, Reason: "SecretSyncedError", Message: "could not get secret\ndetails",
+							LastSync: "5m", synced: true,
+						},
+						{Kind: esv1.ExtSecretKind, Namespace: "default", Name: "db", Ready: corev1.ConditionFalse, synced: true},
+					},
+				},
+				{
+					Resources: []resourceStatus{
+						{Kind: esv1.ClusterExtSecretKind, Name: "shared", Ready: corev1.ConditionTrue},
+					},
+				},
+			},
+			want: "SecretStore/default/vault: False (InvalidProviderConfig) permission denied\n" +
+				"  NAMESPACE  KIND            NAME  READY  REASON             LAST SYNC  MESSAGE\n" +
+				"  default    ExternalSecret  app   False  SecretSyncedError  5m ago     could not get secret\n" +
+				"  default    ExternalSecret  db    False  -                  never      \n" +
+				"\n" +
+				"<no store>\n" +
+				"  NAMESPACE  KIND                   NAME    READY  REASON  LAST SYNC  MESSAGE\n" +
+				"  -          ClusterExternalSecret  shared  True   -       -          \n",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var out bytes.Buffer
+			require.NoError(t, printStatus(&out, tt.report))
+			assert.Equal(t, tt.want, out.String())
+		})
+	}
+}
@@ -0,0 +1,208 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"slices"
+	"time"
+
+	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/labels"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	esv1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1"
+	esv1alpha1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1alpha1"
+)
+
+var (
+	selectLabels        string
+	selectStore         string
+	selectClusterStore  bool
+	selectAllNamespaces bool
+)
+
+func init() {
+	for _, cmd := range []*cobra.Command{syncCmd, pauseCmd, resumeCmd} {
+		rootCmd.AddCommand(cmd)
+		addKubeFlags(cmd)
+		cmd.Flags().StringVarP(&selectLabels, "selector", "l", "", "Label selector of the resources, e.g. app=web")
+		cmd.Flags().StringVar(&selectStore, "all-for-store", "", "Select all resources referencing the SecretStore of this name")
+		cmd.Flags().BoolVar(&selectClusterStore, "cluster-store", false, "If set, --all-for-store refers to a ClusterSecretStore")
+		cmd.Flags().BoolVarP(&selectAllNamespaces, "all-namespaces", "A", false, "If set, resources of all namespaces are selected")
+	}
+}
+
+const selectUsage = `Resources are selected by name, by --selector or by --all-for-store, which selects all resources referencing a store.
+The kind is one of externalsecret (es) or pushsecret (ps).`
+
+var syncCmd = &cobra.Command{
+	Use:   "sync KIND [NAME]...",
+	Short: "triggers an immediate sync of ExternalSecrets or PushSecrets",
+	Long: `Triggers an immediate sync of ExternalSecrets or PushSecrets by setting the ` + esv1.AnnotationForceSync + ` annotation.
+` + selectUsage,
+	Args: cobra.MinimumNArgs(1),
+	RunE: func(_ *cobra.Command, args []string) error {
+		value := time.Now().Format(time.RFC3339Nano)
+		return annotateRun(args, "sync triggered", func(annotations map[string]string) {
+			annotations[esv1.AnnotationForceSync] = value
+		})
+	},
+}
+
+var pauseCmd = &cobra.Command{
+	Use:   "pause KIND [NAME]...",
+	Short: "pauses syncing of ExternalSecrets or PushSecrets",
+	Long: `Pauses syncing of ExternalSecrets or PushSecrets by setting the ` + esv1.AnnotationPaused + ` annotation.
+While paused, the controller makes no provider calls and keeps the existing secrets.
+` + selectUsage,
+	Args: cobra.MinimumNArgs(1),
+	RunE: func(_ *cobra.Command, args []string) error {
+		return annotateRun(args, "paused", func(annotations map[string]string) {
+			annotations[esv1.AnnotationPaused] = "true"
+		})
+	},
+}
+
+var resumeCmd = &cobra.Command{
+	Use:   "resume KIND [NAME]...",
+	Short: "resumes syncing of paused ExternalSecrets or PushSecrets",
+	Long: `Resumes syncing of ExternalSecrets or PushSecrets by removing the ` + esv1.AnnotationPaused + ` annotation.
+` + selectUsage,
+	Args: cobra.MinimumNArgs(1),
+	RunE: func(_ *cobra.Command, args []string) error {
+		return annotateRun(args, "resumed", func(annotations map[string]string) {
+			delete(annotations, esv1.AnnotationPaused)
+		})
+	},
+}
+
+// annotateRun patches the annotations of the selected resources.
+func annotateRun(args []string, done string, annotate func(map[string]string)) error {
+	ctx := context.Background()
+	kind, names := args[0], args[1:]
+	if len(names) > 0 && (selectLabels != "" || selectStore != "") {
+		return errors.New("resources can either be selected by name or by --selector and --all-for-store")
+	}
+	if len(names) == 0 && selectLabels == "" && selectStore == "" {
+		return errors.New("no resources selected, use a name, --selector or --all-for-store")
+	}
+	scheme, err := newScheme()
+	if err != nil {
+		return err
+	}
+	kube, ns, err := newKubeClient(scheme)
+	if err != nil {
+		return err
+	}
+	if selectAllNamespaces {
+		ns = ""
+	}
+	objects, err := selectObjects(ctx, kube, kind, ns, names)
+	if err != nil {
+		return err
+	}
+	if len(objects) == 0 {
+		return errors.New("no resources found")
+	}
+	for _, obj := range objects {
+		// the kind is reset when decoding the response of the patch
+		objKind := obj.GetObjectKind().GroupVersionKind().Kind
+		patch := client.MergeFrom(obj.DeepCopyObject().(client.Object))
+		annotations := obj.GetAnnotations()
+		if annotations == nil {
+			annotations = map[string]string{}
+		}
+		annotate(annotations)
+		obj.SetAnnotations(annotations)
+		if err := kube.Patch(ctx, obj, patch); err != nil {
+			return fmt.Errorf("could not patch %s: %w", client.ObjectKeyFromObject(obj), err)
+		}
+		_, _ = fmt.Fprintf(os.Stdout, "%s %s %s\n", objKind, client.ObjectKeyFromObject(obj), done)
+	}
+	return nil
+}
+
+// selectObjects returns the ExternalSecrets or PushSecrets selected by name or by the selection flags.
+func selectObjects(ctx context.Context, kube client.Client, kind, ns string, names []string) ([]client.Object, error) {
+	var list client.ObjectList
+	switch kind {
+	case "externalsecret", "externalsecrets", "es":
+		list = &esv1.ExternalSecretList{}
+	case "pushsecret", "pushsecrets", "ps":
+		list = &esv1alpha1.PushSecretList{}
+	default:
+		return nil, fmt.Errorf("unsupported kind %q, must be one of externalsecret or pushsecret", kind)
+	}
+	selector, err := labels.Parse(selectLabels)
+	if err != nil {
+		return nil, fmt.Errorf("invalid selector: %w", err)
+	}
+	opts := []client.ListOption{client.MatchingLabelsSelector{Selector: selector}}
+	if ns != "" {
+		opts = append(opts, client.InNamespace(ns))
+	}
+	if err := kube.List(ctx, list, opts...); err != nil {
+		return nil, err
+	}
+
+	var objects []client.Object
+	found := map[string]bool{}
+	add := func(obj client.Object, stores []storeKey) {
+		if len(names) > 0 && !slices.Contains(names, obj.GetName()) {
+			return
+		}
+		if selectStore != "" && !slices.ContainsFunc(stores, referencesSelectedStore) {
+			return
+		}
+		found[obj.GetName()] = true
+		objects = append(objects, obj)
+	}
+	switch l := list.(type) {
+	case *esv1.ExternalSecretList:
+		for i := range l.Items {
+			es := &l.Items[i]
+			es.SetGroupVersionKind(esv1.SchemeGroupVersion.WithKind(esv1.ExtSecretKind))
+			add(es, externalSecretStores(&es.Spec, []string{es.Namespace}))
+		}
+	case *esv1alpha1.PushSecretList:
+		for i := range l.Items {
+			ps := &l.Items[i]
+			ps.SetGroupVersionKind(esv1alpha1.SchemeGroupVersion.WithKind(esv1alpha1.PushSecretKind))
+			var stores []storeKey
+			for _, ref := range ps.Spec.SecretStoreRefs {
+				stores = append(stores, storeKey{Kind: ref.Kind, Name: ref.Name})
+			}
+			add(ps, stores)
+		}
+	}
+	for _, name := range names {
+		if !found[name] {
+			return nil, fmt.Errorf("%s %q not found", kind, name)
+		}
+	}
+	return objects, nil
+}
+
+func referencesSelectedStore(key storeKey) bool {
+	kind := esv1.SecretStoreKind
+	if selectClusterStore {
+		kind = esv1.ClusterSecretStoreKind
+	}
+	return key.Name == selectStore && (key.Kind == kind || key.Kind == "" && kind == esv1.SecretStoreKind)
+}
@@ -0,0 +1,229 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package audit records which resource accessed which remote secret in which store.
+// Audit events never contain secret values.
+package audit
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/spf13/pflag"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	esv1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1"
+	"github.com/external-secrets/external-secrets/pkg/feature"
+)
+
+const (
+	OperationGetSecret     = "GetSecret"
+	OperationGetSecretMap  = "GetSecretMap"
+	OperationGetAllSecrets = "GetAllSecrets"
+	OperationPushSecret    = "PushSecret"
+	OperationDeleteSecret  = "DeleteSecret"
+
+	OutcomeSuccess  = "success"
+	OutcomeNotFound = "not_found"
+	OutcomeError    = "error"
+)
+
+// Event is a single access to a secret provider.
+type Event struct {
+	Time      time.Time `json:"time"`
+	Operation string    `json:"operation"`
+	Resource  Resource  `json:"resource"`
+	Store     Store     `json:"store"`
+	RemoteKey string    `json:"remoteKey,omitempty"`
+	Property  string    `json:"property,omitempty"`
+	Version   string    `json:"version,omitempty"`
+	Find      *Find     `json:"find,omitempty"`
+	Outcome   string    `json:"outcome"`
+	Error     string    `json:"error,omitempty"`
+	LatencyMs float64   `json:"latencyMs"`
+}
+
+// Resource identifies the resource on whose behalf a provider was called.
+type Resource struct {
+	Kind      string `json:"kind"`
+	Namespace string `json:"namespace,omitempty"`
+	Name      string `json:"name"`
+	UID       string `json:"uid,omitempty"`
+}
+
+// Store identifies the (Cluster)SecretStore that was used to call the provider.
+type Store struct {
+	Kind      string `json:"kind"`
+	Namespace string `json:"namespace,omitempty"`
+	Name      string `json:"name"`
+	Provider  string `json:"provider"`
+}
+
+// Find holds the criteria of a GetAllSecrets call.
+type Find struct {
+	Path string            `json:"path,omitempty"`
+	Name string            `json:"name,omitempty"`
+	Tags map[string]string `json:"tags,omitempty"`
+}
+
+// Sink persists audit events.
+// Implementations must be safe for concurrent use.
+type Sink interface {
+	// Write records a single event.
+	Write(event Event) error
+	// Close flushes all buffered events and releases resources.
+	Close(ctx context.Context) error
+}
+
+var (
+	log = ctrl.Log.WithName("audit")
+
+	sinksMu sync.RWMutex
+	sinks   []Sink
+)
+
+// Enabled returns true if at least one sink is configured.
+func Enabled() bool {
+	sinksMu.RLock()
+	defer sinksMu.RUnlock()
+	return len(sinks) > 0
+}
+
+// SetSinks replaces the configured sinks.
+// Previously configured sinks are not closed.
+func SetSinks(s ...Sink) {
+	sinksMu.Lock()
+	defer sinksMu.Unlock()
+	sinks = s
+}
+
+// Record writes the event to all configured sinks.
+// Errors are logged and never returned, as auditing must not break reconciliation.
+func Record(event Event) {
+	sinksMu.RLock()
+	defer sinksMu.RUnlock()
+	for _, sink := range sinks {
+		if err := sink.Write(event); err != nil {
+			log.Error(err, "unable to write audit event", "operation", event.Operation, "resource", event.Resource.Name)
+		}
+	}
+}
+
+// Close flushes and closes all configured sinks.
+func Close(ctx context.Context) error {
+	sinksMu.Lock()
+	defer sinksMu.Unlock()
+	var errs []error
+	for _, sink := range sinks {
+		errs = append(errs, sink.Close(ctx))
+	}
+	sinks = nil
+	return errors.Join(errs...)
+}
+
+type resourceKey struct{}
+
+// WithResource returns a context that carries the identity of the resource
+// which is reconciled. It is attached to all events recorded with that context.
+func WithResource(ctx context.Context, resource Resource) context.Context {
+	return context.WithValue(ctx, resourceKey{}, resource)
+}
+
+// ResourceFrom returns the resource identity stored in the context.
+func ResourceFrom(ctx context.Context) Resource {
+	resource, _ := ctx.Value(resourceKey{}).(Resource)
+	return resource
+}
+
+// StoreFrom returns the identity of the given store.
+func StoreFrom(store esv1.GenericStore) Store {
+	provider, _ := esv1.GetProviderName(store)
+	return Store{
+		Kind:      store.GetKind(),
+		Namespace: store.GetNamespace(),
+		Name:      store.GetName(),
+		Provider:  provider,
+	}
+}
+
+func outcome(err error) string {
+	switch {
+	case err == nil:
+		return OutcomeSuccess
+	case errors.Is(err, esv1.NoSecretErr):
+		return OutcomeNotFound
+	default:
+		return OutcomeError
+	}
+}
+
+type options struct {
+	stdout             bool
+	file               string
+	webhookURL         string
+	webhookHeaders     map[string]string
+	webhookBatchSize   int
+	webhookFlushPeriod time.Duration
+	webhookMaxRetries  int
+	webhookTimeout     time.Duration
+}
+
+func setUp(opts options) error {
+	var configured []Sink
+	if opts.stdout {
+		configured = append(configured, NewStdoutSink())
+	}
+	if opts.file != "" {
+		sink, err := NewFileSink(opts.file)
+		if err != nil {
+			return err
+		}
+		configured = append(configured, sink)
+	}
+	if opts.webhookURL != "" {
+		configured = append(configured, NewWebhookSink(WebhookConfig{
+			URL:           opts.webhookURL,
+			Headers:       opts.webhookHeaders,
+			BatchSize:     opts.webhookBatchSize,
+			FlushInterval: opts.webhookFlushPeriod,
+			MaxRetries:    opts.webhookMaxRetries,
+			Timeout:       opts.webhookTimeout,
+		}))
+	}
+	SetSinks(configured...)
+	return nil
+}
+
+func init() {
+	var opts options
+	fs := pflag.NewFlagSet("audit", pflag.ExitOnError)
+	fs.BoolVar(&opts.stdout, "audit-log-stdout", false, "Write audit events of secret provider access as JSON lines to stdout.")
+	fs.StringVar(&opts.file, "audit-log-file", "", "Write audit events of secret provider access as JSON lines to the given file.")
+	fs.StringVar(&opts.webhookURL, "audit-webhook-url", "", "Send batches of audit events of secret provider access to the given HTTP endpoint.")
+	fs.StringToStringVar(&opts.webhookHeaders, "audit-webhook-headers", nil, "Additional HTTP headers sent to the audit webhook, e.g. Authorization=Bearer xyz.")
+	fs.IntVar(&opts.webhookBatchSize, "audit-webhook-batch-size", defaultBatchSize, "Maximum number of audit events sent to the audit webhook in a single request.")
+	fs.DurationVar(&opts.webhookFlushPeriod, "audit-webhook-flush-interval", defaultFlushInterval, "Maximum time audit events are buffered before they are sent to the audit webhook.")
+	fs.IntVar(&opts.webhookMaxRetries, "audit-webhook-max-retries", defaultMaxRetries, "Number of retries when sending audit events to the audit webhook fails.")
+	fs.DurationVar(&opts.webhookTimeout, "audit-webhook-timeout", defaultTimeout, "Timeout of a single request to the audit webhook.")
+	feature.Register(feature.Feature{
+		Flags: fs,
+		Initialize: func() {
+			if err := setUp(opts); err != nil {
+				log.Error(err, "unable to set up audit sinks")
+			}
+		},
+	})
+}
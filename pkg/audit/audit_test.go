@@ -0,0 +1,221 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package audit
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	esv1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1"
+	esv1alpha1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1alpha1"
+	"github.com/external-secrets/external-secrets/pkg/provider/testing/fake"
+)
+
+type memorySink struct {
+	mu     sync.Mutex
+	events []Event
+}
+
+func (s *memorySink) Write(event Event) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.events = append(s.events, event)
+	return nil
+}
+
+func (s *memorySink) Close(_ context.Context) error {
+	return nil
+}
+
+func TestClient(t *testing.T) {
+	sink := &memorySink{}
+	SetSinks(sink)
+	defer SetSinks()
+
+	store := &esv1.SecretStore{
+		TypeMeta:   metav1.TypeMeta{Kind: esv1.SecretStoreKind},
+		ObjectMeta: metav1.ObjectMeta{Name: "vault", Namespace: "default"},
+		Spec: esv1.SecretStoreSpec{
+			Provider: &esv1.SecretStoreProvider{Fake: &esv1.FakeProvider{}},
+		},
+	}
+	fakeClient := fake.New()
+	fakeClient.WithGetSecret([]byte("s3cr3t"), nil)
+	fakeClient.WithGetSecretMap(nil, esv1.NoSecretErr)
+	fakeClient.WithSetSecret(errors.New("boom"))
+
+	client := NewClient(fakeClient, store)
+	ctx := WithResource(context.Background(), Resource{Kind: esv1.ExtSecretKind, Namespace: "default", Name: "app", UID: "1234"})
+
+	if _, err := client.GetSecret(ctx, esv1.ExternalSecretDataRemoteRef{Key: "db", Property: "password", Version: "2"}); err != nil {
+		t.Fatal(err)
+	}
+	_, _ = client.GetSecretMap(ctx, esv1.ExternalSecretDataRemoteRef{Key: "missing"})
+	_ = client.PushSecret(ctx, &corev1.Secret{}, esv1alpha1.PushSecretData{
+		Match: esv1alpha1.PushSecretMatch{RemoteRef: esv1alpha1.PushSecretRemoteRef{RemoteKey: "remote"}},
+	})
+
+	if len(sink.events) != 3 {
+		t.Fatalf("expected 3 events, got %d", len(sink.events))
+	}
+	get := sink.events[0]
+	wantStore := Store{Kind: esv1.SecretStoreKind, Namespace: "default", Name: "vault", Provider: "fake"}
+	if get.Operation != OperationGetSecret || get.RemoteKey != "db" || get.Property != "password" || get.Version != "2" ||
+		get.Outcome != OutcomeSuccess || get.Resource.UID != "1234" || get.Store != wantStore {
+		t.Errorf("unexpected GetSecret event: %+v", get)
+	}
+	if sink.events[1].Outcome != OutcomeNotFound {
+		t.Errorf("expected outcome %s, got %s", OutcomeNotFound, sink.events[1].Outcome)
+	}
+	if push := sink.events[2]; push.Outcome != OutcomeError || push.Error != "boom" || push.RemoteKey != "remote" {
+		t.Errorf("unexpected PushSecret event: %+v", push)
+	}
+
+	for _, event := range sink.events {
+		line, err := json.Marshal(event)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if strings.Contains(string(line), "s3cr3t") {
+			t.Errorf("audit event contains secret value: %s", line)
+		}
+	}
+}
+
+func TestJSONSink(t *testing.T) {
+	var buf bytes.Buffer
+	sink := NewJSONSink(&buf)
+	for _, op := range []string{OperationGetSecret, OperationDeleteSecret} {
+		if err := sink.Write(Event{Operation: op, Outcome: OutcomeSuccess}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	if len(lines) != 2 {
+		t.Fatalf("expected 2 lines, got %d: %q", len(lines), buf.String())
+	}
+	var event Event
+	if err := json.Unmarshal([]byte(lines[1]), &event); err != nil {
+		t.Fatal(err)
+	}
+	if event.Operation != OperationDeleteSecret {
+		t.Errorf("expected operation %s, got %s", OperationDeleteSecret, event.Operation)
+	}
+}
+
+func TestWebhookSinkBatches(t *testing.T) {
+	var (
+		mu      sync.Mutex
+		batches [][]Event
+	)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer token" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		var batch []Event
+		if err := json.NewDecoder(r.Body).Decode(&batch); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		mu.Lock()
+		batches = append(batches, batch)
+		mu.Unlock()
+	}))
+	defer srv.Close()
+
+	sink := NewWebhookSink(WebhookConfig{
+		URL:           srv.URL,
+		Headers:       map[string]string{"Authorization": "Bearer token"},
+		BatchSize:     2,
+		FlushInterval: time.Hour,
+	})
+	for range 5 {
+		if err := sink.Write(Event{Operation: OperationGetSecret}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := sink.Close(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	total := 0
+	for _, batch := range batches {
+		if len(batch) > 2 {
+			t.Errorf("expected batches of at most 2 events, got %d", len(batch))
+		}
+		total += len(batch)
+	}
+	if total != 5 {
+		t.Errorf("expected 5 events to be sent, got %d", total)
+	}
+	if err := sink.Write(Event{}); err == nil {
+		t.Error("expected an error when writing to a closed sink")
+	}
+}
+
+func TestWebhookSinkRetries(t *testing.T) {
+	tests := []struct {
+		name      string
+		status    int
+		wantCalls int
+	}{
+		{name: "retry on server error", status: http.StatusServiceUnavailable, wantCalls: 3},
+		{name: "retry on throttling", status: http.StatusTooManyRequests, wantCalls: 3},
+		{name: "no retry on client error", status: http.StatusBadRequest, wantCalls: 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var mu sync.Mutex
+			calls := 0
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				mu.Lock()
+				defer mu.Unlock()
+				calls++
+				w.WriteHeader(tt.status)
+			}))
+			defer srv.Close()
+
+			sink := NewWebhookSink(WebhookConfig{
+				URL:          srv.URL,
+				MaxRetries:   2,
+				RetryBackoff: time.Millisecond,
+			})
+			err := sink.send([]Event{{Operation: OperationGetSecret}})
+			if err == nil {
+				t.Error("expected an error")
+			}
+			mu.Lock()
+			defer mu.Unlock()
+			if calls != tt.wantCalls {
+				t.Errorf("expected %d calls, got %d", tt.wantCalls, calls)
+			}
+			_ = sink.Close(context.Background())
+		})
+	}
+}
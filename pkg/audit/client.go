@@ -0,0 +1,112 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package audit
+
+import (
+	"context"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+
+	esv1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1"
+)
+
+// Client wraps a SecretsClient and records an audit event for every
+// call that reads, writes or deletes a secret.
+type Client struct {
+	esv1.SecretsClient
+	store Store
+}
+
+var _ esv1.SecretsClient = &Client{}
+
+// NewClient returns a SecretsClient that audits all secret access of client.
+func NewClient(client esv1.SecretsClient, store esv1.GenericStore) *Client {
+	return &Client{
+		SecretsClient: client,
+		store:         StoreFrom(store),
+	}
+}
+
+func (c *Client) record(ctx context.Context, event Event, start time.Time, err error) {
+	event.Time = start.UTC()
+	event.Resource = ResourceFrom(ctx)
+	event.Store = c.store
+	event.Outcome = outcome(err)
+	if err != nil {
+		event.Error = err.Error()
+	}
+	event.LatencyMs = float64(time.Since(start).Microseconds()) / 1000
+	Record(event)
+}
+
+func (c *Client) GetSecret(ctx context.Context, ref esv1.ExternalSecretDataRemoteRef) ([]byte, error) {
+	start := time.Now()
+	secret, err := c.SecretsClient.GetSecret(ctx, ref)
+	c.record(ctx, remoteRefEvent(OperationGetSecret, ref), start, err)
+	return secret, err
+}
+
+func (c *Client) GetSecretMap(ctx context.Context, ref esv1.ExternalSecretDataRemoteRef) (map[string][]byte, error) {
+	start := time.Now()
+	secrets, err := c.SecretsClient.GetSecretMap(ctx, ref)
+	c.record(ctx, remoteRefEvent(OperationGetSecretMap, ref), start, err)
+	return secrets, err
+}
+
+func (c *Client) GetAllSecrets(ctx context.Context, ref esv1.ExternalSecretFind) (map[string][]byte, error) {
+	start := time.Now()
+	secrets, err := c.SecretsClient.GetAllSecrets(ctx, ref)
+	find := &Find{Tags: ref.Tags}
+	if ref.Path != nil {
+		find.Path = *ref.Path
+	}
+	if ref.Name != nil {
+		find.Name = ref.Name.RegExp
+	}
+	c.record(ctx, Event{Operation: OperationGetAllSecrets, Find: find}, start, err)
+	return secrets, err
+}
+
+func (c *Client) PushSecret(ctx context.Context, secret *corev1.Secret, data esv1.PushSecretData) error {
+	start := time.Now()
+	err := c.SecretsClient.PushSecret(ctx, secret, data)
+	c.record(ctx, Event{
+		Operation: OperationPushSecret,
+		RemoteKey: data.GetRemoteKey(),
+		Property:  data.GetProperty(),
+	}, start, err)
+	return err
+}
+
+func (c *Client) DeleteSecret(ctx context.Context, remoteRef esv1.PushSecretRemoteRef) error {
+	start := time.Now()
+	err := c.SecretsClient.DeleteSecret(ctx, remoteRef)
+	c.record(ctx, Event{
+		Operation: OperationDeleteSecret,
+		RemoteKey: remoteRef.GetRemoteKey(),
+		Property:  remoteRef.GetProperty(),
+	}, start, err)
+	return err
+}
+
+func remoteRefEvent(operation string, ref esv1.ExternalSecretDataRemoteRef) Event {
+	return Event{
+		Operation: operation,
+		RemoteKey: ref.Key,
+		Property:  ref.Property,
+		Version:   ref.Version,
+	}
+}
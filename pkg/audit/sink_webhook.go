@@ -0,0 +1,211 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package audit
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+)
+
+const (
+	defaultBatchSize     = 100
+	defaultFlushInterval = 5 * time.Second
+	defaultMaxRetries    = 3
+	defaultTimeout       = 10 * time.Second
+	defaultRetryBackoff  = 500 * time.Millisecond
+
+	// bufferedBatches is the number of batches the webhook sink buffers
+	// before it starts dropping events.
+	bufferedBatches = 10
+)
+
+var errBufferFull = errors.New("audit webhook buffer is full, dropping event")
+
+// WebhookConfig configures the webhook sink.
+type WebhookConfig struct {
+	URL           string
+	Headers       map[string]string
+	BatchSize     int
+	FlushInterval time.Duration
+	MaxRetries    int
+	Timeout       time.Duration
+	// RetryBackoff is the initial backoff between retries, it doubles on every attempt.
+	RetryBackoff time.Duration
+	Client       *http.Client
+}
+
+// WebhookSink sends batches of events as JSON array to an HTTP endpoint.
+// Events are buffered in memory and sent once the batch is full
+// or the flush interval has passed, whatever comes first.
+type WebhookSink struct {
+	cfg    WebhookConfig
+	events chan Event
+	done   chan struct{}
+
+	closeOnce sync.Once
+	closed    chan struct{}
+}
+
+// NewWebhookSink returns a webhook sink and starts its background sender.
+func NewWebhookSink(cfg WebhookConfig) *WebhookSink {
+	if cfg.BatchSize <= 0 {
+		cfg.BatchSize = defaultBatchSize
+	}
+	if cfg.FlushInterval <= 0 {
+		cfg.FlushInterval = defaultFlushInterval
+	}
+	if cfg.MaxRetries < 0 {
+		cfg.MaxRetries = 0
+	}
+	if cfg.Timeout <= 0 {
+		cfg.Timeout = defaultTimeout
+	}
+	if cfg.RetryBackoff <= 0 {
+		cfg.RetryBackoff = defaultRetryBackoff
+	}
+	if cfg.Client == nil {
+		cfg.Client = &http.Client{Timeout: cfg.Timeout}
+	}
+	s := &WebhookSink{
+		cfg:    cfg,
+		events: make(chan Event, cfg.BatchSize*bufferedBatches),
+		done:   make(chan struct{}),
+		closed: make(chan struct{}),
+	}
+	go s.run()
+	return s
+}
+
+// Write implements Sink. It never blocks: if the buffer is full the event is dropped.
+func (s *WebhookSink) Write(event Event) error {
+	select {
+	case <-s.closed:
+		return errors.New("audit webhook sink is closed")
+	default:
+	}
+	select {
+	case s.events <- event:
+		return nil
+	default:
+		return errBufferFull
+	}
+}
+
+// Close implements Sink. It sends all buffered events before it returns
+// or the context is cancelled.
+func (s *WebhookSink) Close(ctx context.Context) error {
+	s.closeOnce.Do(func() {
+		close(s.closed)
+	})
+	select {
+	case <-s.done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (s *WebhookSink) run() {
+	defer close(s.done)
+	ticker := time.NewTicker(s.cfg.FlushInterval)
+	defer ticker.Stop()
+
+	batch := make([]Event, 0, s.cfg.BatchSize)
+	flush := func() {
+		if len(batch) == 0 {
+			return
+		}
+		if err := s.send(batch); err != nil {
+			log.Error(err, "unable to send audit events", "events", len(batch))
+		}
+		batch = make([]Event, 0, s.cfg.BatchSize)
+	}
+
+	for {
+		select {
+		case event := <-s.events:
+			batch = append(batch, event)
+			if len(batch) >= s.cfg.BatchSize {
+				flush()
+			}
+		case <-ticker.C:
+			flush()
+		case <-s.closed:
+			for {
+				select {
+				case event := <-s.events:
+					batch = append(batch, event)
+					if len(batch) >= s.cfg.BatchSize {
+						flush()
+					}
+				default:
+					flush()
+					return
+				}
+			}
+		}
+	}
+}
+
+func (s *WebhookSink) send(batch []Event) error {
+	body, err := json.Marshal(batch)
+	if err != nil {
+		return fmt.Errorf("unable to marshal audit events: %w", err)
+	}
+	backoff := s.cfg.RetryBackoff
+	for attempt := 0; ; attempt++ {
+		retry, err := s.post(body)
+		if err == nil {
+			return nil
+		}
+		if !retry || attempt >= s.cfg.MaxRetries {
+			return err
+		}
+		time.Sleep(backoff)
+		backoff *= 2
+	}
+}
+
+// post sends the body once and reports whether a failed request may be retried.
+func (s *WebhookSink) post(body []byte) (bool, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), s.cfg.Timeout)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.cfg.URL, bytes.NewReader(body))
+	if err != nil {
+		return false, fmt.Errorf("unable to create audit webhook request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	for k, v := range s.cfg.Headers {
+		req.Header.Set(k, v)
+	}
+	resp, err := s.cfg.Client.Do(req)
+	if err != nil {
+		return true, fmt.Errorf("unable to send audit events: %w", err)
+	}
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		return false, nil
+	}
+	retry := resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= http.StatusInternalServerError
+	return retry, fmt.Errorf("audit webhook returned status %d", resp.StatusCode)
+}
@@ -26,6 +26,7 @@ import (
 	"net/http"
 	"net/url"
 	tpl "text/template"
+	"time"
 
 	"github.com/Azure/go-ntlmssp"
 	"github.com/PaesslerAG/jsonpath"
@@ -37,6 +38,7 @@ import (
 	"github.com/external-secrets/external-secrets/pkg/constants"
 	"github.com/external-secrets/external-secrets/pkg/metrics"
 	"github.com/external-secrets/external-secrets/pkg/template/v2"
+	"github.com/external-secrets/external-secrets/pkg/tracing"
 	"github.com/external-secrets/external-secrets/pkg/utils"
 )
 
@@ -285,8 +287,9 @@ func (w *Webhook) executeRequest(ctx context.Context, provider *Spec, data []byt
 		}
 	}
 
+	start := time.Now()
 	resp, err := w.HTTP.Do(req)
-	metrics.ObserveAPICall(constants.ProviderWebhook, constants.CallWebhookHTTPReq, err)
+	metrics.ObserveAPICall(constants.ProviderWebhook, constants.CallWebhookHTTPReq, start, err)
 	if err != nil {
 		return nil, fmt.Errorf("failed to call endpoint: %w", err)
 	}
@@ -386,6 +389,8 @@ func (w *Webhook) GetHTTPClient(ctx context.Context, provider *Spec) (*http.Clie
 		// add additional auth methods here
 	}
 
+	client.Transport = tracing.Transport(client.Transport)
+
 	// return client with all add-ons
 	return client, nil
 }
@@ -106,6 +106,13 @@ const (
 	StatusError   = "error"
 	StatusSuccess = "success"
 
+	ErrorReasonAuth             = "auth"
+	ErrorReasonNotFound         = "not_found"
+	ErrorReasonThrottled        = "throttled"
+	ErrorReasonTimeout          = "timeout"
+	ErrorReasonPermissionDenied = "permission_denied"
+	ErrorReasonUnknown          = "unknown"
+
 	WellKnownLabelKey             = "external-secrets.io/component"
 	WellKnownLabelValueController = "controller"
 	WellKnownLabelValueWebhook    = "webhook"
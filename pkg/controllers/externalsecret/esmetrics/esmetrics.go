@@ -15,6 +15,9 @@ limitations under the License.
 package esmetrics
 
 import (
+	"maps"
+	"slices"
+
 	"github.com/prometheus/client_golang/prometheus"
 	v1 "k8s.io/api/core/v1"
 	"sigs.k8s.io/controller-runtime/pkg/metrics"
@@ -29,6 +32,7 @@ const (
 	SyncCallsErrorKey                  = "sync_calls_error"
 	ExternalSecretStatusConditionKey   = "status_condition"
 	ExternalSecretReconcileDurationKey = "reconcile_duration"
+	CertificateExpiryTimestampKey      = "certificate_expiry_timestamp_seconds"
 )
 
 var counterVecMetrics = map[string]*prometheus.CounterVec{}
@@ -63,7 +67,13 @@ func SetUpMetrics() {
 		Help:      "The duration time to reconcile the External Secret",
 	}, ctrlmetrics.NonConditionMetricLabelNames)
 
-	metrics.Registry.MustRegister(syncCallsTotal, syncCallsError, externalSecretCondition, externalSecretReconcileDuration)
+	certificateExpiryTimestamp := prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Subsystem: ExternalSecretSubsystem,
+		Name:      CertificateExpiryTimestampKey,
+		Help:      "The earliest expiry of the certificates found in a key of the target Secret, as unix timestamp",
+	}, append(slices.Clone(ctrlmetrics.NonConditionMetricLabelNames), "key"))
+
+	metrics.Registry.MustRegister(syncCallsTotal, syncCallsError, externalSecretCondition, externalSecretReconcileDuration, certificateExpiryTimestamp)
 
 	counterVecMetrics = map[string]*prometheus.CounterVec{
 		SyncCallsKey:      syncCallsTotal,
@@ -73,6 +83,7 @@ func SetUpMetrics() {
 	gaugeVecMetrics = map[string]*prometheus.GaugeVec{
 		ExternalSecretStatusConditionKey:   externalSecretCondition,
 		ExternalSecretReconcileDurationKey: externalSecretReconcileDuration,
+		CertificateExpiryTimestampKey:      certificateExpiryTimestamp,
 	}
 }
 
@@ -146,6 +157,25 @@ func UpdateExternalSecretCondition(es *esv1.ExternalSecret, condition *esv1.Exte
 		})).Set(value)
 }
 
+// UpdateCertificateExpiry replaces the certificate expiry time series of the given ExternalSecret.
+func UpdateCertificateExpiry(es *esv1.ExternalSecret, resourceLabels prometheus.Labels) {
+	certificateExpiry := GetGaugeVec(CertificateExpiryTimestampKey)
+	RemoveCertificateExpiry(es.Name, es.Namespace)
+	for _, cert := range es.Status.Certificates {
+		certLabels := maps.Clone(resourceLabels)
+		certLabels["key"] = cert.Key
+		certificateExpiry.With(certLabels).Set(float64(cert.NotAfter.Unix()))
+	}
+}
+
+// RemoveCertificateExpiry deletes all certificate expiry time series of an ExternalSecret.
+func RemoveCertificateExpiry(name, namespace string) {
+	GetGaugeVec(CertificateExpiryTimestampKey).DeletePartialMatch(prometheus.Labels{
+		"name":      name,
+		"namespace": namespace,
+	})
+}
+
 func GetCounterVec(key string) *prometheus.CounterVec {
 	return counterVecMetrics[key]
 }
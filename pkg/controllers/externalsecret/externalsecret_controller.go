@@ -26,6 +26,7 @@ import (
 
 	"github.com/go-logr/logr"
 	"github.com/prometheus/client_golang/prometheus"
+	"go.opentelemetry.io/otel/attribute"
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/equality"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
@@ -48,10 +49,13 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	esv1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1"
+	"github.com/external-secrets/external-secrets/pkg/audit"
 	// Metrics.
 	"github.com/external-secrets/external-secrets/pkg/controllers/externalsecret/esmetrics"
 	ctrlmetrics "github.com/external-secrets/external-secrets/pkg/controllers/metrics"
 	"github.com/external-secrets/external-secrets/pkg/controllers/util"
+	"github.com/external-secrets/external-secrets/pkg/template"
+	"github.com/external-secrets/external-secrets/pkg/tracing"
 	"github.com/external-secrets/external-secrets/pkg/utils"
 	"github.com/external-secrets/external-secrets/pkg/utils/resolvers"
 
@@ -75,6 +79,10 @@ const (
 	// condition messages for "SecretMissing" reason.
 	msgMissing = "secret will not be created due to CreationPolicy=Merge"
 
+	// condition messages for "Paused" and "Resumed" reasons.
+	msgPaused  = "syncing is paused, the existing secret is kept"
+	msgResumed = "syncing has been resumed"
+
 	// condition messages for "SecretSyncedError" reason.
 	msgErrorGetSecretData   = "could not get secret data from provider"
 	msgErrorDeleteSecret    = "could not delete secret"
@@ -83,6 +91,7 @@ const (
 	msgErrorUpdateImmutable = "could not update secret, target is immutable"
 	msgErrorBecomeOwner     = "failed to take ownership of target secret"
 	msgErrorIsOwned         = "target is owned by another ExternalSecret"
+	msgErrorTemplateLimit   = "template exceeded a resource limit"
 
 	// log messages.
 	logErrorGetES                = "unable to get ExternalSecret"
@@ -138,14 +147,22 @@ type Reconciler struct {
 	ClusterSecretStoreEnabled bool
 	EnableFloodGate           bool
 	EnableGeneratorState      bool
-	recorder                  record.EventRecorder
+	EnableCertificateExpiry   bool
+	// CertificateExpiryThresholds are the remaining lifetimes of a certificate
+	// at which a warning event is emitted, see DefaultCertificateExpiryThresholds.
+	CertificateExpiryThresholds []time.Duration
+	recorder                    record.EventRecorder
 }
 
 // Reconcile implements the main reconciliation loop
 // for watched objects (ExternalSecret, ClusterSecretStore and SecretStore),
 // and updates/creates a Kubernetes secret based on them.
 func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (result ctrl.Result, err error) {
-	log := r.Log.WithValues("ExternalSecret", req.NamespacedName)
+	ctx, span := tracing.Start(ctx, "ExternalSecret.Reconcile",
+		attribute.String("namespace", req.Namespace),
+		attribute.String("name", req.Name))
+	defer func() { tracing.End(span, err) }()
+	log := tracing.Logger(ctx, r.Log.WithValues("ExternalSecret", req.NamespacedName))
 
 	resourceLabels := ctrlmetrics.RefineNonConditionMetricLabels(map[string]string{"name": req.Name, "namespace": req.Namespace})
 	start := time.Now()
@@ -172,6 +189,9 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (result ct
 					Namespace: req.Namespace,
 				},
 			}, *conditionSynced)
+			if r.EnableCertificateExpiry {
+				esmetrics.RemoveCertificateExpiry(req.Name, req.Namespace)
+			}
 
 			return ctrl.Result{}, nil
 		}
@@ -190,6 +210,14 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (result ct
 	// if extended metrics is enabled, refine the time series vector
 	resourceLabels = ctrlmetrics.RefineLabels(resourceLabels, externalSecret.Labels)
 
+	ctx = audit.WithResource(ctx, audit.Resource{
+		Kind:      esv1.ExtSecretKind,
+		Namespace: externalSecret.Namespace,
+		Name:      externalSecret.Name,
+		UID:       string(externalSecret.UID),
+	})
+	defer tracing.Track(ctx, externalSecret.UID)()
+
 	// skip this ExternalSecret if it uses a ClusterSecretStore and the feature is disabled
 	if shouldSkipClusterSecretStore(r, externalSecret) {
 		log.V(1).Info("skipping ExternalSecret, ClusterSecretStore feature is disabled")
@@ -208,6 +236,18 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (result ct
 		return ctrl.Result{}, nil
 	}
 
+	// skip this ExternalSecret while it is paused, keeping the existing secret
+	paused, err := r.updatePausedCondition(ctx, externalSecret)
+	if err != nil {
+		log.Error(err, logErrorUpdateESStatus)
+		syncCallsError.With(resourceLabels).Inc()
+		return ctrl.Result{}, err
+	}
+	if paused {
+		log.V(1).Info("skipping ExternalSecret, it is paused")
+		return ctrl.Result{}, nil
+	}
+
 	// the target secret name defaults to the ExternalSecret name, if not explicitly set
 	secretName := externalSecret.Spec.Target.Name
 	if secretName == "" {
@@ -368,6 +408,9 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (result ct
 		}
 	}
 
+	// targetData holds the data of the target secret after it was mutated.
+	var targetData map[string][]byte
+
 	// mutationFunc is a function which can be applied to a secret to make it match the desired state.
 	mutationFunc := func(secret *v1.Secret) error {
 		// get information about the current owner of the secret
@@ -431,7 +474,9 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (result ct
 
 		// WARNING: this will remove any labels or annotations managed by this ExternalSecret
 		//          so any updates to labels and annotations should be done AFTER this point
-		err = r.ApplyTemplate(ctx, externalSecret, secret, dataMap)
+		templateCtx, span := tracing.Start(ctx, "ExternalSecret.ApplyTemplate")
+		err = r.ApplyTemplate(templateCtx, externalSecret, secret, dataMap)
+		tracing.End(span, err)
 		if err != nil {
 			return fmt.Errorf(errApplyTemplate, err)
 		}
@@ -453,6 +498,7 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (result ct
 
 		secret.Labels[esv1.LabelManaged] = esv1.LabelManagedValue
 		secret.Annotations[esv1.AnnotationDataHash] = utils.ObjectHash(secret.Data)
+		targetData = secret.Data
 
 		return nil
 	}
@@ -524,10 +570,21 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (result ct
 			return ctrl.Result{}, nil
 		}
 
+		// detect errors indicating that a template exceeded a resource limit
+		// NOTE: this error cant be fixed by retrying immediately, so we wait for the next refresh
+		if errors.Is(err, template.ErrLimitExceeded) {
+			r.recorder.Event(externalSecret, v1.EventTypeWarning, esv1.ReasonUpdateFailed, err.Error())
+			conditionSynced := NewExternalSecretCondition(esv1.ExternalSecretReady, v1.ConditionFalse, esv1.ConditionReasonTemplateLimitExceeded, fmt.Sprintf("%s: %v", msgErrorTemplateLimit, err))
+			SetExternalSecretCondition(externalSecret, *conditionSynced)
+			syncCallsError.With(resourceLabels).Inc()
+			return r.getRequeueResult(externalSecret), nil
+		}
+
 		r.markAsFailed(msgErrorUpdateSecret, err, externalSecret, syncCallsError.With(resourceLabels))
 		return ctrl.Result{}, err
 	}
 
+	r.trackCertificateExpiry(externalSecret, targetData, resourceLabels)
 	r.markAsDone(externalSecret, start, log, esv1.ConditionReasonSecretSynced, msgSynced)
 	return r.getRequeueResult(externalSecret), nil
 }
@@ -563,14 +620,44 @@ func (r *Reconciler) getRequeueResult(externalSecret *esv1.ExternalSecret) ctrl.
 	}
 
 	// if there is time remaining, requeue after the remaining time
+	// or when generated values must be renewed, whichever comes first
 	if timeSinceLastRefresh < refreshInterval {
-		return ctrl.Result{RequeueAfter: refreshInterval - timeSinceLastRefresh}
+		requeueAfter := refreshInterval - timeSinceLastRefresh
+		if renewal := externalSecret.Status.RenewalTime; renewal != nil && time.Until(renewal.Time) < requeueAfter {
+			requeueAfter = max(time.Until(renewal.Time), 0)
+		}
+		return ctrl.Result{RequeueAfter: requeueAfter}
 	}
 
 	// otherwise, requeue immediately
 	return ctrl.Result{Requeue: true}
 }
 
+// updatePausedCondition sets the Paused condition according to the paused annotation
+// and returns true if the ExternalSecret is paused.
+func (r *Reconciler) updatePausedCondition(ctx context.Context, externalSecret *esv1.ExternalSecret) (bool, error) {
+	paused := isPaused(externalSecret)
+	current := GetExternalSecretCondition(externalSecret.Status, esv1.ExternalSecretPaused)
+	var condition *esv1.ExternalSecretStatusCondition
+	switch {
+	case paused:
+		condition = NewExternalSecretCondition(esv1.ExternalSecretPaused, v1.ConditionTrue, esv1.ConditionReasonPaused, msgPaused)
+	case current != nil && current.Status == v1.ConditionTrue:
+		condition = NewExternalSecretCondition(esv1.ExternalSecretPaused, v1.ConditionFalse, esv1.ConditionReasonResumed, msgResumed)
+	default:
+		return false, nil
+	}
+	SetExternalSecretCondition(externalSecret, *condition)
+	if current != nil && current.Status == condition.Status {
+		return paused, nil
+	}
+	return paused, r.Status().Update(ctx, externalSecret)
+}
+
+func isPaused(externalSecret *esv1.ExternalSecret) bool {
+	return externalSecret.Annotations[esv1.AnnotationPaused] == "true"
+}
+
 func (r *Reconciler) markAsDone(externalSecret *esv1.ExternalSecret, start time.Time, log logr.Logger, reason, msg string) {
 	oldReadyCondition := GetExternalSecretCondition(externalSecret.Status, esv1.ExternalSecretReady)
 	newReadyCondition := NewExternalSecretCondition(esv1.ExternalSecretReady, v1.ConditionTrue, reason, msg)
@@ -901,6 +988,11 @@ func shouldRefreshPeriodic(es *esv1.ExternalSecret) bool {
 		return true
 	}
 
+	// if generated values must be renewed, we should refresh
+	if es.Status.RenewalTime != nil && !es.Status.RenewalTime.After(time.Now()) {
+		return true
+	}
+
 	// if the last refresh time + refresh interval is before now, we should refresh
 	return es.Status.RefreshTime.Add(es.Spec.RefreshInterval.Duration).Before(time.Now())
 }
@@ -930,9 +1022,15 @@ func isSecretValid(existingSecret *v1.Secret, es *esv1.ExternalSecret) bool {
 	return true
 }
 
+// SetEventRecorder sets the recorder used to emit events.
+// It only needs to be called if the Reconciler is used without a Manager.
+func (r *Reconciler) SetEventRecorder(recorder record.EventRecorder) {
+	r.recorder = recorder
+}
+
 // SetupWithManager returns a new controller builder that will be started by the provided Manager.
 func (r *Reconciler) SetupWithManager(mgr ctrl.Manager, opts controller.Options) error {
-	r.recorder = mgr.GetEventRecorderFor("external-secrets")
+	r.recorder = tracing.NewEventRecorder(mgr.GetEventRecorderFor("external-secrets"))
 
 	// index ExternalSecrets based on the target secret name,
 	// this lets us quickly find all ExternalSecrets which target a specific Secret
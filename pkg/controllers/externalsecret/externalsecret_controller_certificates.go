@@ -0,0 +1,170 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package externalsecret
+
+import (
+	"crypto/x509"
+	"encoding/pem"
+	"slices"
+	"sort"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	gopkcs12 "software.sslmate.com/src/go-pkcs12"
+
+	esv1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1"
+	"github.com/external-secrets/external-secrets/pkg/controllers/externalsecret/esmetrics"
+)
+
+const (
+	pemTypeCertificate = "CERTIFICATE"
+
+	eventCertificateExpiring = "certificate in key %q expires at %s (less than %s left)"
+	eventCertificateExpired  = "certificate in key %q expired at %s"
+)
+
+// DefaultCertificateExpiryThresholds are the remaining lifetimes at which
+// a warning event is emitted for an expiring certificate.
+var DefaultCertificateExpiryThresholds = []time.Duration{30 * 24 * time.Hour, 7 * 24 * time.Hour, 24 * time.Hour}
+
+// trackCertificateExpiry records the earliest certificate expiry of every key of the target secret data
+// in the status and metrics of the ExternalSecret and emits warning events when a threshold is crossed.
+// It must be called before the refresh time of the ExternalSecret is updated,
+// as the previous refresh time is used to determine if a threshold was crossed since the last sync.
+func (r *Reconciler) trackCertificateExpiry(es *esv1.ExternalSecret, data map[string][]byte, resourceLabels prometheus.Labels) {
+	if !r.EnableCertificateExpiry {
+		return
+	}
+
+	now := time.Now()
+	previous := make(map[string]metav1.Time, len(es.Status.Certificates))
+	for _, cert := range es.Status.Certificates {
+		previous[cert.Key] = cert.NotAfter
+	}
+
+	certificates := certificateExpiries(data)
+	for _, cert := range certificates {
+		notAfter := cert.NotAfter.Time
+		threshold, expiring := expiryThreshold(notAfter.Sub(now), r.CertificateExpiryThresholds)
+		if !expiring {
+			continue
+		}
+
+		// only emit an event if the certificate crossed a threshold since the last sync,
+		// otherwise we would emit the same event on every reconcile.
+		if prevNotAfter, ok := previous[cert.Key]; ok && prevNotAfter.Equal(&cert.NotAfter) && !es.Status.RefreshTime.IsZero() {
+			prevThreshold, prevExpiring := expiryThreshold(notAfter.Sub(es.Status.RefreshTime.Time), r.CertificateExpiryThresholds)
+			if prevExpiring && prevThreshold == threshold {
+				continue
+			}
+		}
+
+		if threshold <= 0 {
+			r.recorder.Eventf(es, v1.EventTypeWarning, esv1.ReasonCertificateExpired, eventCertificateExpired, cert.Key, notAfter.Format(time.RFC3339))
+			continue
+		}
+		r.recorder.Eventf(es, v1.EventTypeWarning, esv1.ReasonCertificateExpiring, eventCertificateExpiring, cert.Key, notAfter.Format(time.RFC3339), threshold)
+	}
+
+	es.Status.Certificates = certificates
+	esmetrics.UpdateCertificateExpiry(es, resourceLabels)
+}
+
+// expiryThreshold returns the smallest threshold that the remaining lifetime of a certificate is within.
+// A threshold of 0 is returned if the certificate has already expired.
+func expiryThreshold(remaining time.Duration, thresholds []time.Duration) (time.Duration, bool) {
+	if remaining <= 0 {
+		return 0, true
+	}
+	sorted := slices.Clone(thresholds)
+	slices.Sort(sorted)
+	for _, threshold := range sorted {
+		if remaining <= threshold {
+			return threshold, true
+		}
+	}
+	return 0, false
+}
+
+// certificateExpiries returns the earliest notAfter of the certificates found in each key of the data.
+// Keys that do not contain any certificate are omitted. The result is sorted by key.
+func certificateExpiries(data map[string][]byte) []esv1.CertificateExpiry {
+	var certificates []esv1.CertificateExpiry
+	for key, value := range data {
+		notAfter, ok := earliestNotAfter(value)
+		if !ok {
+			continue
+		}
+		certificates = append(certificates, esv1.CertificateExpiry{
+			Key:      key,
+			NotAfter: metav1.NewTime(notAfter),
+		})
+	}
+	sort.Slice(certificates, func(i, j int) bool {
+		return certificates[i].Key < certificates[j].Key
+	})
+	return certificates
+}
+
+// earliestNotAfter parses PEM encoded certificates, DER encoded certificates
+// and PKCS#12 archives without password and returns the earliest notAfter.
+func earliestNotAfter(value []byte) (time.Time, bool) {
+	certs := parsePEMCertificates(value)
+	if len(certs) == 0 {
+		if cert, err := x509.ParseCertificate(value); err == nil {
+			certs = append(certs, cert)
+		}
+	}
+	if len(certs) == 0 {
+		if _, cert, caCerts, err := gopkcs12.DecodeChain(value, ""); err == nil {
+			certs = append(caCerts, cert)
+		} else if trustStore, err := gopkcs12.DecodeTrustStore(value, ""); err == nil {
+			certs = trustStore
+		}
+	}
+
+	var earliest time.Time
+	for _, cert := range certs {
+		if cert == nil {
+			continue
+		}
+		if earliest.IsZero() || cert.NotAfter.Before(earliest) {
+			earliest = cert.NotAfter
+		}
+	}
+	return earliest, !earliest.IsZero()
+}
+
+func parsePEMCertificates(value []byte) []*x509.Certificate {
+	var certs []*x509.Certificate
+	rest := value
+	for {
+		var block *pem.Block
+		block, rest = pem.Decode(rest)
+		if block == nil {
+			return certs
+		}
+		if block.Type != pemTypeCertificate {
+			continue
+		}
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			continue
+		}
+		certs = append(certs, cert)
+	}
+}
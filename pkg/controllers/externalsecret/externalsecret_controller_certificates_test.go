@@ -0,0 +1,113 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package externalsecret
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"testing"
+	"time"
+
+	gopkcs12 "software.sslmate.com/src/go-pkcs12"
+)
+
+func newTestCertificate(t *testing.T, notAfter time.Time) (*x509.Certificate, *ecdsa.PrivateKey) {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "test"},
+		NotBefore:    notAfter.Add(-time.Hour),
+		NotAfter:     notAfter,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return cert, key
+}
+
+func TestCertificateExpiries(t *testing.T) {
+	early := time.Now().Add(24 * time.Hour).Truncate(time.Second).UTC()
+	late := time.Now().Add(48 * time.Hour).Truncate(time.Second).UTC()
+	earlyCert, earlyKey := newTestCertificate(t, early)
+	lateCert, _ := newTestCertificate(t, late)
+
+	chain := append(pem.EncodeToMemory(&pem.Block{Type: pemTypeCertificate, Bytes: lateCert.Raw}),
+		pem.EncodeToMemory(&pem.Block{Type: pemTypeCertificate, Bytes: earlyCert.Raw})...)
+	pfx, err := gopkcs12.Modern.Encode(earlyKey, earlyCert, []*x509.Certificate{lateCert}, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expiries := certificateExpiries(map[string][]byte{
+		"tls.crt":  chain,
+		"cert.der": lateCert.Raw,
+		"cert.pfx": pfx,
+		"password": []byte("not a certificate"),
+	})
+
+	want := map[string]time.Time{
+		"cert.der": late,
+		"cert.pfx": early,
+		"tls.crt":  early,
+	}
+	if len(expiries) != len(want) {
+		t.Fatalf("expected %d certificate expiries, got %d: %v", len(want), len(expiries), expiries)
+	}
+	for i, expiry := range expiries {
+		if i > 0 && expiries[i-1].Key > expiry.Key {
+			t.Errorf("expected expiries to be sorted by key, got %v", expiries)
+		}
+		if !expiry.NotAfter.Time.Equal(want[expiry.Key]) {
+			t.Errorf("key %s: expected notAfter %v, got %v", expiry.Key, want[expiry.Key], expiry.NotAfter.Time)
+		}
+	}
+}
+
+func TestExpiryThreshold(t *testing.T) {
+	thresholds := []time.Duration{24 * time.Hour, 30 * 24 * time.Hour, 7 * 24 * time.Hour}
+	tests := []struct {
+		name          string
+		remaining     time.Duration
+		wantThreshold time.Duration
+		wantExpiring  bool
+	}{
+		{name: "not expiring", remaining: 60 * 24 * time.Hour},
+		{name: "within largest threshold", remaining: 10 * 24 * time.Hour, wantThreshold: 30 * 24 * time.Hour, wantExpiring: true},
+		{name: "within smallest threshold", remaining: time.Hour, wantThreshold: 24 * time.Hour, wantExpiring: true},
+		{name: "expired", remaining: -time.Hour, wantThreshold: 0, wantExpiring: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			threshold, expiring := expiryThreshold(tt.remaining, thresholds)
+			if threshold != tt.wantThreshold || expiring != tt.wantExpiring {
+				t.Errorf("expiryThreshold() = (%v, %v), want (%v, %v)", threshold, expiring, tt.wantThreshold, tt.wantExpiring)
+			}
+		})
+	}
+}
@@ -20,14 +20,19 @@ import (
 	"errors"
 	"fmt"
 	"strconv"
+	"time"
 
+	"go.opentelemetry.io/otel/attribute"
 	v1 "k8s.io/api/core/v1"
 	apiextensions "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	esv1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1"
 	genv1alpha1 "github.com/external-secrets/external-secrets/apis/generators/v1alpha1"
 	"github.com/external-secrets/external-secrets/pkg/controllers/secretstore"
+	"github.com/external-secrets/external-secrets/pkg/generator/chain"
 	"github.com/external-secrets/external-secrets/pkg/generator/statemanager"
+	"github.com/external-secrets/external-secrets/pkg/tracing"
 	"github.com/external-secrets/external-secrets/pkg/utils"
 	"github.com/external-secrets/external-secrets/pkg/utils/resolvers"
 
@@ -72,26 +77,34 @@ func (r *Reconciler) GetProviderSecretData(ctx context.Context, externalSecret *
 			}
 		}()
 	}
+	// renewalTime is the earliest time at which generated values must be renewed
+	var renewalTime *metav1.Time
 	providerData = make(map[string][]byte)
 	for i, remoteRef := range externalSecret.Spec.DataFrom {
 		var secretMap map[string][]byte
 
+		entryCtx, span := tracing.Start(ctx, "ExternalSecret.dataFrom", attribute.Int("index", i))
 		if remoteRef.Find != nil {
-			secretMap, err = r.handleFindAllSecrets(ctx, externalSecret, remoteRef, mgr, genState, i)
+			secretMap, err = r.handleFindAllSecrets(entryCtx, externalSecret, remoteRef, mgr, genState, i)
 			if err != nil {
 				err = fmt.Errorf("error processing spec.dataFrom[%d].find, err: %w", i, err)
 			}
 		} else if remoteRef.Extract != nil {
-			secretMap, err = r.handleExtractSecrets(ctx, externalSecret, remoteRef, mgr, genState, i)
+			secretMap, err = r.handleExtractSecrets(entryCtx, externalSecret, remoteRef, mgr, genState, i)
 			if err != nil {
 				err = fmt.Errorf("error processing spec.dataFrom[%d].extract, err: %w", i, err)
 			}
 		} else if remoteRef.SourceRef != nil && remoteRef.SourceRef.GeneratorRef != nil {
-			secretMap, err = r.handleGenerateSecrets(ctx, externalSecret.Namespace, remoteRef, i, genState)
+			var renewAt time.Time
+			secretMap, renewAt, err = r.handleGenerateSecrets(entryCtx, externalSecret.Namespace, remoteRef, i, genState)
+			if !renewAt.IsZero() && (renewalTime == nil || renewAt.Before(renewalTime.Time)) {
+				renewalTime = &metav1.Time{Time: renewAt}
+			}
 			if err != nil {
 				err = fmt.Errorf("error processing spec.dataFrom[%d].sourceRef.generatorRef, err: %w", i, err)
 			}
 		}
+		tracing.End(span, err)
 
 		if errors.Is(err, esv1.NoSecretErr) && externalSecret.Spec.Target.DeletionPolicy != esv1.DeletionPolicyRetain {
 			r.recorder.Eventf(externalSecret, v1.EventTypeNormal, esv1.ReasonMissingProviderSecret, eventMissingProviderSecret, i)
@@ -105,7 +118,11 @@ func (r *Reconciler) GetProviderSecretData(ctx context.Context, externalSecret *
 	}
 
 	for i, secretRef := range externalSecret.Spec.Data {
-		err := r.handleSecretData(ctx, externalSecret, secretRef, providerData, mgr)
+		entryCtx, span := tracing.Start(ctx, "ExternalSecret.data",
+			attribute.Int("index", i),
+			attribute.String("secretKey", secretRef.SecretKey))
+		err := r.handleSecretData(entryCtx, externalSecret, secretRef, providerData, mgr)
+		tracing.End(span, err)
 		if errors.Is(err, esv1.NoSecretErr) && externalSecret.Spec.Target.DeletionPolicy != esv1.DeletionPolicyRetain {
 			r.recorder.Eventf(externalSecret, v1.EventTypeNormal, esv1.ReasonMissingProviderSecret, eventMissingProviderSecretKey, i, secretRef.RemoteRef.Key)
 			continue
@@ -115,6 +132,7 @@ func (r *Reconciler) GetProviderSecretData(ctx context.Context, externalSecret *
 		}
 	}
 
+	externalSecret.Status.RenewalTime = renewalTime
 	return providerData, nil
 }
 
@@ -151,43 +169,59 @@ func toStoreGenSourceRef(ref *esv1.StoreSourceRef) *esv1.StoreGeneratorSourceRef
 	}
 }
 
-func (r *Reconciler) handleGenerateSecrets(ctx context.Context, namespace string, remoteRef esv1.ExternalSecretDataFromRemoteRef, i int, generatorState *statemanager.Manager) (map[string][]byte, error) {
+// handleGenerateSecrets returns the values created by a generator.
+// If the generator implements Renewer, the time at which the values must be renewed is returned as well.
+func (r *Reconciler) handleGenerateSecrets(ctx context.Context, namespace string, remoteRef esv1.ExternalSecretDataFromRemoteRef, i int, generatorState *statemanager.Manager) (map[string][]byte, time.Time, error) {
 	impl, generatorResource, err := resolvers.GeneratorRef(ctx, r.Client, r.Scheme, namespace, remoteRef.SourceRef.GeneratorRef)
 	if err != nil {
-		return nil, err
+		return nil, time.Time{}, err
 	}
 	var latestState *genv1alpha1.GeneratorState
 	if generatorState != nil {
 		latestState, err = generatorState.GetLatestState(generatorStateKey(i))
 		if err != nil {
-			return nil, fmt.Errorf("unable to get latest state: %w", err)
+			return nil, time.Time{}, fmt.Errorf("unable to get latest state: %w", err)
 		}
 	}
-	secretMap, newState, err := impl.Generate(ctx, generatorResource, r.Client, namespace)
+	// the inputs of the generator are generated first, their state is tracked along with it
+	kube, err := chain.Client(ctx, r.Client, r.Scheme, namespace, generatorResource, generatorStateKey(i), generatorState)
 	if err != nil {
-		return nil, fmt.Errorf(errGenerate, err)
+		return nil, time.Time{}, fmt.Errorf(errGenerate, err)
+	}
+	secretMap, newState, err := impl.Generate(ctx, generatorResource, kube, namespace)
+	if err != nil {
+		return nil, time.Time{}, fmt.Errorf(errGenerate, err)
 	}
 	if latestState != nil {
 		if generatorState != nil {
 			generatorState.EnqueueMoveStateToGC(generatorStateKey(i))
 		}
 	}
+	// the state is enqueued before anything else may fail,
+	// so that the generated resources are cleaned up on rollback.
 	if generatorState != nil {
 		generatorState.EnqueueSetLatest(ctx, generatorStateKey(i), namespace, generatorResource, impl, newState)
 	}
+	var renewAt time.Time
+	if renewer, ok := impl.(genv1alpha1.Renewer); ok {
+		renewAt, err = renewer.RenewalTime(generatorResource, secretMap)
+		if err != nil {
+			return nil, time.Time{}, fmt.Errorf(errGenerate, err)
+		}
+	}
 	// rewrite the keys if needed
 	secretMap, err = utils.RewriteMap(remoteRef.Rewrite, secretMap)
 	if err != nil {
-		return nil, fmt.Errorf(errRewrite, err)
+		return nil, time.Time{}, fmt.Errorf(errRewrite, err)
 	}
 
 	// validate the keys
 	err = utils.ValidateKeys(r.Log, secretMap)
 	if err != nil {
-		return nil, fmt.Errorf(errInvalidKeys, err)
+		return nil, time.Time{}, fmt.Errorf(errInvalidKeys, err)
 	}
 
-	return secretMap, err
+	return secretMap, renewAt, err
 }
 
 // We're using the index of the generator as the key for the generator state
@@ -363,6 +363,26 @@ var _ = Describe("ExternalSecret controller", Serial, func() {
 		}
 	}
 
+	// a paused ExternalSecret does not call the provider
+	// and does not create a secret
+	skipPausedExternalSecret := func(tc *testCase) {
+		tc.externalSecret.ObjectMeta.Annotations = map[string]string{
+			esv1.AnnotationPaused: "true",
+		}
+		fakeProvider.WithGetSecret([]byte(secretVal), nil)
+		tc.checkCondition = func(es *esv1.ExternalSecret) bool {
+			cond := GetExternalSecretCondition(es.Status, esv1.ExternalSecretPaused)
+			return cond != nil && cond.Status == v1.ConditionTrue && cond.Reason == esv1.ConditionReasonPaused
+		}
+		tc.checkExternalSecret = func(es *esv1.ExternalSecret) {
+			Expect(GetExternalSecretCondition(es.Status, esv1.ExternalSecretReady)).To(BeNil())
+			Expect(es.Status.RefreshTime.IsZero()).To(BeTrue())
+			secret := &v1.Secret{}
+			err := k8sClient.Get(context.Background(), types.NamespacedName{Name: tc.targetSecretName, Namespace: es.Namespace}, secret)
+			Expect(apierrors.IsNotFound(err)).To(BeTrue())
+		}
+	}
+
 	// labels and annotations from the Kind=ExternalSecret
 	// should be copied over to the Kind=Secret
 	syncLabelsAnnotations := func(tc *testCase) {
@@ -2350,6 +2370,7 @@ var _ = Describe("ExternalSecret controller", Serial, func() {
 		Entry("should sync to target secrets with naming bigger than 63 characters", syncBigNames),
 		Entry("should expose the secret as a provisioned service binding secret", syncBindingSecret),
 		Entry("should not expose a provisioned service when no secret is synced", skipBindingSecret),
+		Entry("should not sync a paused ExternalSecret", skipPausedExternalSecret),
 		Entry("should set labels and annotations from the ExternalSecret", syncLabelsAnnotations),
 		Entry("should merge labels and annotations to the ones owned by other entity", mergeLabelsAnnotations),
 		Entry("should removed outdated labels and annotations", removeOutdatedLabelsAnnotations),
@@ -2468,6 +2489,28 @@ var _ = Describe("ExternalSecret refresh logic", func() {
 			Expect(shouldRefresh(es)).To(BeTrue())
 		})
 
+		It("should refresh when the force-sync annotation changes", func() {
+			es := &esv1.ExternalSecret{
+				ObjectMeta: metav1.ObjectMeta{
+					Generation: 1,
+				},
+				Spec: esv1.ExternalSecretSpec{
+					RefreshPolicy:   esv1.RefreshPolicyOnChange,
+					RefreshInterval: &metav1.Duration{Duration: time.Hour},
+				},
+				Status: esv1.ExternalSecretStatus{
+					RefreshTime: metav1.Now(),
+				},
+			}
+			es.Status.SyncedResourceVersion = util.GetResourceVersion(es.ObjectMeta)
+			Expect(shouldRefresh(es)).To(BeFalse())
+
+			// the annotation is not read by the controller,
+			// setting it changes the resource version like any other annotation
+			es.ObjectMeta.Annotations = map[string]string{esv1.AnnotationForceSync: "2025-01-01T00:00:00Z"}
+			Expect(shouldRefresh(es)).To(BeTrue())
+		})
+
 		It("should refresh when generation has changed", func() {
 			es := &esv1.ExternalSecret{
 				ObjectMeta: metav1.ObjectMeta{
@@ -2535,6 +2578,27 @@ var _ = Describe("ExternalSecret refresh logic", func() {
 			Expect(shouldRefresh(es)).To(BeTrue())
 		})
 
+		It("should refresh when generated values must be renewed", func() {
+			es := &esv1.ExternalSecret{
+				ObjectMeta: metav1.ObjectMeta{
+					Generation: 1,
+				},
+				Spec: esv1.ExternalSecretSpec{
+					RefreshInterval: &metav1.Duration{Duration: time.Hour},
+				},
+				Status: esv1.ExternalSecretStatus{
+					RefreshTime: metav1.NewTime(metav1.Now().Add(-time.Minute)),
+					RenewalTime: &metav1.Time{Time: time.Now().Add(time.Minute)},
+				},
+			}
+			es.Status.SyncedResourceVersion = util.GetResourceVersion(es.ObjectMeta)
+			Expect(shouldRefresh(es)).To(BeFalse())
+
+			// renewal time has passed -> refresh
+			es.Status.RenewalTime = &metav1.Time{Time: time.Now().Add(-time.Second)}
+			Expect(shouldRefresh(es)).To(BeTrue())
+		})
+
 	})
 	Context("objectmeta hash", func() {
 		It("should produce different hashes for different k/v pairs", func() {
@@ -23,6 +23,7 @@ import (
 	"time"
 
 	"github.com/go-logr/logr"
+	"go.opentelemetry.io/otel/attribute"
 	v1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -38,12 +39,15 @@ import (
 	esv1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1"
 	esapi "github.com/external-secrets/external-secrets/apis/externalsecrets/v1alpha1"
 	genv1alpha1 "github.com/external-secrets/external-secrets/apis/generators/v1alpha1"
+	"github.com/external-secrets/external-secrets/pkg/audit"
 	ctrlmetrics "github.com/external-secrets/external-secrets/pkg/controllers/metrics"
 	"github.com/external-secrets/external-secrets/pkg/controllers/pushsecret/psmetrics"
 	"github.com/external-secrets/external-secrets/pkg/controllers/secretstore"
 	"github.com/external-secrets/external-secrets/pkg/controllers/util"
+	"github.com/external-secrets/external-secrets/pkg/generator/chain"
 	"github.com/external-secrets/external-secrets/pkg/generator/statemanager"
 	"github.com/external-secrets/external-secrets/pkg/provider/util/locks"
+	"github.com/external-secrets/external-secrets/pkg/tracing"
 	"github.com/external-secrets/external-secrets/pkg/utils"
 	"github.com/external-secrets/external-secrets/pkg/utils/resolvers"
 
@@ -73,7 +77,7 @@ type Reconciler struct {
 }
 
 func (r *Reconciler) SetupWithManager(mgr ctrl.Manager, opts controller.Options) error {
-	r.recorder = mgr.GetEventRecorderFor("pushsecret")
+	r.recorder = tracing.NewEventRecorder(mgr.GetEventRecorderFor("pushsecret"))
 
 	return ctrl.NewControllerManagedBy(mgr).
 		WithOptions(opts).
@@ -81,8 +85,12 @@ func (r *Reconciler) SetupWithManager(mgr ctrl.Manager, opts controller.Options)
 		Complete(r)
 }
 
-func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
-	log := r.Log.WithValues("pushsecret", req.NamespacedName)
+func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (result ctrl.Result, err error) {
+	ctx, span := tracing.Start(ctx, "PushSecret.Reconcile",
+		attribute.String("namespace", req.Namespace),
+		attribute.String("name", req.Name))
+	defer func() { tracing.End(span, err) }()
+	log := tracing.Logger(ctx, r.Log.WithValues("pushsecret", req.NamespacedName))
 
 	resourceLabels := ctrlmetrics.RefineNonConditionMetricLabels(map[string]string{"name": req.Name, "namespace": req.Namespace})
 	start := time.Now()
@@ -108,6 +116,14 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 		return ctrl.Result{}, fmt.Errorf("get resource: %w", err)
 	}
 
+	ctx = audit.WithResource(ctx, audit.Resource{
+		Kind:      esapi.PushSecretKind,
+		Namespace: ps.Namespace,
+		Name:      ps.Name,
+		UID:       string(ps.UID),
+	})
+	defer tracing.Track(ctx, ps.UID)()
+
 	refreshInt := r.RequeueInterval
 	if ps.Spec.RefreshInterval != nil {
 		refreshInt = ps.Spec.RefreshInterval.Duration
@@ -154,6 +170,12 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 	default:
 	}
 
+	// skip this PushSecret while it is paused
+	if updatePausedCondition(&ps) {
+		log.V(1).Info("skipping PushSecret, it is paused")
+		return ctrl.Result{}, nil
+	}
+
 	timeSinceLastRefresh := 0 * time.Second
 	if !ps.Status.RefreshTime.IsZero() {
 		timeSinceLastRefresh = time.Since(ps.Status.RefreshTime.Time)
@@ -239,6 +261,20 @@ func shouldRefresh(ps esapi.PushSecret) bool {
 	return ps.Status.RefreshTime.Add(ps.Spec.RefreshInterval.Duration).Before(time.Now())
 }
 
+// updatePausedCondition sets the Paused condition according to the paused annotation
+// and returns true if the PushSecret is paused.
+func updatePausedCondition(ps *esapi.PushSecret) bool {
+	paused := ps.Annotations[esv1.AnnotationPaused] == "true"
+	current := GetPushSecretCondition(ps.Status.Conditions, esapi.PushSecretPaused)
+	switch {
+	case paused:
+		SetPushSecretCondition(ps, *NewPushSecretCondition(esapi.PushSecretPaused, v1.ConditionTrue, esapi.ReasonPaused, "syncing is paused"))
+	case current != nil && current.Status == v1.ConditionTrue:
+		SetPushSecretCondition(ps, *NewPushSecretCondition(esapi.PushSecretPaused, v1.ConditionFalse, esapi.ReasonResumed, "syncing has been resumed"))
+	}
+	return paused
+}
+
 func (r *Reconciler) markAsFailed(msg string, ps *esapi.PushSecret, syncState esapi.SyncedPushSecretsMap) {
 	cond := NewPushSecretCondition(esapi.PushSecretReady, v1.ConditionFalse, esapi.ReasonErrored, msg)
 	SetPushSecretCondition(ps, *cond)
@@ -452,7 +488,11 @@ func (r *Reconciler) resolveSecretFromGenerator(ctx context.Context, namespace s
 			return nil, fmt.Errorf("unable to get latest state: %w", err)
 		}
 	}
-	secretMap, newState, err := gen.Generate(ctx, genResource, r.Client, namespace)
+	kube, err := chain.Client(ctx, r.Client, r.Scheme, namespace, genResource, defaultGeneratorStateKey, generatorState)
+	if err != nil {
+		return nil, fmt.Errorf("unable to generate inputs: %w", err)
+	}
+	secretMap, newState, err := gen.Generate(ctx, genResource, kube, namespace)
 	if err != nil {
 		return nil, fmt.Errorf("unable to generate: %w", err)
 	}
@@ -21,6 +21,7 @@ import (
 	"fmt"
 	"os"
 	"strconv"
+	"testing"
 	"time"
 
 	v1 "k8s.io/api/core/v1"
@@ -33,6 +34,7 @@ import (
 	genv1alpha1 "github.com/external-secrets/external-secrets/apis/generators/v1alpha1"
 	ctest "github.com/external-secrets/external-secrets/pkg/controllers/commontest"
 	"github.com/external-secrets/external-secrets/pkg/controllers/pushsecret/psmetrics"
+	"github.com/external-secrets/external-secrets/pkg/controllers/util"
 	"github.com/external-secrets/external-secrets/pkg/provider/testing/fake"
 
 	. "github.com/onsi/ginkgo/v2"
@@ -1122,6 +1124,65 @@ var _ = Describe("PushSecret controller", func() {
 		}
 	}
 
+	// a paused PushSecret does not push secrets to the provider.
+	skipPausedPushSecret := func(tc *testCase) {
+		fakeProvider.SetSecretFn = func() error {
+			return nil
+		}
+		tc.pushsecret.ObjectMeta.Annotations = map[string]string{
+			esv1.AnnotationPaused: "true",
+		}
+		tc.assert = func(ps *v1alpha1.PushSecret, secret *v1.Secret) bool {
+			expected := v1alpha1.PushSecretStatusCondition{
+				Type:    v1alpha1.PushSecretPaused,
+				Status:  v1.ConditionTrue,
+				Reason:  v1alpha1.ReasonPaused,
+				Message: "syncing is paused",
+			}
+			if !checkCondition(ps.Status, expected) {
+				return false
+			}
+			Expect(fakeProvider.SetSecretArgs).To(BeEmpty())
+			Expect(GetPushSecretCondition(ps.Status.Conditions, v1alpha1.PushSecretReady)).To(BeNil())
+			return true
+		}
+	}
+
+	// a paused PushSecret pushes secrets again once the paused annotation is removed.
+	resumePausedPushSecret := func(tc *testCase) {
+		fakeProvider.SetSecretFn = func() error {
+			return nil
+		}
+		tc.pushsecret.ObjectMeta.Annotations = map[string]string{
+			esv1.AnnotationPaused: "true",
+		}
+		tc.assert = func(ps *v1alpha1.PushSecret, secret *v1.Secret) bool {
+			paused := GetPushSecretCondition(ps.Status.Conditions, v1alpha1.PushSecretPaused)
+			if paused == nil {
+				return false
+			}
+			if paused.Status == v1.ConditionTrue {
+				Expect(fakeProvider.SetSecretArgs).To(BeEmpty())
+				By("removing the paused annotation")
+				delete(ps.Annotations, esv1.AnnotationPaused)
+				// a conflict is retried with the next attempt
+				_ = k8sClient.Update(context.Background(), ps)
+				return false
+			}
+			expected := v1alpha1.PushSecretStatusCondition{
+				Type:    v1alpha1.PushSecretPaused,
+				Status:  v1.ConditionFalse,
+				Reason:  v1alpha1.ReasonResumed,
+				Message: "syncing has been resumed",
+			}
+			if !checkCondition(ps.Status, expected) {
+				return false
+			}
+			providerValue, ok := fakeProvider.SetSecretArgs[ps.Spec.Data[0].Match.RemoteRef.RemoteKey]
+			return ok && bytes.Equal(providerValue.Value, secret.Data[defaultKey])
+		}
+	}
+
 	DescribeTable("When reconciling a PushSecret",
 		func(tweaks ...testTweaks) {
 			tc := makeDefaultTestcase()
@@ -1175,6 +1236,8 @@ var _ = Describe("PushSecret controller", func() {
 		Entry("should fail if no valid SecretStore", failNoSecretStore),
 		Entry("should fail if no valid ClusterSecretStore", failNoClusterStore),
 		Entry("should fail if NewClient fails", newClientFail),
+		Entry("should not push a paused PushSecret", skipPausedPushSecret),
+		Entry("should push once a paused PushSecret is resumed", resumePausedPushSecret),
 	)
 })
 
@@ -1483,3 +1546,52 @@ var _ = Describe("PushSecret Controller Un/Managed Stores", func() {
 		Entry("should skip unmanaged stores and sync managed stores", warnUnmanagedStoresAndSyncManagedStores),
 	)
 })
+
+var _ = Describe("PushSecret refresh logic", func() {
+	It("should refresh when the force-sync annotation changes", func() {
+		ps := v1alpha1.PushSecret{
+			ObjectMeta: metav1.ObjectMeta{
+				Generation: 1,
+			},
+			Spec: v1alpha1.PushSecretSpec{
+				RefreshInterval: &metav1.Duration{Duration: time.Hour},
+			},
+			Status: v1alpha1.PushSecretStatus{
+				RefreshTime: metav1.Now(),
+			},
+		}
+		ps.Status.SyncedResourceVersion = util.GetResourceVersion(ps.ObjectMeta)
+		Expect(shouldRefresh(ps)).To(BeFalse())
+
+		ps.ObjectMeta.Annotations = map[string]string{esv1.AnnotationForceSync: "2025-01-01T00:00:00Z"}
+		Expect(shouldRefresh(ps)).To(BeTrue())
+	})
+})
+
+func TestUpdatePausedCondition(t *testing.T) {
+	ps := &v1alpha1.PushSecret{
+		ObjectMeta: metav1.ObjectMeta{
+			Annotations: map[string]string{esv1.AnnotationPaused: "true"},
+		},
+	}
+	if !updatePausedCondition(ps) {
+		t.Fatal("expected the PushSecret to be paused")
+	}
+	if cond := GetPushSecretCondition(ps.Status.Conditions, v1alpha1.PushSecretPaused); cond == nil || cond.Status != v1.ConditionTrue || cond.Reason != v1alpha1.ReasonPaused {
+		t.Fatalf("unexpected Paused condition: %+v", cond)
+	}
+
+	delete(ps.Annotations, esv1.AnnotationPaused)
+	if updatePausedCondition(ps) {
+		t.Fatal("expected the PushSecret to be resumed")
+	}
+	if cond := GetPushSecretCondition(ps.Status.Conditions, v1alpha1.PushSecretPaused); cond == nil || cond.Status != v1.ConditionFalse || cond.Reason != v1alpha1.ReasonResumed {
+		t.Fatalf("unexpected Paused condition: %+v", cond)
+	}
+
+	// PushSecrets that have never been paused get no Paused condition
+	never := &v1alpha1.PushSecret{}
+	if updatePausedCondition(never) || len(never.Status.Conditions) != 0 {
+		t.Fatalf("unexpected conditions: %+v", never.Status.Conditions)
+	}
+}
@@ -30,6 +30,9 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	esv1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1"
+	"github.com/external-secrets/external-secrets/pkg/audit"
+	"github.com/external-secrets/external-secrets/pkg/providercache"
+	"github.com/external-secrets/external-secrets/pkg/tracing"
 )
 
 const (
@@ -93,6 +96,15 @@ func (m *Manager) GetFromStore(ctx context.Context, store esv1.GenericStore, nam
 	if err != nil {
 		return nil, err
 	}
+	if providercache.Enabled() {
+		secretClient = providercache.Wrap(secretClient, store, namespace)
+	}
+	if audit.Enabled() {
+		secretClient = audit.NewClient(secretClient, store)
+	}
+	if tracing.Enabled() {
+		secretClient = tracing.NewClient(secretClient, store)
+	}
 	idx := storeKey(storeProvider)
 	m.clientMap[idx] = &clientVal{
 		client: secretClient,
@@ -0,0 +1,135 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package agekey
+
+import (
+	"context"
+	"crypto/ecdh"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/sha512"
+	"errors"
+	"fmt"
+	"io"
+
+	"golang.org/x/crypto/ssh"
+	apiextensions "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+
+	genv1alpha1 "github.com/external-secrets/external-secrets/apis/generators/v1alpha1"
+	esmeta "github.com/external-secrets/external-secrets/apis/meta/v1"
+	"github.com/external-secrets/external-secrets/pkg/utils/resolvers"
+)
+
+type Generator struct{}
+
+const (
+	identityPrefix  = "AGE-SECRET-KEY-"
+	recipientPrefix = "age"
+	keySize         = 32
+
+	errNoSpec      = "no config spec provided"
+	errParseSpec   = "unable to parse spec: %w"
+	errGenerateKey = "unable to generate key: %w"
+	errGetSSHKey   = "unable to get ssh key: %w"
+	errParseSSHKey = "unable to parse ssh key: %w"
+	errSSHKeyType  = "ssh key must be an ed25519 key, got %T"
+)
+
+func (g *Generator) Generate(ctx context.Context, jsonSpec *apiextensions.JSON, kube client.Client, namespace string) (map[string][]byte, genv1alpha1.GeneratorProviderState, error) {
+	return g.generate(ctx, jsonSpec, kube, namespace, rand.Reader)
+}
+
+func (g *Generator) Cleanup(_ context.Context, _ *apiextensions.JSON, _ genv1alpha1.GeneratorProviderState, _ client.Client, _ string) error {
+	return nil
+}
+
+func (g *Generator) generate(ctx context.Context, jsonSpec *apiextensions.JSON, kube client.Client, namespace string, random io.Reader) (map[string][]byte, genv1alpha1.GeneratorProviderState, error) {
+	if jsonSpec == nil {
+		return nil, nil, errors.New(errNoSpec)
+	}
+	res, err := parseSpec(jsonSpec.Raw)
+	if err != nil {
+		return nil, nil, fmt.Errorf(errParseSpec, err)
+	}
+	var scalar []byte
+	if ref := res.Spec.SSHKeySecretRef; ref != nil {
+		sshKey, err := resolvers.SecretKeyRef(ctx, kube, resolvers.EmptyStoreKind, namespace, &esmeta.SecretKeySelector{
+			Namespace: &namespace,
+			Name:      ref.Name,
+			Key:       ref.Key,
+		})
+		if err != nil {
+			return nil, nil, fmt.Errorf(errGetSSHKey, err)
+		}
+		scalar, err = sshToAge([]byte(sshKey))
+		if err != nil {
+			return nil, nil, err
+		}
+	} else {
+		scalar = make([]byte, keySize)
+		if _, err := io.ReadFull(random, scalar); err != nil {
+			return nil, nil, fmt.Errorf(errGenerateKey, err)
+		}
+	}
+	identity, recipient, err := encodeKeyPair(scalar)
+	if err != nil {
+		return nil, nil, fmt.Errorf(errGenerateKey, err)
+	}
+	return map[string][]byte{
+		"identity":  []byte(identity),
+		"recipient": []byte(recipient),
+	}, nil, nil
+}
+
+// sshToAge converts an ed25519 private key to the X25519 scalar of an age identity,
+// which is the same conversion that ssh-to-age and the age ssh recipients use.
+func sshToAge(sshKey []byte) ([]byte, error) {
+	key, err := ssh.ParseRawPrivateKey(sshKey)
+	if err != nil {
+		return nil, fmt.Errorf(errParseSSHKey, err)
+	}
+	var edKey ed25519.PrivateKey
+	switch k := key.(type) {
+	case ed25519.PrivateKey:
+		edKey = k
+	case *ed25519.PrivateKey:
+		edKey = *k
+	default:
+		return nil, fmt.Errorf(errSSHKeyType, key)
+	}
+	h := sha512.Sum512(edKey.Seed())
+	return h[:keySize], nil
+}
+
+// encodeKeyPair returns the identity and the recipient of the X25519 scalar in the age format.
+func encodeKeyPair(scalar []byte) (identity, recipient string, err error) {
+	key, err := ecdh.X25519().NewPrivateKey(scalar)
+	if err != nil {
+		return "", "", err
+	}
+	return bech32Encode(identityPrefix, key.Bytes()), bech32Encode(recipientPrefix, key.PublicKey().Bytes()), nil
+}
+
+func parseSpec(data []byte) (*genv1alpha1.AgeKey, error) {
+	var spec genv1alpha1.AgeKey
+	err := yaml.Unmarshal(data, &spec)
+	return &spec, err
+}
+
+func init() {
+	genv1alpha1.Register(genv1alpha1.AgeKeyKind, &Generator{})
+}
@@ -0,0 +1,116 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package agekey
+
+import (
+	"bytes"
+	"context"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"encoding/pem"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/ssh"
+	corev1 "k8s.io/api/core/v1"
+	apiextensions "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func sequence(start byte) []byte {
+	b := make([]byte, keySize)
+	for i := range b {
+		b[i] = start + byte(i)
+	}
+	return b
+}
+
+func sshKeyPEM(t *testing.T, key any) []byte {
+	t.Helper()
+	block, err := ssh.MarshalPrivateKey(key, "")
+	require.NoError(t, err)
+	return pem.EncodeToMemory(block)
+}
+
+func TestGenerate(t *testing.T) {
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	kube := fake.NewClientBuilder().WithObjects(&corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "ssh", Namespace: "default"},
+		Data: map[string][]byte{
+			"ed25519": sshKeyPEM(t, ed25519.NewKeyFromSeed(sequence(100))),
+			"ecdsa":   sshKeyPEM(t, ecKey),
+		},
+	}).Build()
+
+	tests := []struct {
+		name          string
+		jsonSpec      *apiextensions.JSON
+		expectedErr   string
+		wantIdentity  string
+		wantRecipient string
+	}{
+		{
+			name:        "nil spec should return error",
+			expectedErr: errNoSpec,
+		},
+		{
+			name:          "random key",
+			jsonSpec:      &apiextensions.JSON{Raw: []byte(`{"spec":{}}`)},
+			wantIdentity:  "AGE-SECRET-KEY-1QQQSYQCYQ5RQWZQFPG9SCRGWPUGPZYSNZS23V9CCRYDPK8QARC0SWRYDWG",
+			wantRecipient: "age13aqvttdk3ujkyjh9kg2w5an6dmy5mq5a84a4uxk3hfhnugfc9p0sy5p2wh",
+		},
+		{
+			name:          "converted ssh key",
+			jsonSpec:      &apiextensions.JSON{Raw: []byte(`{"spec":{"sshKeySecretRef":{"name":"ssh","key":"ed25519"}}}`)},
+			wantIdentity:  "AGE-SECRET-KEY-1DPR80964AZZ2K9MRWS6M4SRFXU24L7TCEVKJPQGRQ9R4XKVFL97SL0QU6E",
+			wantRecipient: "age1t0xdfpzjwu209r4kkmafusl74wh88643j2h0re74n5s3zdpa0dcqdu79up",
+		},
+		{
+			name:        "non ed25519 ssh key",
+			jsonSpec:    &apiextensions.JSON{Raw: []byte(`{"spec":{"sshKeySecretRef":{"name":"ssh","key":"ecdsa"}}}`)},
+			expectedErr: "ssh key must be an ed25519 key",
+		},
+		{
+			name:        "missing ssh key",
+			jsonSpec:    &apiextensions.JSON{Raw: []byte(`{"spec":{"sshKeySecretRef":{"name":"missing","key":"ed25519"}}}`)},
+			expectedErr: "unable to get ssh key",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := &Generator{}
+			result, _, err := g.generate(context.Background(), tt.jsonSpec, kube, "default", bytes.NewReader(sequence(0)))
+			if tt.expectedErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantIdentity, string(result["identity"]))
+			assert.Equal(t, tt.wantRecipient, string(result["recipient"]))
+		})
+	}
+}
+
+func TestBech32Encode(t *testing.T) {
+	// valid test vectors from BIP 173
+	assert.Equal(t, "a12uel5l", bech32Encode("a", nil))
+	assert.Equal(t, "A12UEL5L", bech32Encode("A", nil))
+}
@@ -0,0 +1,98 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package agekey
+
+import (
+	"strings"
+)
+
+// The age key formats use Bech32 as specified in BIP 173, without the length limit.
+
+const charset = "qpzry9x8gf2tvdw0s3jn54khce6mua7l"
+
+var generator = []uint32{0x3b6a57b2, 0x26508e6d, 0x1ea119fa, 0x3d4233dd, 0x2a1462b3}
+
+// bech32Encode encodes data with the human-readable part hrp.
+// The result is uppercase if hrp is uppercase.
+func bech32Encode(hrp string, data []byte) string {
+	values := convertBits(data)
+	lower := strings.ToLower(hrp)
+	var sb strings.Builder
+	sb.WriteString(lower)
+	sb.WriteByte('1')
+	for _, v := range append(values, checksum(lower, values)...) {
+		sb.WriteByte(charset[v])
+	}
+	if hrp != lower {
+		return strings.ToUpper(sb.String())
+	}
+	return sb.String()
+}
+
+func polymod(values []byte) uint32 {
+	chk := uint32(1)
+	for _, v := range values {
+		top := chk >> 25
+		chk = (chk&0x1ffffff)<<5 ^ uint32(v)
+		for i, g := range generator {
+			if (top>>i)&1 == 1 {
+				chk ^= g
+			}
+		}
+	}
+	return chk
+}
+
+func hrpExpand(hrp string) []byte {
+	values := make([]byte, 0, len(hrp)*2+1)
+	for _, c := range []byte(hrp) {
+		values = append(values, c>>5)
+	}
+	values = append(values, 0)
+	for _, c := range []byte(hrp) {
+		values = append(values, c&31)
+	}
+	return values
+}
+
+func checksum(hrp string, data []byte) []byte {
+	values := append(hrpExpand(hrp), data...)
+	values = append(values, 0, 0, 0, 0, 0, 0)
+	mod := polymod(values) ^ 1
+	sum := make([]byte, 6)
+	for i := range sum {
+		sum[i] = byte(mod>>(5*(5-i))) & 31
+	}
+	return sum
+}
+
+// convertBits regroups 8-bit bytes into padded 5-bit groups.
+func convertBits(data []byte) []byte {
+	var acc uint32
+	var bits uint
+	values := make([]byte, 0, len(data)*8/5+1)
+	for _, b := range data {
+		acc = acc<<8 | uint32(b)
+		bits += 8
+		for bits >= 5 {
+			bits -= 5
+			values = append(values, byte(acc>>bits)&31)
+		}
+	}
+	if bits > 0 {
+		values = append(values, byte(acc<<(5-bits))&31)
+	}
+	return values
+}
@@ -0,0 +1,363 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package certificate
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"math/big"
+	"net"
+	"net/url"
+	"time"
+
+	apiextensions "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+
+	genv1alpha1 "github.com/external-secrets/external-secrets/apis/generators/v1alpha1"
+	esmeta "github.com/external-secrets/external-secrets/apis/meta/v1"
+	"github.com/external-secrets/external-secrets/pkg/generator/renewal"
+	"github.com/external-secrets/external-secrets/pkg/utils/resolvers"
+)
+
+type Generator struct{}
+
+const (
+	defaultKeyAlgorithm          = "ECDSA"
+	defaultRSAKeySize            = 2048
+	defaultECDSAKeySize          = 256
+	defaultDuration              = 90 * 24 * time.Hour
+	defaultRenewBeforePercentage = 33
+
+	keyCertificate = "tls.crt"
+	keyPrivateKey  = "tls.key"
+	keyCA          = "ca.crt"
+
+	errNoSpec          = "no config spec provided"
+	errParseSpec       = "unable to parse spec: %w"
+	errGenerateKey     = "unable to generate private key: %w"
+	errUnsupported     = "unsupported key algorithm: %s"
+	errKeySize         = "unsupported key size %d for %s keys"
+	errInvalidIP       = "invalid ip address: %s"
+	errInvalidURI      = "invalid uri %s: %w"
+	errGetCA           = "unable to get CA: %w"
+	errNoCACertificate = "no certificate found in CA secret"
+	errNoCAKey         = "no private key found in CA secret"
+	errCreateCert      = "unable to create certificate: %w"
+	errNoCertificate   = "no certificate found in " + keyCertificate
+)
+
+var keyUsages = map[genv1alpha1.KeyUsage]x509.KeyUsage{
+	genv1alpha1.KeyUsageDigitalSignature:  x509.KeyUsageDigitalSignature,
+	genv1alpha1.KeyUsageContentCommitment: x509.KeyUsageContentCommitment,
+	genv1alpha1.KeyUsageKeyEncipherment:   x509.KeyUsageKeyEncipherment,
+	genv1alpha1.KeyUsageDataEncipherment:  x509.KeyUsageDataEncipherment,
+	genv1alpha1.KeyUsageKeyAgreement:      x509.KeyUsageKeyAgreement,
+	genv1alpha1.KeyUsageCertSign:          x509.KeyUsageCertSign,
+	genv1alpha1.KeyUsageCRLSign:           x509.KeyUsageCRLSign,
+}
+
+var extKeyUsages = map[genv1alpha1.KeyUsage]x509.ExtKeyUsage{
+	genv1alpha1.KeyUsageServerAuth:      x509.ExtKeyUsageServerAuth,
+	genv1alpha1.KeyUsageClientAuth:      x509.ExtKeyUsageClientAuth,
+	genv1alpha1.KeyUsageCodeSigning:     x509.ExtKeyUsageCodeSigning,
+	genv1alpha1.KeyUsageEmailProtection: x509.ExtKeyUsageEmailProtection,
+	genv1alpha1.KeyUsageOCSPSigning:     x509.ExtKeyUsageOCSPSigning,
+}
+
+func (g *Generator) Generate(ctx context.Context, jsonSpec *apiextensions.JSON, kube client.Client, namespace string) (map[string][]byte, genv1alpha1.GeneratorProviderState, error) {
+	if jsonSpec == nil {
+		return nil, nil, errors.New(errNoSpec)
+	}
+	res, err := parseSpec(jsonSpec.Raw)
+	if err != nil {
+		return nil, nil, fmt.Errorf(errParseSpec, err)
+	}
+	var ca *certificateAuthority
+	if res.Spec.CA != nil {
+		ca, err = getCA(ctx, kube, namespace, res.Spec.CA)
+		if err != nil {
+			return nil, nil, fmt.Errorf(errGetCA, err)
+		}
+	}
+	data, err := generate(&res.Spec, ca, time.Now())
+	return data, nil, err
+}
+
+func (g *Generator) Cleanup(_ context.Context, _ *apiextensions.JSON, _ genv1alpha1.GeneratorProviderState, _ client.Client, _ string) error {
+	return nil
+}
+
+// RenewalTime returns the time at which RenewBeforePercentage of the lifetime of the certificate is left.
+func (g *Generator) RenewalTime(jsonSpec *apiextensions.JSON, values map[string][]byte) (time.Time, error) {
+	if jsonSpec == nil {
+		return time.Time{}, errors.New(errNoSpec)
+	}
+	res, err := parseSpec(jsonSpec.Raw)
+	if err != nil {
+		return time.Time{}, fmt.Errorf(errParseSpec, err)
+	}
+	block, _ := pem.Decode(values[keyCertificate])
+	if block == nil {
+		return time.Time{}, errors.New(errNoCertificate)
+	}
+	cert, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return time.Time{}, err
+	}
+	lifetime := cert.NotAfter.Sub(cert.NotBefore)
+	return renewal.Time(cert.NotAfter, lifetime, res.Spec.RenewBeforePercentage, defaultRenewBeforePercentage), nil
+}
+
+type certificateAuthority struct {
+	cert  *x509.Certificate
+	key   crypto.Signer
+	chain []byte
+}
+
+func generate(spec *genv1alpha1.CertificateSpec, ca *certificateAuthority, now time.Time) (map[string][]byte, error) {
+	algorithm := defaultKeyAlgorithm
+	if spec.KeyAlgorithm != "" {
+		algorithm = spec.KeyAlgorithm
+	}
+	key, err := generateKey(algorithm, spec.KeySize)
+	if err != nil {
+		return nil, fmt.Errorf(errGenerateKey, err)
+	}
+	template, err := certificateTemplate(spec, algorithm, now)
+	if err != nil {
+		return nil, err
+	}
+
+	parent, signer := template, key
+	if ca != nil {
+		parent, signer = ca.cert, ca.key
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, parent, key.Public(), signer)
+	if err != nil {
+		return nil, fmt.Errorf(errCreateCert, err)
+	}
+	keyDER, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		return nil, fmt.Errorf(errGenerateKey, err)
+	}
+
+	cert := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+	caCert := cert
+	if ca != nil {
+		caCert = ca.chain
+	}
+	return map[string][]byte{
+		keyCertificate: cert,
+		keyPrivateKey:  pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: keyDER}),
+		keyCA:          caCert,
+	}, nil
+}
+
+func certificateTemplate(spec *genv1alpha1.CertificateSpec, algorithm string, now time.Time) (*x509.Certificate, error) {
+	serialNumber, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 128))
+	if err != nil {
+		return nil, err
+	}
+	duration := defaultDuration
+	if spec.Duration != nil {
+		duration = spec.Duration.Duration
+	}
+	template := &x509.Certificate{
+		SerialNumber:          serialNumber,
+		NotBefore:             now,
+		NotAfter:              now.Add(duration),
+		DNSNames:              spec.DNSNames,
+		EmailAddresses:        spec.EmailAddresses,
+		IsCA:                  spec.IsCA,
+		BasicConstraintsValid: true,
+	}
+	if spec.Subject != nil {
+		template.Subject = pkix.Name{
+			CommonName:         spec.Subject.CommonName,
+			Organization:       spec.Subject.Organizations,
+			OrganizationalUnit: spec.Subject.OrganizationalUnits,
+			Country:            spec.Subject.Countries,
+			Province:           spec.Subject.Provinces,
+			Locality:           spec.Subject.Localities,
+		}
+	}
+	for _, value := range spec.IPAddresses {
+		ip := net.ParseIP(value)
+		if ip == nil {
+			return nil, fmt.Errorf(errInvalidIP, value)
+		}
+		template.IPAddresses = append(template.IPAddresses, ip)
+	}
+	for _, value := range spec.URIs {
+		uri, err := url.Parse(value)
+		if err != nil {
+			return nil, fmt.Errorf(errInvalidURI, value, err)
+		}
+		template.URIs = append(template.URIs, uri)
+	}
+
+	usages := spec.Usages
+	if len(usages) == 0 {
+		usages = []genv1alpha1.KeyUsage{genv1alpha1.KeyUsageDigitalSignature}
+		// only RSA keys can encrypt the keys exchanged with them.
+		if algorithm == "RSA" {
+			usages = append(usages, genv1alpha1.KeyUsageKeyEncipherment)
+		}
+	}
+	for _, usage := range usages {
+		if keyUsage, ok := keyUsages[usage]; ok {
+			template.KeyUsage |= keyUsage
+		}
+		if extKeyUsage, ok := extKeyUsages[usage]; ok {
+			template.ExtKeyUsage = append(template.ExtKeyUsage, extKeyUsage)
+		}
+	}
+	if spec.IsCA {
+		template.KeyUsage |= x509.KeyUsageCertSign
+	}
+	return template, nil
+}
+
+func generateKey(algorithm string, keySize *int) (crypto.Signer, error) {
+	switch algorithm {
+	case "RSA":
+		bits := defaultRSAKeySize
+		if keySize != nil {
+			bits = *keySize
+		}
+		if bits < 2048 || bits > 8192 {
+			return nil, fmt.Errorf(errKeySize, bits, algorithm)
+		}
+		return rsa.GenerateKey(rand.Reader, bits)
+	case "ECDSA":
+		size := defaultECDSAKeySize
+		if keySize != nil {
+			size = *keySize
+		}
+		var curve elliptic.Curve
+		switch size {
+		case 256:
+			curve = elliptic.P256()
+		case 384:
+			curve = elliptic.P384()
+		case 521:
+			curve = elliptic.P521()
+		default:
+			return nil, fmt.Errorf(errKeySize, size, algorithm)
+		}
+		return ecdsa.GenerateKey(curve, rand.Reader)
+	case "Ed25519":
+		_, key, err := ed25519.GenerateKey(rand.Reader)
+		return key, err
+	default:
+		return nil, fmt.Errorf(errUnsupported, algorithm)
+	}
+}
+
+func getCA(ctx context.Context, kube client.Client, namespace string, ref *genv1alpha1.CertificateCA) (*certificateAuthority, error) {
+	certPEM, err := resolvers.SecretKeyRef(ctx, kube, resolvers.EmptyStoreKind, namespace, &esmeta.SecretKeySelector{
+		Namespace: &namespace,
+		Name:      ref.CertSecretRef.Name,
+		Key:       ref.CertSecretRef.Key,
+	})
+	if err != nil {
+		return nil, err
+	}
+	keyPEM, err := resolvers.SecretKeyRef(ctx, kube, resolvers.EmptyStoreKind, namespace, &esmeta.SecretKeySelector{
+		Namespace: &namespace,
+		Name:      ref.KeySecretRef.Name,
+		Key:       ref.KeySecretRef.Key,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// the first certificate is the CA, further certificates are its chain
+	var cert *x509.Certificate
+	rest := []byte(certPEM)
+	for cert == nil {
+		var block *pem.Block
+		block, rest = pem.Decode(rest)
+		if block == nil {
+			return nil, errors.New(errNoCACertificate)
+		}
+		if block.Type == "CERTIFICATE" {
+			cert, err = x509.ParseCertificate(block.Bytes)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	key, err := parsePrivateKey([]byte(keyPEM))
+	if err != nil {
+		return nil, err
+	}
+	return &certificateAuthority{
+		cert:  cert,
+		key:   key,
+		chain: []byte(certPEM),
+	}, nil
+}
+
+func parsePrivateKey(data []byte) (crypto.Signer, error) {
+	for {
+		block, rest := pem.Decode(data)
+		if block == nil {
+			return nil, errors.New(errNoCAKey)
+		}
+		data = rest
+		var key any
+		var err error
+		switch block.Type {
+		case "PRIVATE KEY":
+			key, err = x509.ParsePKCS8PrivateKey(block.Bytes)
+		case "RSA PRIVATE KEY":
+			key, err = x509.ParsePKCS1PrivateKey(block.Bytes)
+		case "EC PRIVATE KEY":
+			key, err = x509.ParseECPrivateKey(block.Bytes)
+		default:
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		signer, ok := key.(crypto.Signer)
+		if !ok {
+			return nil, errors.New(errNoCAKey)
+		}
+		return signer, nil
+	}
+}
+
+func parseSpec(data []byte) (*genv1alpha1.Certificate, error) {
+	var spec genv1alpha1.Certificate
+	err := yaml.Unmarshal(data, &spec)
+	return &spec, err
+}
+
+func init() {
+	genv1alpha1.Register(genv1alpha1.CertificateKind, &Generator{})
+}
@@ -0,0 +1,177 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package certificate
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	apiextensions "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func parseCertificate(t *testing.T, data []byte) *x509.Certificate {
+	t.Helper()
+	block, _ := pem.Decode(data)
+	require.NotNil(t, block)
+	cert, err := x509.ParseCertificate(block.Bytes)
+	require.NoError(t, err)
+	return cert
+}
+
+func parseKey(t *testing.T, data []byte) any {
+	t.Helper()
+	block, _ := pem.Decode(data)
+	require.NotNil(t, block)
+	assert.Equal(t, "PRIVATE KEY", block.Type)
+	key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	require.NoError(t, err)
+	return key
+}
+
+func TestGenerate(t *testing.T) {
+	tests := []struct {
+		name        string
+		spec        string
+		expectedErr string
+		validate    func(t *testing.T, cert *x509.Certificate, key any)
+	}{
+		{
+			name: "empty spec should use defaults",
+			spec: `{"spec":{}}`,
+			validate: func(t *testing.T, cert *x509.Certificate, key any) {
+				assert.IsType(t, &ecdsa.PrivateKey{}, key)
+				assert.Equal(t, defaultDuration, cert.NotAfter.Sub(cert.NotBefore))
+				assert.Equal(t, x509.KeyUsageDigitalSignature, cert.KeyUsage)
+				assert.False(t, cert.IsCA)
+			},
+		},
+		{
+			name: "rsa key should add key encipherment to the default usages",
+			spec: `{"spec":{"keyAlgorithm":"RSA"}}`,
+			validate: func(t *testing.T, cert *x509.Certificate, key any) {
+				assert.IsType(t, &rsa.PrivateKey{}, key)
+				assert.Equal(t, x509.KeyUsageDigitalSignature|x509.KeyUsageKeyEncipherment, cert.KeyUsage)
+			},
+		},
+		{
+			name: "rsa server certificate",
+			spec: `{"spec":{"keyAlgorithm":"RSA","keySize":3072,"subject":{"commonName":"foo","organizations":["acme"]},
+				"dnsNames":["foo.example.com"],"ipAddresses":["10.0.0.1"],"uris":["spiffe://cluster.local/ns/default/sa/foo"],
+				"usages":["digital signature","server auth","client auth"],"duration":"24h"}}`,
+			validate: func(t *testing.T, cert *x509.Certificate, key any) {
+				rsaKey, ok := key.(*rsa.PrivateKey)
+				require.True(t, ok)
+				assert.Equal(t, 3072, rsaKey.N.BitLen())
+				assert.Equal(t, "foo", cert.Subject.CommonName)
+				assert.Equal(t, []string{"acme"}, cert.Subject.Organization)
+				assert.Equal(t, []string{"foo.example.com"}, cert.DNSNames)
+				assert.Equal(t, "10.0.0.1", cert.IPAddresses[0].String())
+				assert.Equal(t, "spiffe://cluster.local/ns/default/sa/foo", cert.URIs[0].String())
+				assert.Equal(t, x509.KeyUsageDigitalSignature, cert.KeyUsage)
+				assert.Equal(t, []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth}, cert.ExtKeyUsage)
+				assert.Equal(t, 24*time.Hour, cert.NotAfter.Sub(cert.NotBefore))
+			},
+		},
+		{
+			name: "ed25519 ca certificate",
+			spec: `{"spec":{"keyAlgorithm":"Ed25519","isCA":true}}`,
+			validate: func(t *testing.T, cert *x509.Certificate, key any) {
+				assert.IsType(t, ed25519.PrivateKey{}, key)
+				assert.True(t, cert.IsCA)
+				assert.NotZero(t, cert.KeyUsage&x509.KeyUsageCertSign)
+			},
+		},
+		{
+			name:        "invalid ecdsa key size",
+			spec:        `{"spec":{"keyAlgorithm":"ECDSA","keySize":123}}`,
+			expectedErr: "unsupported key size 123 for ECDSA keys",
+		},
+		{
+			name:        "invalid ip address",
+			spec:        `{"spec":{"ipAddresses":["foo"]}}`,
+			expectedErr: "invalid ip address: foo",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := &Generator{}
+			result, _, err := g.Generate(context.Background(), &apiextensions.JSON{Raw: []byte(tt.spec)}, nil, "default")
+			if tt.expectedErr != "" {
+				assert.ErrorContains(t, err, tt.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			cert := parseCertificate(t, result[keyCertificate])
+			assert.Equal(t, result[keyCertificate], result[keyCA])
+			// self-signed certificates are signed by their own key
+			require.NoError(t, cert.CheckSignature(cert.SignatureAlgorithm, cert.RawTBSCertificate, cert.Signature))
+			tt.validate(t, cert, parseKey(t, result[keyPrivateKey]))
+		})
+	}
+
+	g := &Generator{}
+	_, _, err := g.Generate(context.Background(), nil, nil, "default")
+	assert.EqualError(t, err, errNoSpec)
+}
+
+func TestGenerateWithCA(t *testing.T) {
+	g := &Generator{}
+	ca, _, err := g.Generate(context.Background(), &apiextensions.JSON{Raw: []byte(`{"spec":{"isCA":true,"subject":{"commonName":"ca"}}}`)}, nil, "default")
+	require.NoError(t, err)
+	kube := fake.NewClientBuilder().WithObjects(&corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "ca", Namespace: "default"},
+		Data:       ca,
+	}).Build()
+
+	spec := `{"spec":{"subject":{"commonName":"leaf"},"ca":{"certSecretRef":{"name":"ca","key":"tls.crt"},"keySecretRef":{"name":"ca","key":"tls.key"}}}}`
+	result, _, err := g.Generate(context.Background(), &apiextensions.JSON{Raw: []byte(spec)}, kube, "default")
+	require.NoError(t, err)
+	assert.Equal(t, ca[keyCertificate], result[keyCA])
+
+	caCert := parseCertificate(t, ca[keyCertificate])
+	cert := parseCertificate(t, result[keyCertificate])
+	assert.Equal(t, "ca", cert.Issuer.CommonName)
+	require.NoError(t, cert.CheckSignatureFrom(caCert))
+
+	// the CA must be in the namespace of the generator
+	_, _, err = g.Generate(context.Background(), &apiextensions.JSON{Raw: []byte(spec)}, kube, "other")
+	assert.ErrorContains(t, err, "unable to get CA")
+}
+
+func TestRenewalTime(t *testing.T) {
+	g := &Generator{}
+	spec := &apiextensions.JSON{Raw: []byte(`{"spec":{"duration":"100h","renewBeforePercentage":25}}`)}
+	result, _, err := g.Generate(context.Background(), spec, nil, "default")
+	require.NoError(t, err)
+	cert := parseCertificate(t, result[keyCertificate])
+
+	renewAt, err := g.RenewalTime(spec, result)
+	require.NoError(t, err)
+	assert.Equal(t, cert.NotBefore.Add(75*time.Hour), renewAt)
+
+	_, err = g.RenewalTime(spec, map[string][]byte{})
+	assert.EqualError(t, err, errNoCertificate)
+}
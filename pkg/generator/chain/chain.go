@@ -0,0 +1,156 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package chain resolves the inputs of generators,
+// which feeds the values of one generator into another one.
+package chain
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	apiextensions "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	esv1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1"
+	genv1alpha1 "github.com/external-secrets/external-secrets/apis/generators/v1alpha1"
+	"github.com/external-secrets/external-secrets/pkg/generator/statemanager"
+	"github.com/external-secrets/external-secrets/pkg/utils/resolvers"
+)
+
+// maxDepth limits the nesting of inputs, which also breaks cycles.
+const maxDepth = 5
+
+const (
+	errParseInputs = "unable to parse generator inputs: %w"
+	errInput       = "unable to generate input %q: %w"
+	errMaxDepth    = "generator inputs are nested deeper than %d levels, check for cycles"
+)
+
+// Client generates the inputs of the generator resource and returns a client
+// that serves their values as Secrets in the namespace. All other requests are
+// passed to kube. If the generator has no inputs, kube is returned as is.
+//
+// Inputs may have inputs themselves. The state of every input is tracked by the
+// state manager under a key derived from stateKey, so that a rollback cleans up
+// the whole chain.
+func Client(
+	ctx context.Context,
+	kube client.Client,
+	scheme *runtime.Scheme,
+	namespace string,
+	resource *apiextensions.JSON,
+	stateKey string,
+	state *statemanager.Manager) (client.Client, error) {
+	return withInputs(ctx, kube, scheme, namespace, resource, stateKey, state, 0)
+}
+
+func withInputs(
+	ctx context.Context,
+	kube client.Client,
+	scheme *runtime.Scheme,
+	namespace string,
+	resource *apiextensions.JSON,
+	stateKey string,
+	state *statemanager.Manager,
+	depth int) (client.Client, error) {
+	var res genv1alpha1.GeneratorInputsResource
+	if err := json.Unmarshal(resource.Raw, &res); err != nil {
+		return nil, fmt.Errorf(errParseInputs, err)
+	}
+	if len(res.Spec.Inputs) == 0 {
+		return kube, nil
+	}
+	if depth >= maxDepth {
+		return nil, fmt.Errorf(errMaxDepth, maxDepth)
+	}
+	inputs := make(map[string]map[string][]byte, len(res.Spec.Inputs))
+	for _, input := range res.Spec.Inputs {
+		ref := input.GeneratorRef
+		if ref.APIVersion == "" {
+			ref.APIVersion = genv1alpha1.SchemeGroupVersion.String()
+		}
+		values, err := generate(ctx, kube, scheme, namespace, &ref, inputStateKey(stateKey, input.Name), state, depth)
+		if err != nil {
+			return nil, fmt.Errorf(errInput, input.Name, err)
+		}
+		inputs[input.Name] = values
+	}
+	return &inputClient{
+		Client:    kube,
+		namespace: namespace,
+		inputs:    inputs,
+	}, nil
+}
+
+func generate(
+	ctx context.Context,
+	kube client.Client,
+	scheme *runtime.Scheme,
+	namespace string,
+	ref *esv1.GeneratorRef,
+	stateKey string,
+	state *statemanager.Manager,
+	depth int) (map[string][]byte, error) {
+	impl, resource, err := resolvers.GeneratorRef(ctx, kube, scheme, namespace, ref)
+	if err != nil {
+		return nil, err
+	}
+	inputKube, err := withInputs(ctx, kube, scheme, namespace, resource, stateKey, state, depth+1)
+	if err != nil {
+		return nil, err
+	}
+	values, newState, err := impl.Generate(ctx, resource, inputKube, namespace)
+	if err != nil {
+		return nil, err
+	}
+	if state != nil {
+		state.EnqueueMoveStateToGC(stateKey)
+		state.EnqueueSetLatest(ctx, stateKey, namespace, resource, impl, newState)
+	}
+	return values, nil
+}
+
+// inputStateKey returns the key of the generator state of an input,
+// which is scoped to the generator that consumes the input.
+func inputStateKey(stateKey, input string) string {
+	return stateKey + "/" + input
+}
+
+// inputClient serves the values of generator inputs as Secrets.
+type inputClient struct {
+	client.Client
+	namespace string
+	inputs    map[string]map[string][]byte
+}
+
+func (c *inputClient) Get(ctx context.Context, key client.ObjectKey, obj client.Object, opts ...client.GetOption) error {
+	if secret, ok := obj.(*corev1.Secret); ok && key.Namespace == c.namespace {
+		if values, ok := c.inputs[key.Name]; ok {
+			*secret = corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      key.Name,
+					Namespace: key.Namespace,
+				},
+				Data: values,
+			}
+			return nil
+		}
+	}
+	return c.Client.Get(ctx, key, obj, opts...)
+}
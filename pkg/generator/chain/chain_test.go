@@ -0,0 +1,146 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package chain
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	apiextensions "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	clientfake "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	esv1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1"
+	genv1alpha1 "github.com/external-secrets/external-secrets/apis/generators/v1alpha1"
+	"github.com/external-secrets/external-secrets/pkg/generator/certificate"
+	_ "github.com/external-secrets/external-secrets/pkg/generator/fake"
+)
+
+const namespace = "default"
+
+func testScheme(t *testing.T) *runtime.Scheme {
+	scheme := runtime.NewScheme()
+	require.NoError(t, clientgoscheme.AddToScheme(scheme))
+	require.NoError(t, genv1alpha1.AddToScheme(scheme))
+	return scheme
+}
+
+func certificateRef(name string) esv1.GeneratorRef {
+	return esv1.GeneratorRef{
+		APIVersion: genv1alpha1.SchemeGroupVersion.String(),
+		Kind:       genv1alpha1.CertificateKind,
+		Name:       name,
+	}
+}
+
+func toJSON(t *testing.T, obj client.Object) *apiextensions.JSON {
+	raw, err := json.Marshal(obj)
+	require.NoError(t, err)
+	return &apiextensions.JSON{Raw: raw}
+}
+
+func TestClient(t *testing.T) {
+	ca := &genv1alpha1.Certificate{
+		ObjectMeta: metav1.ObjectMeta{Name: "ca", Namespace: namespace},
+		Spec: genv1alpha1.CertificateSpec{
+			Subject: &genv1alpha1.CertificateSubject{CommonName: "chained ca"},
+			IsCA:    true,
+		},
+	}
+	leaf := &genv1alpha1.Certificate{
+		TypeMeta:   metav1.TypeMeta{APIVersion: genv1alpha1.SchemeGroupVersion.String(), Kind: genv1alpha1.CertificateKind},
+		ObjectMeta: metav1.ObjectMeta{Name: "leaf", Namespace: namespace},
+		Spec: genv1alpha1.CertificateSpec{
+			DNSNames: []string{"example.com"},
+			CA: &genv1alpha1.CertificateCA{
+				CertSecretRef: genv1alpha1.SecretKeySelector{Name: "issuer", Key: "tls.crt"},
+				KeySecretRef:  genv1alpha1.SecretKeySelector{Name: "issuer", Key: "tls.key"},
+			},
+			Inputs: []genv1alpha1.GeneratorInput{{Name: "issuer", GeneratorRef: certificateRef("ca")}},
+		},
+	}
+	other := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "other", Namespace: namespace},
+		Data:       map[string][]byte{"foo": []byte("bar")},
+	}
+	kube := clientfake.NewClientBuilder().WithScheme(testScheme(t)).WithObjects(ca, other).Build()
+
+	inputKube, err := Client(context.Background(), kube, testScheme(t), namespace, toJSON(t, leaf), "0", nil)
+	require.NoError(t, err)
+
+	// the input is served as a Secret
+	issuer := &corev1.Secret{}
+	require.NoError(t, inputKube.Get(context.Background(), client.ObjectKey{Namespace: namespace, Name: "issuer"}, issuer))
+	block, _ := pem.Decode(issuer.Data["tls.crt"])
+	require.NotNil(t, block)
+	caCert, err := x509.ParseCertificate(block.Bytes)
+	require.NoError(t, err)
+	assert.Equal(t, "chained ca", caCert.Subject.CommonName)
+
+	// other Secrets are read from the API
+	got := &corev1.Secret{}
+	require.NoError(t, inputKube.Get(context.Background(), client.ObjectKey{Namespace: namespace, Name: "other"}, got))
+	assert.Equal(t, other.Data, got.Data)
+
+	// the input is not served to other namespaces
+	err = inputKube.Get(context.Background(), client.ObjectKey{Namespace: "other", Name: "issuer"}, &corev1.Secret{})
+	assert.Error(t, err)
+
+	// the leaf certificate is signed by the input
+	values, _, err := (&certificate.Generator{}).Generate(context.Background(), toJSON(t, leaf), inputKube, namespace)
+	require.NoError(t, err)
+	block, _ = pem.Decode(values["tls.crt"])
+	require.NotNil(t, block)
+	leafCert, err := x509.ParseCertificate(block.Bytes)
+	require.NoError(t, err)
+	assert.NoError(t, leafCert.CheckSignatureFrom(caCert))
+}
+
+func TestClientWithoutInputs(t *testing.T) {
+	kube := clientfake.NewClientBuilder().WithScheme(testScheme(t)).Build()
+	got, err := Client(context.Background(), kube, testScheme(t), namespace, &apiextensions.JSON{Raw: []byte(`{"spec":{}}`)}, "0", nil)
+	require.NoError(t, err)
+	assert.Same(t, kube, got)
+}
+
+func TestClientErrors(t *testing.T) {
+	cyclic := &genv1alpha1.Certificate{
+		ObjectMeta: metav1.ObjectMeta{Name: "cyclic", Namespace: namespace},
+		Spec: genv1alpha1.CertificateSpec{
+			Inputs: []genv1alpha1.GeneratorInput{{Name: "self", GeneratorRef: certificateRef("cyclic")}},
+		},
+	}
+	kube := clientfake.NewClientBuilder().WithScheme(testScheme(t)).WithObjects(cyclic).Build()
+
+	_, err := Client(context.Background(), kube, testScheme(t), namespace, toJSON(t, cyclic), "0", nil)
+	assert.ErrorContains(t, err, "nested deeper than 5 levels")
+
+	missing := &genv1alpha1.Certificate{
+		Spec: genv1alpha1.CertificateSpec{
+			Inputs: []genv1alpha1.GeneratorInput{{Name: "missing", GeneratorRef: certificateRef("missing")}},
+		},
+	}
+	_, err = Client(context.Background(), kube, testScheme(t), namespace, toJSON(t, missing), "0", nil)
+	assert.ErrorContains(t, err, `unable to generate input "missing"`)
+}
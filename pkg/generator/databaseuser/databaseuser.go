@@ -0,0 +1,291 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package databaseuser
+
+import (
+	"bytes"
+	"context"
+	"crypto/x509"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"text/template"
+
+	"github.com/sethvargo/go-password/password"
+	apiextensions "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+
+	genv1alpha1 "github.com/external-secrets/external-secrets/apis/generators/v1alpha1"
+	esmeta "github.com/external-secrets/external-secrets/apis/meta/v1"
+	"github.com/external-secrets/external-secrets/pkg/utils"
+	"github.com/external-secrets/external-secrets/pkg/utils/resolvers"
+)
+
+type openFunc func(d dialect, dsn string, rootCAs *x509.CertPool) (*sql.DB, error)
+
+type Generator struct {
+	open openFunc
+}
+
+const (
+	defaultUsernamePrefix = "eso_"
+	defaultPasswordLength = 32
+	usernameRandomLength  = 16
+
+	errNoSpec           = "no config spec provided"
+	errParseSpec        = "unable to parse spec: %w"
+	errParseState       = "unable to parse state: %w"
+	errNoState          = "missing previous state"
+	errUnknownDriver    = "unknown database driver %q"
+	errGetPassword      = "unable to get admin password: %w"
+	errFetchCA          = "unable to fetch CA certificate: %w"
+	errAppendCA         = "unable to append CA certificate to the pool"
+	errConnect          = "unable to connect to database: %w"
+	errGenerateUsername = "unable to generate username: %w"
+	errGeneratePassword = "unable to generate password: %w"
+	errTemplate         = "unable to render statement %d: %w"
+	errCreateUser       = "unable to create user: %w"
+	errDropUser         = "unable to drop user %s: %w"
+)
+
+// templateData is available in the statements of the spec.
+type templateData struct {
+	Username string
+	Password string
+	Database string
+}
+
+func (g *Generator) Generate(ctx context.Context, jsonSpec *apiextensions.JSON, kube client.Client, namespace string) (map[string][]byte, genv1alpha1.GeneratorProviderState, error) {
+	if jsonSpec == nil {
+		return nil, nil, errors.New(errNoSpec)
+	}
+	res, err := parseSpec(jsonSpec.Raw)
+	if err != nil {
+		return nil, nil, fmt.Errorf(errParseSpec, err)
+	}
+	d, err := dialectFor(res.Spec.Driver)
+	if err != nil {
+		return nil, nil, err
+	}
+	username, pass, err := generateCredentials(&res.Spec)
+	if err != nil {
+		return nil, nil, err
+	}
+	db, err := g.connect(ctx, d, &res.Spec, kube, namespace)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer db.Close()
+
+	if err := createUser(ctx, db, d, &res.Spec, username, pass); err != nil {
+		// MySQL commits DDL statements implicitly, so a partially
+		// created user has to be dropped explicitly.
+		if dropErr := dropUser(ctx, db, d, &res.Spec, username); dropErr != nil {
+			return nil, nil, errors.Join(err, dropErr)
+		}
+		return nil, nil, err
+	}
+
+	rawState, err := json.Marshal(&genv1alpha1.DatabaseUserState{
+		Username: username,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return map[string][]byte{
+		"username": []byte(username),
+		"password": []byte(pass),
+	}, &apiextensions.JSON{Raw: rawState}, nil
+}
+
+// Cleanup drops the user that has been created by Generate.
+func (g *Generator) Cleanup(ctx context.Context, jsonSpec *apiextensions.JSON, previousState genv1alpha1.GeneratorProviderState, kube client.Client, namespace string) error {
+	if jsonSpec == nil {
+		return errors.New(errNoSpec)
+	}
+	if previousState == nil {
+		return errors.New(errNoState)
+	}
+	res, err := parseSpec(jsonSpec.Raw)
+	if err != nil {
+		return fmt.Errorf(errParseSpec, err)
+	}
+	var state genv1alpha1.DatabaseUserState
+	if err := json.Unmarshal(previousState.Raw, &state); err != nil {
+		return fmt.Errorf(errParseState, err)
+	}
+	d, err := dialectFor(res.Spec.Driver)
+	if err != nil {
+		return err
+	}
+	db, err := g.connect(ctx, d, &res.Spec, kube, namespace)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+	return dropUser(ctx, db, d, &res.Spec, state.Username)
+}
+
+func (g *Generator) connect(ctx context.Context, d dialect, spec *genv1alpha1.DatabaseUserSpec, kube client.Client, namespace string) (*sql.DB, error) {
+	adminPassword, err := resolvers.SecretKeyRef(ctx, kube, resolvers.EmptyStoreKind, namespace, &esmeta.SecretKeySelector{
+		Namespace: &namespace,
+		Name:      spec.Auth.Password.Name,
+		Key:       spec.Auth.Password.Key,
+	})
+	if err != nil {
+		return nil, fmt.Errorf(errGetPassword, err)
+	}
+	var rootCAs *x509.CertPool
+	if len(spec.CABundle) > 0 || spec.CAProvider != nil {
+		ca, err := utils.FetchCACertFromSource(ctx, utils.CreateCertOpts{
+			CABundle:   spec.CABundle,
+			CAProvider: spec.CAProvider,
+			StoreKind:  resolvers.EmptyStoreKind,
+			Namespace:  namespace,
+			Client:     kube,
+		})
+		if err != nil {
+			return nil, fmt.Errorf(errFetchCA, err)
+		}
+		rootCAs = x509.NewCertPool()
+		if ok := rootCAs.AppendCertsFromPEM(ca); !ok {
+			return nil, errors.New(errAppendCA)
+		}
+	}
+	open := g.open
+	if open == nil {
+		open = openDB
+	}
+	db, err := open(d, d.dsn(spec, adminPassword), rootCAs)
+	if err != nil {
+		return nil, fmt.Errorf(errConnect, err)
+	}
+	if err := db.PingContext(ctx); err != nil {
+		_ = db.Close()
+		return nil, fmt.Errorf(errConnect, err)
+	}
+	return db, nil
+}
+
+func openDB(d dialect, dsn string, rootCAs *x509.CertPool) (*sql.DB, error) {
+	c, err := d.connector(dsn, rootCAs)
+	if err != nil {
+		return nil, err
+	}
+	return sql.OpenDB(c), nil
+}
+
+func generateCredentials(spec *genv1alpha1.DatabaseUserSpec) (string, string, error) {
+	prefix := defaultUsernamePrefix
+	if spec.UsernamePrefix != nil {
+		prefix = *spec.UsernamePrefix
+	}
+	suffix, err := password.Generate(usernameRandomLength, usernameRandomLength/4, 0, true, true)
+	if err != nil {
+		return "", "", fmt.Errorf(errGenerateUsername, err)
+	}
+	length := defaultPasswordLength
+	if spec.PasswordLength != nil {
+		length = *spec.PasswordLength
+	}
+	pass, err := password.Generate(length, length/4, 0, false, true)
+	if err != nil {
+		return "", "", fmt.Errorf(errGeneratePassword, err)
+	}
+	return prefix + suffix, pass, nil
+}
+
+func createUser(ctx context.Context, db *sql.DB, d dialect, spec *genv1alpha1.DatabaseUserSpec, username, pass string) error {
+	stmts, err := d.createUser(username, pass)
+	if err != nil {
+		return fmt.Errorf(errCreateUser, err)
+	}
+	for _, role := range spec.Roles {
+		stmts = append(stmts, d.grantRole(username, role)...)
+	}
+	custom, err := renderStatements(spec.Statements, templateData{
+		Username: username,
+		Password: pass,
+		Database: spec.Database,
+	})
+	if err != nil {
+		return fmt.Errorf(errCreateUser, err)
+	}
+	if err := exec(ctx, db, append(stmts, custom...)); err != nil {
+		return fmt.Errorf(errCreateUser, err)
+	}
+	return nil
+}
+
+// dropUser runs the cleanup statements and drops the user.
+// It succeeds if the user does not exist anymore.
+func dropUser(ctx context.Context, db *sql.DB, d dialect, spec *genv1alpha1.DatabaseUserSpec, username string) error {
+	var exists int
+	err := db.QueryRowContext(ctx, d.userExists(), username).Scan(&exists)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf(errDropUser, username, err)
+	}
+	stmts, err := renderStatements(spec.CleanupStatements, templateData{
+		Username: username,
+		Database: spec.Database,
+	})
+	if err != nil {
+		return fmt.Errorf(errDropUser, username, err)
+	}
+	if err := exec(ctx, db, append(stmts, d.dropUser(username)...)); err != nil {
+		return fmt.Errorf(errDropUser, username, err)
+	}
+	return nil
+}
+
+func renderStatements(stmts []string, data templateData) ([]string, error) {
+	out := make([]string, 0, len(stmts))
+	for i, stmt := range stmts {
+		tpl, err := template.New("statement").Option("missingkey=error").Parse(stmt)
+		if err != nil {
+			return nil, fmt.Errorf(errTemplate, i, err)
+		}
+		var buf bytes.Buffer
+		if err := tpl.Execute(&buf, data); err != nil {
+			return nil, fmt.Errorf(errTemplate, i, err)
+		}
+		out = append(out, buf.String())
+	}
+	return out, nil
+}
+
+func exec(ctx context.Context, db *sql.DB, stmts []string) error {
+	for _, stmt := range stmts {
+		if _, err := db.ExecContext(ctx, stmt); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func parseSpec(data []byte) (*genv1alpha1.DatabaseUser, error) {
+	var spec genv1alpha1.DatabaseUser
+	err := yaml.Unmarshal(data, &spec)
+	return &spec, err
+}
+
+func init() {
+	genv1alpha1.Register(genv1alpha1.DatabaseUserKind, &Generator{open: openDB})
+}
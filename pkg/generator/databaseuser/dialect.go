@@ -0,0 +1,219 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package databaseuser
+
+import (
+	"crypto/rand"
+	"crypto/x509"
+	"database/sql/driver"
+	"fmt"
+	"net"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/go-sql-driver/mysql"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/stdlib"
+
+	genv1alpha1 "github.com/external-secrets/external-secrets/apis/generators/v1alpha1"
+	"github.com/external-secrets/external-secrets/pkg/utils/scram"
+)
+
+// dialect builds the statements that differ between the database servers.
+// Identifiers and literals are always quoted, even though the generated
+// username and password only contain letters, digits and underscores.
+type dialect interface {
+	dsn(spec *genv1alpha1.DatabaseUserSpec, password string) string
+	// connector connects to the dsn. If rootCAs is set, it is used
+	// instead of the system root certificates to verify the server.
+	connector(dsn string, rootCAs *x509.CertPool) (driver.Connector, error)
+	// userExists is a query that returns a row if the user given as argument exists.
+	userExists() string
+	createUser(username, password string) ([]string, error)
+	grantRole(username, role string) []string
+	dropUser(username string) []string
+}
+
+func dialectFor(driver genv1alpha1.DatabaseDriver) (dialect, error) {
+	switch driver {
+	case genv1alpha1.DatabaseDriverPostgres:
+		return postgres{}, nil
+	case genv1alpha1.DatabaseDriverMySQL:
+		return mysqlDialect{}, nil
+	default:
+		return nil, fmt.Errorf(errUnknownDriver, driver)
+	}
+}
+
+func sslMode(spec *genv1alpha1.DatabaseUserSpec) genv1alpha1.DatabaseSSLMode {
+	if spec.SSLMode == "" {
+		return genv1alpha1.DatabaseSSLModeVerifyFull
+	}
+	return spec.SSLMode
+}
+
+func address(spec *genv1alpha1.DatabaseUserSpec, defaultPort int32) string {
+	port := defaultPort
+	if spec.Port != nil {
+		port = *spec.Port
+	}
+	return net.JoinHostPort(spec.Host, strconv.Itoa(int(port)))
+}
+
+type postgres struct{}
+
+func (postgres) dsn(spec *genv1alpha1.DatabaseUserSpec, password string) string {
+	u := url.URL{
+		Scheme:   "postgres",
+		User:     url.UserPassword(spec.Auth.Username, password),
+		Host:     address(spec, 5432),
+		Path:     "/" + spec.Database,
+		RawQuery: url.Values{"sslmode": []string{string(sslMode(spec))}}.Encode(),
+	}
+	return u.String()
+}
+
+func (postgres) connector(dsn string, rootCAs *x509.CertPool) (driver.Connector, error) {
+	cfg, err := pgx.ParseConfig(dsn)
+	if err != nil {
+		return nil, err
+	}
+	if rootCAs != nil && cfg.TLSConfig != nil {
+		cfg.TLSConfig.RootCAs = rootCAs
+	}
+	return stdlib.GetConnector(*cfg), nil
+}
+
+func (postgres) userExists() string {
+	return "SELECT 1 FROM pg_roles WHERE rolname = $1"
+}
+
+// createUser sends a SCRAM-SHA-256 verifier instead of the password,
+// so the password does not show up in the server logs or pg_stat_activity.
+func (p postgres) createUser(username, password string) ([]string, error) {
+	salt := make([]byte, scram.SaltSize)
+	if _, err := rand.Read(salt); err != nil {
+		return nil, err
+	}
+	verifier, err := scram.Verifier(password, salt)
+	if err != nil {
+		return nil, err
+	}
+	return []string{
+		fmt.Sprintf("CREATE ROLE %s WITH LOGIN PASSWORD %s", p.quoteIdentifier(username), p.quoteLiteral(verifier)),
+	}, nil
+}
+
+func (p postgres) grantRole(username, role string) []string {
+	return []string{
+		fmt.Sprintf("GRANT %s TO %s", p.quoteIdentifier(role), p.quoteIdentifier(username)),
+	}
+}
+
+// dropUser hands the objects of the user over to the admin user first,
+// as a role that owns objects or holds privileges can not be dropped.
+func (p postgres) dropUser(username string) []string {
+	user := p.quoteIdentifier(username)
+	return []string{
+		fmt.Sprintf("REASSIGN OWNED BY %s TO CURRENT_USER", user),
+		fmt.Sprintf("DROP OWNED BY %s", user),
+		fmt.Sprintf("DROP ROLE IF EXISTS %s", user),
+	}
+}
+
+func (postgres) quoteIdentifier(s string) string {
+	return pgx.Identifier{s}.Sanitize()
+}
+
+// quoteLiteral uses the escape string syntax for backslashes,
+// which does not depend on standard_conforming_strings.
+func (postgres) quoteLiteral(s string) string {
+	s = strings.ReplaceAll(s, "'", "''")
+	if strings.Contains(s, `\`) {
+		return "E'" + strings.ReplaceAll(s, `\`, `\\`) + "'"
+	}
+	return "'" + s + "'"
+}
+
+type mysqlDialect struct{}
+
+func (mysqlDialect) dsn(spec *genv1alpha1.DatabaseUserSpec, password string) string {
+	cfg := mysql.NewConfig()
+	cfg.User = spec.Auth.Username
+	cfg.Passwd = password
+	cfg.Net = "tcp"
+	cfg.Addr = address(spec, 3306)
+	cfg.DBName = spec.Database
+	switch sslMode(spec) {
+	case genv1alpha1.DatabaseSSLModeDisable:
+		cfg.TLSConfig = "false"
+	case genv1alpha1.DatabaseSSLModeRequire:
+		cfg.TLSConfig = "skip-verify"
+	case genv1alpha1.DatabaseSSLModeVerifyFull:
+		cfg.TLSConfig = "true"
+	}
+	return cfg.FormatDSN()
+}
+
+func (mysqlDialect) connector(dsn string, rootCAs *x509.CertPool) (driver.Connector, error) {
+	cfg, err := mysql.ParseDSN(dsn)
+	if err != nil {
+		return nil, err
+	}
+	if rootCAs != nil && cfg.TLS != nil {
+		cfg.TLS.RootCAs = rootCAs
+	}
+	return mysql.NewConnector(cfg)
+}
+
+func (mysqlDialect) userExists() string {
+	return "SELECT 1 FROM mysql.user WHERE User = ?"
+}
+
+func (m mysqlDialect) createUser(username, password string) ([]string, error) {
+	return []string{
+		fmt.Sprintf("CREATE USER %s IDENTIFIED BY %s", m.account(username), m.quoteLiteral(password)),
+	}, nil
+}
+
+// grantRole also activates the granted roles on login,
+// which MySQL does not do by default.
+func (m mysqlDialect) grantRole(username, role string) []string {
+	return []string{
+		fmt.Sprintf("GRANT %s TO %s", m.quoteIdentifier(role), m.account(username)),
+		fmt.Sprintf("SET DEFAULT ROLE ALL TO %s", m.account(username)),
+	}
+}
+
+func (m mysqlDialect) dropUser(username string) []string {
+	return []string{
+		fmt.Sprintf("DROP USER IF EXISTS %s", m.account(username)),
+	}
+}
+
+// account is the user that may connect from any host.
+func (m mysqlDialect) account(username string) string {
+	return m.quoteLiteral(username) + "@'%'"
+}
+
+func (mysqlDialect) quoteIdentifier(s string) string {
+	return "`" + strings.ReplaceAll(s, "`", "``") + "`"
+}
+
+func (mysqlDialect) quoteLiteral(s string) string {
+	s = strings.ReplaceAll(s, `\`, `\\`)
+	return "'" + strings.ReplaceAll(s, "'", "''") + "'"
+}
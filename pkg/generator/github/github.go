@@ -23,6 +23,9 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/golang-jwt/jwt/v5"
@@ -39,22 +42,29 @@ type Generator struct {
 }
 
 type Github struct {
-	HTTP         *http.Client
-	Kube         client.Client
-	Namespace    string
-	URL          string
-	InstallTkn   string
-	Repositories []string
-	Permissions  map[string]string
+	HTTP          *http.Client
+	Kube          client.Client
+	Namespace     string
+	BaseURL       string
+	URL           string
+	InstallTkn    string
+	Repositories  []string
+	RepositoryIDs []int64
+	Permissions   map[string]string
 }
 
 const (
 	defaultLoginUsername = "token"
 	defaultGithubAPI     = "https://api.github.com"
 
-	errNoSpec    = "no config spec provided"
-	errParseSpec = "unable to parse spec: %w"
-	errGetToken  = "unable to get authorization token: %w"
+	errNoSpec           = "no config spec provided"
+	errParseSpec        = "unable to parse spec: %w"
+	errParseState       = "unable to parse state: %w"
+	errGetToken         = "unable to get authorization token: %w"
+	errRevokeToken      = "unable to revoke token: %w"
+	errGetInstallation  = "unable to get installation: %w"
+	errNoInstallation   = "either installID or installation must be set"
+	errInstallationRefs = "exactly one of organization, user or repository must be set in installation"
 
 	contextTimeout    = 30 * time.Second
 	httpClientTimeout = 5 * time.Second
@@ -69,10 +79,60 @@ func (g *Generator) Generate(ctx context.Context, jsonSpec *apiextensions.JSON,
 	)
 }
 
-func (g *Generator) Cleanup(ctx context.Context, jsonSpec *apiextensions.JSON, _ genv1alpha1.GeneratorProviderState, crClient client.Client, namespace string) error {
+// Cleanup revokes the installation access token that has been created by Generate,
+// so it can not be used for the rest of its lifetime.
+func (g *Generator) Cleanup(ctx context.Context, jsonSpec *apiextensions.JSON, previousState genv1alpha1.GeneratorProviderState, _ client.Client, _ string) error {
+	// states of tokens created by earlier versions are empty
+	if previousState == nil {
+		return nil
+	}
+	if jsonSpec == nil {
+		return errors.New(errNoSpec)
+	}
+	res, err := parseSpec(jsonSpec.Raw)
+	if err != nil {
+		return fmt.Errorf(errParseSpec, err)
+	}
+	var state genv1alpha1.GithubAccessTokenState
+	if err := json.Unmarshal(previousState.Raw, &state); err != nil {
+		return fmt.Errorf(errParseState, err)
+	}
+	ctx, cancel := context.WithTimeout(ctx, contextTimeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, baseURL(&res.Spec)+"/installation/token", http.NoBody)
+	if err != nil {
+		return fmt.Errorf(errRevokeToken, err)
+	}
+	req.Header.Add("Authorization", "Bearer "+state.Token)
+	req.Header.Add("Accept", "application/vnd.github.v3+json")
+
+	resp, err := g.client().Do(req)
+	if err != nil {
+		return fmt.Errorf(errRevokeToken, err)
+	}
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+	// an expired or already revoked token is rejected as unauthorized
+	if resp.StatusCode == http.StatusUnauthorized {
+		return nil
+	}
+	if resp.StatusCode >= 300 {
+		return fmt.Errorf(errRevokeToken, fmt.Errorf("response code: %d", resp.StatusCode))
+	}
 	return nil
 }
 
+func (g *Generator) client() *http.Client {
+	if g.httpClient != nil {
+		return g.httpClient
+	}
+	return &http.Client{
+		Timeout: httpClientTimeout,
+	}
+}
+
 func (g *Generator) generate(
 	ctx context.Context,
 	jsonSpec *apiextensions.JSON,
@@ -96,6 +156,9 @@ func (g *Generator) generate(
 	if len(gh.Repositories) > 0 {
 		payload["repositories"] = gh.Repositories
 	}
+	if len(gh.RepositoryIDs) > 0 {
+		payload["repository_ids"] = gh.RepositoryIDs
+	}
 
 	var body io.Reader = http.NoBody
 	if len(payload) > 0 {
@@ -140,9 +203,15 @@ func (g *Generator) generate(
 	if !ok {
 		return nil, nil, errors.New("token isn't a string or token key doesn't exist")
 	}
+	rawState, err := json.Marshal(&genv1alpha1.GithubAccessTokenState{
+		Token: accessToken,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
 	return map[string][]byte{
 		defaultLoginUsername: []byte(accessToken),
-	}, nil, nil
+	}, &apiextensions.JSON{Raw: rawState}, nil
 }
 
 func newGHClient(ctx context.Context, k client.Client, n string, hc *http.Client,
@@ -157,18 +226,15 @@ func newGHClient(ctx context.Context, k client.Client, n string, hc *http.Client
 		return nil, fmt.Errorf(errParseSpec, err)
 	}
 	gh := &Github{
-		Kube:         k,
-		Namespace:    n,
-		HTTP:         hc,
-		Repositories: res.Spec.Repositories,
-		Permissions:  res.Spec.Permissions,
+		Kube:          k,
+		Namespace:     n,
+		HTTP:          hc,
+		BaseURL:       baseURL(&res.Spec),
+		Repositories:  res.Spec.Repositories,
+		RepositoryIDs: res.Spec.RepositoryIDs,
+		Permissions:   res.Spec.Permissions,
 	}
 
-	ghPath := fmt.Sprintf("/app/installations/%s/access_tokens", res.Spec.InstallID)
-	gh.URL = defaultGithubAPI + ghPath
-	if res.Spec.URL != "" {
-		gh.URL = res.Spec.URL + ghPath
-	}
 	secret := &corev1.Secret{}
 	if err := gh.Kube.Get(ctx, client.ObjectKey{Name: res.Spec.Auth.PrivateKey.SecretRef.Name, Namespace: n}, secret); err != nil {
 		return nil, fmt.Errorf("error getting GH pem from secret:%w", err)
@@ -181,9 +247,74 @@ func newGHClient(ctx context.Context, k client.Client, n string, hc *http.Client
 	if gh.InstallTkn, err = GetInstallationToken(pk, res.Spec.AppID); err != nil {
 		return nil, fmt.Errorf("can't get InstallationToken: %w", err)
 	}
+
+	installID := res.Spec.InstallID
+	if installID == "" {
+		if installID, err = gh.installationID(ctx, res.Spec.Installation); err != nil {
+			return nil, fmt.Errorf(errGetInstallation, err)
+		}
+	}
+	gh.URL = gh.BaseURL + fmt.Sprintf("/app/installations/%s/access_tokens", installID)
 	return gh, nil
 }
 
+// installationID looks up the ID of the installation of the app
+// in the organization, user account or repository.
+func (gh *Github) installationID(ctx context.Context, installation *genv1alpha1.GithubInstallation) (string, error) {
+	if installation == nil {
+		return "", errors.New(errNoInstallation)
+	}
+	var paths []string
+	if installation.Organization != "" {
+		paths = append(paths, "/orgs/"+url.PathEscape(installation.Organization))
+	}
+	if installation.User != "" {
+		paths = append(paths, "/users/"+url.PathEscape(installation.User))
+	}
+	if installation.Repository != "" {
+		owner, repo, ok := strings.Cut(installation.Repository, "/")
+		if !ok {
+			return "", fmt.Errorf("repository must be in the form owner/name: %q", installation.Repository)
+		}
+		paths = append(paths, "/repos/"+url.PathEscape(owner)+"/"+url.PathEscape(repo))
+	}
+	if len(paths) != 1 {
+		return "", errors.New(errInstallationRefs)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, gh.BaseURL+paths[0]+"/installation", http.NoBody)
+	if err != nil {
+		return "", err
+	}
+	req.Header.Add("Authorization", "Bearer "+gh.InstallTkn)
+	req.Header.Add("Accept", "application/vnd.github.v3+json")
+
+	resp, err := gh.HTTP.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+	if resp.StatusCode >= 300 {
+		return "", fmt.Errorf("response code: %d", resp.StatusCode)
+	}
+	var inst struct {
+		ID int64 `json:"id"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&inst); err != nil {
+		return "", fmt.Errorf("error decoding response: %w", err)
+	}
+	return strconv.FormatInt(inst.ID, 10), nil
+}
+
+func baseURL(spec *genv1alpha1.GithubAccessTokenSpec) string {
+	if spec.URL != "" {
+		return spec.URL
+	}
+	return defaultGithubAPI
+}
+
 // Get github installation token.
 func GetInstallationToken(key *rsa.PrivateKey, aid string) (string, error) {
 	claims := jwt.RegisteredClaims{
@@ -16,7 +16,9 @@ package github
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
 	"net/http/httptest"
 	"os"
@@ -197,3 +199,116 @@ spec:
 		})
 	}
 }
+
+func TestGenerateWithInstallationLookup(t *testing.T) {
+	pem, err := os.ReadFile(tstCrtName)
+	require.NoError(t, err)
+	kube := clientfake.NewClientBuilder().WithObjects(&v1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "testName", Namespace: "foo"},
+		Data:       map[string][]byte{"privateKey": pem},
+	}).Build()
+
+	var payload map[string]any
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /orgs/octo-org/installation", func(rw http.ResponseWriter, req *http.Request) {
+		assert.NotEmpty(t, req.Header.Get("Authorization"))
+		rw.Write([]byte(`{"id": 1234}`))
+	})
+	mux.HandleFunc("POST /app/installations/1234/access_tokens", func(rw http.ResponseWriter, req *http.Request) {
+		raw, _ := io.ReadAll(req.Body)
+		assert.NoError(t, json.Unmarshal(raw, &payload))
+		rw.WriteHeader(http.StatusCreated)
+		rw.Write([]byte(`{"token": "ghs_new"}`))
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	spec := func(installation string) *apiextensions.JSON {
+		return &apiextensions.JSON{Raw: []byte(fmt.Sprintf(`apiVersion: generators.external-secrets.io/v1alpha1
+kind: GithubAccessToken
+spec:
+  appID: "0000000"
+  url: %q
+  %s
+  repositoryIDs: [1296269, 42]
+  auth:
+    privateKey:
+      secretRef:
+        name: "testName"
+        key: "privateKey"`, server.URL, installation))}
+	}
+
+	g := &Generator{httpClient: server.Client()}
+	got, state, err := g.generate(context.TODO(), spec(`installation: {organization: octo-org}`), kube, "foo")
+	require.NoError(t, err)
+	assert.Equal(t, map[string][]byte{"token": []byte("ghs_new")}, got)
+	assert.JSONEq(t, `{"token":"ghs_new"}`, string(state.Raw))
+	assert.Equal(t, map[string]any{"repository_ids": []any{float64(1296269), float64(42)}}, payload)
+
+	// the token is only kept in the state, so nothing is left behind
+	// when the controller runs without GeneratorStates.
+	var secrets v1.SecretList
+	require.NoError(t, kube.List(context.TODO(), &secrets, client.InNamespace("foo")))
+	assert.Len(t, secrets.Items, 1)
+
+	_, _, err = g.generate(context.TODO(), spec(`installation: {user: octocat}`), kube, "foo")
+	assert.ErrorContains(t, err, "unable to get installation: response code: 404")
+
+	_, _, err = g.generate(context.TODO(), spec(`installation: {organization: octo-org, repository: octo-org/app}`), kube, "foo")
+	assert.ErrorContains(t, err, errInstallationRefs)
+
+	_, _, err = g.generate(context.TODO(), spec(``), kube, "foo")
+	assert.ErrorContains(t, err, errNoInstallation)
+}
+
+func TestCleanup(t *testing.T) {
+	tests := []struct {
+		name    string
+		state   *apiextensions.JSON
+		status  int
+		wantErr bool
+	}{
+		{
+			name:   "revokes token",
+			state:  &apiextensions.JSON{Raw: []byte(`{"token":"ghs_old"}`)},
+			status: http.StatusNoContent,
+		},
+		{
+			name:   "token already expired",
+			state:  &apiextensions.JSON{Raw: []byte(`{"token":"ghs_old"}`)},
+			status: http.StatusUnauthorized,
+		},
+		{
+			name:    "server error",
+			state:   &apiextensions.JSON{Raw: []byte(`{"token":"ghs_old"}`)},
+			status:  http.StatusInternalServerError,
+			wantErr: true,
+		},
+		{
+			name: "no state",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			called := false
+			server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+				called = true
+				assert.Equal(t, http.MethodDelete, req.Method)
+				assert.Equal(t, "/installation/token", req.URL.Path)
+				assert.Equal(t, "Bearer ghs_old", req.Header.Get("Authorization"))
+				rw.WriteHeader(tt.status)
+			}))
+			defer server.Close()
+
+			g := &Generator{httpClient: server.Client()}
+			jsonSpec := &apiextensions.JSON{Raw: []byte(fmt.Sprintf(`{"spec":{"url":%q}}`, server.URL))}
+			err := g.Cleanup(context.TODO(), jsonSpec, tt.state, nil, "foo")
+			if tt.wantErr {
+				assert.ErrorContains(t, err, "unable to revoke token")
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.state != nil, called)
+		})
+	}
+}
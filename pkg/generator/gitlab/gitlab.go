@@ -0,0 +1,305 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gitlab
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+	apiextensions "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+
+	esv1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1"
+	genv1alpha1 "github.com/external-secrets/external-secrets/apis/generators/v1alpha1"
+	esmeta "github.com/external-secrets/external-secrets/apis/meta/v1"
+	"github.com/external-secrets/external-secrets/pkg/generator/renewal"
+	gitlabprovider "github.com/external-secrets/external-secrets/pkg/provider/gitlab"
+	"github.com/external-secrets/external-secrets/pkg/utils/resolvers"
+)
+
+type Generator struct{}
+
+const (
+	defaultName         = "external-secrets"
+	defaultExpiresAfter = 30 * 24 * time.Hour
+	day                 = 24 * time.Hour
+
+	keyToken  = "token"
+	keyID     = "id"
+	keyExpiry = "expiry"
+
+	errNoSpec        = "no config spec provided"
+	errParseSpec     = "unable to parse spec: %w"
+	errParseState    = "unable to parse state: %w"
+	errNoState       = "missing previous state"
+	errGetAuth       = "unable to get access token: %w"
+	errNewClient     = "unable to create gitlab client: %w"
+	errCreateToken   = "unable to create %s access token: %w"
+	errRevokeToken   = "unable to revoke %s access token %d: %w"
+	errParseExpiry   = "unable to parse expiry: %w"
+	errMissingOwner  = "%s is required for %s access tokens"
+	errUnknownType   = "unknown access token type %q"
+	errShortLifetime = "expiresAfter must be at least one day"
+)
+
+var accessLevels = map[genv1alpha1.GitlabAccessLevel]gitlab.AccessLevelValue{
+	genv1alpha1.GitlabAccessLevelGuest:      gitlab.GuestPermissions,
+	genv1alpha1.GitlabAccessLevelReporter:   gitlab.ReporterPermissions,
+	genv1alpha1.GitlabAccessLevelDeveloper:  gitlab.DeveloperPermissions,
+	genv1alpha1.GitlabAccessLevelMaintainer: gitlab.MaintainerPermissions,
+	genv1alpha1.GitlabAccessLevelOwner:      gitlab.OwnerPermissions,
+}
+
+// token is the part of the project, group and personal access tokens that is returned.
+type token struct {
+	ID        int
+	Token     string
+	ExpiresAt *gitlab.ISOTime
+}
+
+func (g *Generator) Generate(ctx context.Context, jsonSpec *apiextensions.JSON, kube client.Client, namespace string) (map[string][]byte, genv1alpha1.GeneratorProviderState, error) {
+	return g.generate(ctx, jsonSpec, kube, namespace, time.Now())
+}
+
+// Cleanup revokes the access token that has been created by Generate.
+func (g *Generator) Cleanup(ctx context.Context, jsonSpec *apiextensions.JSON, previousState genv1alpha1.GeneratorProviderState, kube client.Client, namespace string) error {
+	if jsonSpec == nil {
+		return errors.New(errNoSpec)
+	}
+	if previousState == nil {
+		return errors.New(errNoState)
+	}
+	res, err := parseSpec(jsonSpec.Raw)
+	if err != nil {
+		return fmt.Errorf(errParseSpec, err)
+	}
+	var state genv1alpha1.GitlabAccessTokenState
+	if err := json.Unmarshal(previousState.Raw, &state); err != nil {
+		return fmt.Errorf(errParseState, err)
+	}
+	gl, err := newClient(ctx, &res.Spec, kube, namespace)
+	if err != nil {
+		return err
+	}
+
+	switch state.Type {
+	case genv1alpha1.GitlabAccessTokenTypeProject:
+		_, err = gl.ProjectAccessTokens.RevokeProjectAccessToken(state.ProjectID, state.ID, gitlab.WithContext(ctx))
+	case genv1alpha1.GitlabAccessTokenTypeGroup:
+		_, err = gl.GroupAccessTokens.RevokeGroupAccessToken(state.GroupID, state.ID, gitlab.WithContext(ctx))
+	case genv1alpha1.GitlabAccessTokenTypePersonal:
+		_, err = gl.PersonalAccessTokens.RevokePersonalAccessTokenByID(state.ID, gitlab.WithContext(ctx))
+	default:
+		return fmt.Errorf(errUnknownType, state.Type)
+	}
+	// the token may have been revoked manually or expired already
+	if err != nil && !errors.Is(err, gitlab.ErrNotFound) {
+		return fmt.Errorf(errRevokeToken, state.Type, state.ID, err)
+	}
+	return nil
+}
+
+// RenewalTime returns the time at which RenewBeforePercentage of the lifetime of the token is left.
+func (g *Generator) RenewalTime(jsonSpec *apiextensions.JSON, values map[string][]byte) (time.Time, error) {
+	if jsonSpec == nil {
+		return time.Time{}, errors.New(errNoSpec)
+	}
+	res, err := parseSpec(jsonSpec.Raw)
+	if err != nil {
+		return time.Time{}, fmt.Errorf(errParseSpec, err)
+	}
+	expiry, err := time.Parse(time.RFC3339, string(values[keyExpiry]))
+	if err != nil {
+		return time.Time{}, fmt.Errorf(errParseExpiry, err)
+	}
+	return renewal.Time(expiry, expiresAfter(&res.Spec), res.Spec.RenewBeforePercentage, renewal.DefaultPercentage), nil
+}
+
+func (g *Generator) generate(
+	ctx context.Context,
+	jsonSpec *apiextensions.JSON,
+	kube client.Client,
+	namespace string,
+	now time.Time) (map[string][]byte, genv1alpha1.GeneratorProviderState, error) {
+	if jsonSpec == nil {
+		return nil, nil, errors.New(errNoSpec)
+	}
+	res, err := parseSpec(jsonSpec.Raw)
+	if err != nil {
+		return nil, nil, fmt.Errorf(errParseSpec, err)
+	}
+	if err := validateSpec(&res.Spec); err != nil {
+		return nil, nil, err
+	}
+	gl, err := newClient(ctx, &res.Spec, kube, namespace)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	tkn, err := createToken(ctx, gl, &res.Spec, expiresAt(&res.Spec, now))
+	if err != nil {
+		return nil, nil, fmt.Errorf(errCreateToken, res.Spec.Type, err)
+	}
+	rawState, err := json.Marshal(&genv1alpha1.GitlabAccessTokenState{
+		ID:        tkn.ID,
+		Type:      res.Spec.Type,
+		ProjectID: res.Spec.ProjectID,
+		GroupID:   res.Spec.GroupID,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	values := map[string][]byte{
+		keyToken: []byte(tkn.Token),
+		keyID:    []byte(strconv.Itoa(tkn.ID)),
+	}
+	if tkn.ExpiresAt != nil {
+		values[keyExpiry] = []byte(time.Time(*tkn.ExpiresAt).UTC().Format(time.RFC3339))
+	}
+	return values, &apiextensions.JSON{Raw: rawState}, nil
+}
+
+func createToken(ctx context.Context, gl *gitlab.Client, spec *genv1alpha1.GitlabAccessTokenSpec, expiry gitlab.ISOTime) (*token, error) {
+	name := spec.Name
+	if name == "" {
+		name = defaultName
+	}
+	var description *string
+	if spec.Description != "" {
+		description = &spec.Description
+	}
+	accessLevel := gitlab.MaintainerPermissions
+	if spec.AccessLevel != "" {
+		accessLevel = accessLevels[spec.AccessLevel]
+	}
+
+	switch spec.Type {
+	case genv1alpha1.GitlabAccessTokenTypeProject:
+		t, _, err := gl.ProjectAccessTokens.CreateProjectAccessToken(spec.ProjectID, &gitlab.CreateProjectAccessTokenOptions{
+			Name:        &name,
+			Description: description,
+			Scopes:      &spec.Scopes,
+			AccessLevel: &accessLevel,
+			ExpiresAt:   &expiry,
+		}, gitlab.WithContext(ctx))
+		if err != nil {
+			return nil, err
+		}
+		return &token{ID: t.ID, Token: t.Token, ExpiresAt: t.ExpiresAt}, nil
+	case genv1alpha1.GitlabAccessTokenTypeGroup:
+		t, _, err := gl.GroupAccessTokens.CreateGroupAccessToken(spec.GroupID, &gitlab.CreateGroupAccessTokenOptions{
+			Name:        &name,
+			Description: description,
+			Scopes:      &spec.Scopes,
+			AccessLevel: &accessLevel,
+			ExpiresAt:   &expiry,
+		}, gitlab.WithContext(ctx))
+		if err != nil {
+			return nil, err
+		}
+		return &token{ID: t.ID, Token: t.Token, ExpiresAt: t.ExpiresAt}, nil
+	case genv1alpha1.GitlabAccessTokenTypePersonal:
+		t, _, err := gl.Users.CreatePersonalAccessToken(*spec.UserID, &gitlab.CreatePersonalAccessTokenOptions{
+			Name:        &name,
+			Description: description,
+			Scopes:      &spec.Scopes,
+			ExpiresAt:   &expiry,
+		}, gitlab.WithContext(ctx))
+		if err != nil {
+			return nil, err
+		}
+		return &token{ID: t.ID, Token: t.Token, ExpiresAt: t.ExpiresAt}, nil
+	default:
+		return nil, fmt.Errorf(errUnknownType, spec.Type)
+	}
+}
+
+func validateSpec(spec *genv1alpha1.GitlabAccessTokenSpec) error {
+	switch spec.Type {
+	case genv1alpha1.GitlabAccessTokenTypeProject:
+		if spec.ProjectID == "" {
+			return fmt.Errorf(errMissingOwner, "projectID", spec.Type)
+		}
+	case genv1alpha1.GitlabAccessTokenTypeGroup:
+		if spec.GroupID == "" {
+			return fmt.Errorf(errMissingOwner, "groupID", spec.Type)
+		}
+	case genv1alpha1.GitlabAccessTokenTypePersonal:
+		if spec.UserID == nil {
+			return fmt.Errorf(errMissingOwner, "userID", spec.Type)
+		}
+	default:
+		return fmt.Errorf(errUnknownType, spec.Type)
+	}
+	if expiresAfter(spec) < day {
+		return errors.New(errShortLifetime)
+	}
+	return nil
+}
+
+func expiresAfter(spec *genv1alpha1.GitlabAccessTokenSpec) time.Duration {
+	if spec.ExpiresAfter == nil {
+		return defaultExpiresAfter
+	}
+	return spec.ExpiresAfter.Duration
+}
+
+// expiresAt returns the day on which the token expires, which is
+// rounded up as GitLab expires tokens at the start of the day in UTC.
+func expiresAt(spec *genv1alpha1.GitlabAccessTokenSpec, now time.Time) gitlab.ISOTime {
+	expiry := now.UTC().Add(expiresAfter(spec))
+	start := expiry.Truncate(day)
+	if start.Before(expiry) {
+		start = start.Add(day)
+	}
+	return gitlab.ISOTime(start)
+}
+
+func newClient(ctx context.Context, spec *genv1alpha1.GitlabAccessTokenSpec, kube client.Client, namespace string) (*gitlab.Client, error) {
+	accessToken, err := resolvers.SecretKeyRef(ctx, kube, resolvers.EmptyStoreKind, namespace, &esmeta.SecretKeySelector{
+		Namespace: &namespace,
+		Name:      spec.Auth.AccessToken.Name,
+		Key:       spec.Auth.AccessToken.Key,
+	})
+	if err != nil {
+		return nil, fmt.Errorf(errGetAuth, err)
+	}
+	gl, err := gitlabprovider.NewAPIClient(ctx, &esv1.GitlabProvider{
+		URL:        spec.URL,
+		CABundle:   spec.CABundle,
+		CAProvider: spec.CAProvider,
+	}, accessToken, kube, resolvers.EmptyStoreKind, namespace)
+	if err != nil {
+		return nil, fmt.Errorf(errNewClient, err)
+	}
+	return gl, nil
+}
+
+func parseSpec(data []byte) (*genv1alpha1.GitlabAccessToken, error) {
+	var spec genv1alpha1.GitlabAccessToken
+	err := yaml.Unmarshal(data, &spec)
+	return &spec, err
+}
+
+func init() {
+	genv1alpha1.Register(genv1alpha1.GitlabAccessTokenKind, &Generator{})
+}
@@ -0,0 +1,259 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gitlab
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	apiextensions "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	genv1alpha1 "github.com/external-secrets/external-secrets/apis/generators/v1alpha1"
+)
+
+var now = time.Date(2025, 3, 10, 15, 4, 5, 0, time.UTC)
+
+type request struct {
+	Method string
+	Path   string
+	Body   map[string]any
+}
+
+// newServer returns a GitLab API stub that records the requests
+// and answers them with the given status and body.
+func newServer(t *testing.T, status int, body string) (*httptest.Server, *[]request) {
+	t.Helper()
+	var requests []request
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		req := request{Method: r.Method, Path: r.URL.EscapedPath()}
+		raw, _ := io.ReadAll(r.Body)
+		if len(raw) > 0 {
+			require.NoError(t, json.Unmarshal(raw, &req.Body))
+		}
+		requests = append(requests, req)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(status)
+		_, _ = w.Write([]byte(body))
+	}))
+	t.Cleanup(srv.Close)
+	return srv, &requests
+}
+
+func newKube() client.Client {
+	return fake.NewClientBuilder().WithObjects(&corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "gitlab", Namespace: "default"},
+		Data:       map[string][]byte{"token": []byte("glpat-admin")},
+	}).Build()
+}
+
+func spec(url, s string) *apiextensions.JSON {
+	return &apiextensions.JSON{Raw: []byte(`{"spec":{"url":"` + url + `","auth":{"accessToken":{"name":"gitlab","key":"token"}},` + s + `}}`)}
+}
+
+func TestGenerate(t *testing.T) {
+	tests := []struct {
+		name      string
+		spec      string
+		wantPath  string
+		wantBody  map[string]any
+		wantState genv1alpha1.GitlabAccessTokenState
+	}{
+		{
+			name:     "project token",
+			spec:     `"type":"project","projectID":"group/app","scopes":["read_registry"],"accessLevel":"reporter"`,
+			wantPath: "/api/v4/projects/group%2Fapp/access_tokens",
+			wantBody: map[string]any{
+				"name":         "external-secrets",
+				"scopes":       []any{"read_registry"},
+				"access_level": float64(20),
+				"expires_at":   "2025-04-10",
+			},
+			wantState: genv1alpha1.GitlabAccessTokenState{ID: 42, Type: "project", ProjectID: "group/app"},
+		},
+		{
+			name:     "group token",
+			spec:     `"type":"group","groupID":"7","name":"ci","description":"deploys","scopes":["api"],"expiresAfter":"36h"`,
+			wantPath: "/api/v4/groups/7/access_tokens",
+			wantBody: map[string]any{
+				"name":         "ci",
+				"description":  "deploys",
+				"scopes":       []any{"api"},
+				"access_level": float64(40),
+				"expires_at":   "2025-03-13",
+			},
+			wantState: genv1alpha1.GitlabAccessTokenState{ID: 42, Type: "group", GroupID: "7"},
+		},
+		{
+			name:     "personal token",
+			spec:     `"type":"personal","userID":3,"scopes":["read_api"],"expiresAfter":"48h"`,
+			wantPath: "/api/v4/users/3/personal_access_tokens",
+			wantBody: map[string]any{
+				"name":       "external-secrets",
+				"scopes":     []any{"read_api"},
+				"expires_at": "2025-03-13",
+			},
+			wantState: genv1alpha1.GitlabAccessTokenState{ID: 42, Type: "personal"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv, requests := newServer(t, http.StatusCreated, `{"id":42,"token":"glpat-new","expires_at":"2025-04-10"}`)
+			g := &Generator{}
+			values, rawState, err := g.generate(context.Background(), spec(srv.URL, tt.spec), newKube(), "default", now)
+			require.NoError(t, err)
+
+			require.Len(t, *requests, 1)
+			assert.Equal(t, request{Method: http.MethodPost, Path: tt.wantPath, Body: tt.wantBody}, (*requests)[0])
+			assert.Equal(t, map[string][]byte{
+				"token":  []byte("glpat-new"),
+				"id":     []byte("42"),
+				"expiry": []byte("2025-04-10T00:00:00Z"),
+			}, values)
+			var state genv1alpha1.GitlabAccessTokenState
+			require.NoError(t, json.Unmarshal(rawState.Raw, &state))
+			assert.Equal(t, tt.wantState, state)
+		})
+	}
+}
+
+func TestGenerateErrors(t *testing.T) {
+	tests := []struct {
+		name        string
+		spec        string
+		status      int
+		expectedErr string
+	}{
+		{
+			name:        "missing project",
+			spec:        `"type":"project","scopes":["api"]`,
+			expectedErr: "projectID is required for project access tokens",
+		},
+		{
+			name:        "missing user",
+			spec:        `"type":"personal","scopes":["api"]`,
+			expectedErr: "userID is required for personal access tokens",
+		},
+		{
+			name:        "short lifetime",
+			spec:        `"type":"group","groupID":"7","scopes":["api"],"expiresAfter":"1h"`,
+			expectedErr: errShortLifetime,
+		},
+		{
+			name:        "api error",
+			spec:        `"type":"group","groupID":"7","scopes":["api"]`,
+			status:      http.StatusForbidden,
+			expectedErr: "unable to create group access token",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv, _ := newServer(t, tt.status, `{"message":"403 Forbidden"}`)
+			g := &Generator{}
+			_, _, err := g.generate(context.Background(), spec(srv.URL, tt.spec), newKube(), "default", now)
+			require.ErrorContains(t, err, tt.expectedErr)
+		})
+	}
+}
+
+func TestCleanup(t *testing.T) {
+	tests := []struct {
+		name        string
+		state       string
+		status      int
+		wantPath    string
+		expectedErr string
+	}{
+		{
+			name:     "project token",
+			state:    `{"id":42,"type":"project","projectID":"group/app"}`,
+			status:   http.StatusNoContent,
+			wantPath: "/api/v4/projects/group%2Fapp/access_tokens/42",
+		},
+		{
+			name:     "group token",
+			state:    `{"id":42,"type":"group","groupID":"7"}`,
+			status:   http.StatusNoContent,
+			wantPath: "/api/v4/groups/7/access_tokens/42",
+		},
+		{
+			name:     "personal token that is already revoked",
+			state:    `{"id":42,"type":"personal"}`,
+			status:   http.StatusNotFound,
+			wantPath: "/api/v4/personal_access_tokens/42",
+		},
+		{
+			name:        "api error",
+			state:       `{"id":42,"type":"personal"}`,
+			status:      http.StatusForbidden,
+			wantPath:    "/api/v4/personal_access_tokens/42",
+			expectedErr: "unable to revoke personal access token 42",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv, requests := newServer(t, tt.status, `{}`)
+			g := &Generator{}
+			err := g.Cleanup(context.Background(), spec(srv.URL, `"type":"project","scopes":["api"]`), &apiextensions.JSON{Raw: []byte(tt.state)}, newKube(), "default")
+			if tt.expectedErr != "" {
+				require.ErrorContains(t, err, tt.expectedErr)
+			} else {
+				require.NoError(t, err)
+			}
+			require.Len(t, *requests, 1)
+			assert.Equal(t, http.MethodDelete, (*requests)[0].Method)
+			assert.Equal(t, tt.wantPath, (*requests)[0].Path)
+		})
+	}
+}
+
+func TestRenewalTime(t *testing.T) {
+	tests := []struct {
+		name string
+		spec string
+		want time.Time
+	}{
+		{
+			name: "default percentage",
+			spec: `"type":"group","groupID":"7","scopes":["api"],"expiresAfter":"240h"`,
+			want: time.Date(2025, 4, 8, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "custom percentage",
+			spec: `"type":"group","groupID":"7","scopes":["api"],"expiresAfter":"240h","renewBeforePercentage":50`,
+			want: time.Date(2025, 4, 5, 0, 0, 0, 0, time.UTC),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := &Generator{}
+			renewal, err := g.RenewalTime(spec("", tt.spec), map[string][]byte{
+				"expiry": []byte("2025-04-10T00:00:00Z"),
+			})
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, renewal)
+		})
+	}
+}
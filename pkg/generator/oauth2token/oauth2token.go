@@ -0,0 +1,303 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package oauth2token
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+	"golang.org/x/oauth2"
+	"golang.org/x/oauth2/clientcredentials"
+	apiextensions "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+
+	genv1alpha1 "github.com/external-secrets/external-secrets/apis/generators/v1alpha1"
+	esmeta "github.com/external-secrets/external-secrets/apis/meta/v1"
+	"github.com/external-secrets/external-secrets/pkg/generator/renewal"
+	"github.com/external-secrets/external-secrets/pkg/tracing"
+	"github.com/external-secrets/external-secrets/pkg/utils"
+	"github.com/external-secrets/external-secrets/pkg/utils/resolvers"
+)
+
+type Generator struct{}
+
+const (
+	clientAssertionType = "urn:ietf:params:oauth:client-assertion-type:jwt-bearer"
+	assertionLifetime   = 5 * time.Minute
+	httpClientTimeout   = 30 * time.Second
+
+	keyAccessToken = "access_token"
+	keyTokenType   = "token_type"
+	keyExpiresIn   = "expires_in"
+	keyExpiresAt   = "expires_at"
+
+	errNoSpec         = "no config spec provided"
+	errParseSpec      = "unable to parse spec: %w"
+	errNoAuth         = "no client authentication configured"
+	errGetSecret      = "unable to get %s: %w"
+	errParseKey       = "unable to parse private key: must be a PEM encoded RSA, ECDSA or Ed25519 key"
+	errSignAssertion  = "unable to sign client assertion: %w"
+	errLoadClientCert = "unable to load client certificate: %w"
+	errFetchCA        = "unable to fetch ca bundle: %w"
+	errAppendCA       = "unable to append ca bundle"
+	errGetToken       = "unable to get token: %w"
+	errParseExpiry    = "unable to parse expiry: %w"
+)
+
+func (g *Generator) Generate(ctx context.Context, jsonSpec *apiextensions.JSON, kube client.Client, namespace string) (map[string][]byte, genv1alpha1.GeneratorProviderState, error) {
+	return g.generate(ctx, jsonSpec, kube, namespace, time.Now())
+}
+
+func (g *Generator) Cleanup(_ context.Context, _ *apiextensions.JSON, _ genv1alpha1.GeneratorProviderState, _ client.Client, _ string) error {
+	return nil
+}
+
+// RenewalTime returns the time at which RenewBeforePercentage of the lifetime of the token is left.
+// Tokens without an expiry are not renewed.
+func (g *Generator) RenewalTime(jsonSpec *apiextensions.JSON, values map[string][]byte) (time.Time, error) {
+	if jsonSpec == nil {
+		return time.Time{}, errors.New(errNoSpec)
+	}
+	res, err := parseSpec(jsonSpec.Raw)
+	if err != nil {
+		return time.Time{}, fmt.Errorf(errParseSpec, err)
+	}
+	if len(values[keyExpiresAt]) == 0 {
+		return time.Time{}, nil
+	}
+	expiry, err := time.Parse(time.RFC3339, string(values[keyExpiresAt]))
+	if err != nil {
+		return time.Time{}, fmt.Errorf(errParseExpiry, err)
+	}
+	expiresIn, err := strconv.ParseInt(string(values[keyExpiresIn]), 10, 64)
+	if err != nil {
+		return time.Time{}, fmt.Errorf(errParseExpiry, err)
+	}
+	lifetime := time.Duration(expiresIn) * time.Second
+	return renewal.Time(expiry, lifetime, res.Spec.RenewBeforePercentage, renewal.DefaultPercentage), nil
+}
+
+func (g *Generator) generate(ctx context.Context, jsonSpec *apiextensions.JSON, kube client.Client, namespace string, now time.Time) (map[string][]byte, genv1alpha1.GeneratorProviderState, error) {
+	if jsonSpec == nil {
+		return nil, nil, errors.New(errNoSpec)
+	}
+	res, err := parseSpec(jsonSpec.Raw)
+	if err != nil {
+		return nil, nil, fmt.Errorf(errParseSpec, err)
+	}
+	spec := &res.Spec
+
+	cfg := &clientcredentials.Config{
+		ClientID:       spec.ClientID,
+		TokenURL:       spec.TokenURL,
+		Scopes:         spec.Scopes,
+		EndpointParams: url.Values{},
+		AuthStyle:      oauth2.AuthStyleInParams,
+	}
+	for k, v := range spec.Parameters {
+		cfg.EndpointParams.Set(k, v)
+	}
+	if spec.Audience != "" {
+		cfg.EndpointParams.Set("audience", spec.Audience)
+	}
+	tlsConfig := &tls.Config{
+		MinVersion: tls.VersionTLS12,
+	}
+	if err := configureAuth(ctx, spec, kube, namespace, now, cfg, tlsConfig); err != nil {
+		return nil, nil, err
+	}
+	hc, err := g.client(ctx, spec, kube, namespace, tlsConfig)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	tkn, err := cfg.Token(context.WithValue(ctx, oauth2.HTTPClient, hc))
+	if err != nil {
+		return nil, nil, fmt.Errorf(errGetToken, err)
+	}
+	values := map[string][]byte{
+		keyAccessToken: []byte(tkn.AccessToken),
+		keyTokenType:   []byte(tkn.Type()),
+	}
+	if !tkn.Expiry.IsZero() {
+		expiresIn := tkn.ExpiresIn
+		if expiresIn == 0 {
+			expiresIn = int64(tkn.Expiry.Sub(now).Round(time.Second) / time.Second)
+		}
+		values[keyExpiresIn] = []byte(strconv.FormatInt(expiresIn, 10))
+		values[keyExpiresAt] = []byte(tkn.Expiry.UTC().Format(time.RFC3339))
+	}
+	return values, nil, nil
+}
+
+// configureAuth sets up the client authentication method of the spec.
+func configureAuth(ctx context.Context, spec *genv1alpha1.OAuth2TokenSpec, kube client.Client, namespace string, now time.Time, cfg *clientcredentials.Config, tlsConfig *tls.Config) error {
+	auth := spec.Auth
+	switch {
+	case auth.ClientSecretBasic != nil:
+		secret, err := secretKeyRef(ctx, kube, namespace, auth.ClientSecretBasic, "client secret")
+		if err != nil {
+			return err
+		}
+		cfg.ClientSecret = secret
+		cfg.AuthStyle = oauth2.AuthStyleInHeader
+	case auth.ClientSecretPost != nil:
+		secret, err := secretKeyRef(ctx, kube, namespace, auth.ClientSecretPost, "client secret")
+		if err != nil {
+			return err
+		}
+		cfg.ClientSecret = secret
+	case auth.PrivateKeyJWT != nil:
+		pemKey, err := secretKeyRef(ctx, kube, namespace, &auth.PrivateKeyJWT.PrivateKey, "private key")
+		if err != nil {
+			return err
+		}
+		assertion, err := clientAssertion(spec, []byte(pemKey), now)
+		if err != nil {
+			return err
+		}
+		cfg.EndpointParams.Set("client_assertion_type", clientAssertionType)
+		cfg.EndpointParams.Set("client_assertion", assertion)
+	case auth.TLSClientAuth != nil:
+		cert, err := secretKeyRef(ctx, kube, namespace, &auth.TLSClientAuth.Certificate, "client certificate")
+		if err != nil {
+			return err
+		}
+		key, err := secretKeyRef(ctx, kube, namespace, &auth.TLSClientAuth.PrivateKey, "client certificate key")
+		if err != nil {
+			return err
+		}
+		clientCert, err := tls.X509KeyPair([]byte(cert), []byte(key))
+		if err != nil {
+			return fmt.Errorf(errLoadClientCert, err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{clientCert}
+	default:
+		return errors.New(errNoAuth)
+	}
+	return nil
+}
+
+// clientAssertion returns a JWT that authenticates the client as described in RFC 7523.
+func clientAssertion(spec *genv1alpha1.OAuth2TokenSpec, pemKey []byte, now time.Time) (string, error) {
+	key, method, err := parsePrivateKey(pemKey)
+	if err != nil {
+		return "", err
+	}
+	audience := spec.Auth.PrivateKeyJWT.Audience
+	if audience == "" {
+		audience = spec.TokenURL
+	}
+	token := jwt.NewWithClaims(method, jwt.RegisteredClaims{
+		Issuer:    spec.ClientID,
+		Subject:   spec.ClientID,
+		Audience:  jwt.ClaimStrings{audience},
+		ID:        uuid.NewString(),
+		IssuedAt:  jwt.NewNumericDate(now),
+		ExpiresAt: jwt.NewNumericDate(now.Add(assertionLifetime)),
+	})
+	if kid := spec.Auth.PrivateKeyJWT.KeyID; kid != "" {
+		token.Header["kid"] = kid
+	}
+	signed, err := token.SignedString(key)
+	if err != nil {
+		return "", fmt.Errorf(errSignAssertion, err)
+	}
+	return signed, nil
+}
+
+// parsePrivateKey returns the key and the matching signing method.
+func parsePrivateKey(pemKey []byte) (any, jwt.SigningMethod, error) {
+	if key, err := jwt.ParseRSAPrivateKeyFromPEM(pemKey); err == nil {
+		return key, jwt.SigningMethodRS256, nil
+	}
+	if key, err := jwt.ParseECPrivateKeyFromPEM(pemKey); err == nil {
+		switch key.Curve.Params().BitSize {
+		case 384:
+			return key, jwt.SigningMethodES384, nil
+		case 521:
+			return key, jwt.SigningMethodES512, nil
+		default:
+			return key, jwt.SigningMethodES256, nil
+		}
+	}
+	if key, err := jwt.ParseEdPrivateKeyFromPEM(pemKey); err == nil {
+		return key, jwt.SigningMethodEdDSA, nil
+	}
+	return nil, nil, errors.New(errParseKey)
+}
+
+func (g *Generator) client(ctx context.Context, spec *genv1alpha1.OAuth2TokenSpec, kube client.Client, namespace string, tlsConfig *tls.Config) (*http.Client, error) {
+	if len(spec.CABundle) > 0 || spec.CAProvider != nil {
+		ca, err := utils.FetchCACertFromSource(ctx, utils.CreateCertOpts{
+			CABundle:   spec.CABundle,
+			CAProvider: spec.CAProvider,
+			StoreKind:  resolvers.EmptyStoreKind,
+			Namespace:  namespace,
+			Client:     kube,
+		})
+		if err != nil {
+			return nil, fmt.Errorf(errFetchCA, err)
+		}
+		pool := x509.NewCertPool()
+		if ok := pool.AppendCertsFromPEM(ca); !ok {
+			return nil, errors.New(errAppendCA)
+		}
+		tlsConfig.RootCAs = pool
+	}
+	// the transport is used for a single token request,
+	// so connections are not kept alive after it.
+	return &http.Client{
+		Transport: tracing.Transport(&http.Transport{
+			Proxy:             http.ProxyFromEnvironment,
+			TLSClientConfig:   tlsConfig,
+			ForceAttemptHTTP2: true,
+			DisableKeepAlives: true,
+		}),
+		Timeout: httpClientTimeout,
+	}, nil
+}
+
+func secretKeyRef(ctx context.Context, kube client.Client, namespace string, ref *genv1alpha1.SecretKeySelector, what string) (string, error) {
+	value, err := resolvers.SecretKeyRef(ctx, kube, resolvers.EmptyStoreKind, namespace, &esmeta.SecretKeySelector{
+		Namespace: &namespace,
+		Name:      ref.Name,
+		Key:       ref.Key,
+	})
+	if err != nil {
+		return "", fmt.Errorf(errGetSecret, what, err)
+	}
+	return value, nil
+}
+
+func parseSpec(data []byte) (*genv1alpha1.OAuth2Token, error) {
+	var spec genv1alpha1.OAuth2Token
+	err := yaml.Unmarshal(data, &spec)
+	return &spec, err
+}
+
+func init() {
+	genv1alpha1.Register(genv1alpha1.OAuth2TokenKind, &Generator{})
+}
@@ -0,0 +1,265 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package oauth2token
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	apiextensions "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	genv1alpha1 "github.com/external-secrets/external-secrets/apis/generators/v1alpha1"
+)
+
+// tokenRequest is what the authorization server stub has received.
+type tokenRequest struct {
+	form       url.Values
+	basicUser  string
+	basicPass  string
+	clientCert *x509.Certificate
+}
+
+// newServer returns an authorization server stub that requests client certificates
+// and answers with the given status and body.
+func newServer(t *testing.T, status int, body string) (*httptest.Server, *tokenRequest) {
+	t.Helper()
+	received := &tokenRequest{}
+	srv := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		require.NoError(t, r.ParseForm())
+		received.form = r.PostForm
+		received.basicUser, received.basicPass, _ = r.BasicAuth()
+		if len(r.TLS.PeerCertificates) > 0 {
+			received.clientCert = r.TLS.PeerCertificates[0]
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(status)
+		_, _ = w.Write([]byte(body))
+	}))
+	srv.TLS = &tls.Config{ClientAuth: tls.RequestClientCert}
+	srv.StartTLS()
+	t.Cleanup(srv.Close)
+	return srv, received
+}
+
+func serverCA(srv *httptest.Server) string {
+	return base64.StdEncoding.EncodeToString(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: srv.Certificate().Raw}))
+}
+
+// clientCertificate returns a self signed certificate and its key in PEM.
+func clientCertificate(t *testing.T, key *ecdsa.PrivateKey) ([]byte, []byte) {
+	t.Helper()
+	tpl := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "my-client"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tpl, tpl, &key.PublicKey, key)
+	require.NoError(t, err)
+	keyDER, err := x509.MarshalPKCS8PrivateKey(key)
+	require.NoError(t, err)
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+		pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: keyDER})
+}
+
+func TestGenerate(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P384(), rand.Reader)
+	require.NoError(t, err)
+	certPEM, keyPEM := clientCertificate(t, key)
+	kube := fake.NewClientBuilder().WithObjects(&corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "client", Namespace: "default"},
+		Data: map[string][]byte{
+			"secret":  []byte("s3cret"),
+			"tls.crt": certPEM,
+			"tls.key": keyPEM,
+		},
+	}).Build()
+	now := time.Now()
+
+	tests := []struct {
+		name     string
+		auth     string
+		validate func(t *testing.T, srv *httptest.Server, req *tokenRequest)
+	}{
+		{
+			name: "client secret basic",
+			auth: `{"clientSecretBasic":{"name":"client","key":"secret"}}`,
+			validate: func(t *testing.T, _ *httptest.Server, req *tokenRequest) {
+				assert.Equal(t, "my-client", req.basicUser)
+				assert.Equal(t, "s3cret", req.basicPass)
+				assert.Empty(t, req.form.Get("client_secret"))
+			},
+		},
+		{
+			name: "client secret post",
+			auth: `{"clientSecretPost":{"name":"client","key":"secret"}}`,
+			validate: func(t *testing.T, _ *httptest.Server, req *tokenRequest) {
+				assert.Empty(t, req.basicUser)
+				assert.Equal(t, "my-client", req.form.Get("client_id"))
+				assert.Equal(t, "s3cret", req.form.Get("client_secret"))
+			},
+		},
+		{
+			name: "private key jwt",
+			auth: `{"privateKeyJWT":{"privateKey":{"name":"client","key":"tls.key"},"keyID":"key-1"}}`,
+			validate: func(t *testing.T, srv *httptest.Server, req *tokenRequest) {
+				assert.Equal(t, "my-client", req.form.Get("client_id"))
+				assert.Equal(t, clientAssertionType, req.form.Get("client_assertion_type"))
+				claims := jwt.RegisteredClaims{}
+				token, err := jwt.ParseWithClaims(req.form.Get("client_assertion"), &claims, func(*jwt.Token) (any, error) {
+					return &key.PublicKey, nil
+				}, jwt.WithValidMethods([]string{"ES384"}), jwt.WithAudience(srv.URL+"/token"))
+				require.NoError(t, err)
+				assert.Equal(t, "key-1", token.Header["kid"])
+				assert.Equal(t, "my-client", claims.Issuer)
+				assert.Equal(t, "my-client", claims.Subject)
+				assert.NotEmpty(t, claims.ID)
+			},
+		},
+		{
+			name: "tls client auth",
+			auth: `{"tlsClientAuth":{"certificate":{"name":"client","key":"tls.crt"},"privateKey":{"name":"client","key":"tls.key"}}}`,
+			validate: func(t *testing.T, _ *httptest.Server, req *tokenRequest) {
+				require.NotNil(t, req.clientCert)
+				assert.Equal(t, "my-client", req.clientCert.Subject.CommonName)
+				assert.Equal(t, "my-client", req.form.Get("client_id"))
+				assert.Empty(t, req.form.Get("client_secret"))
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv, req := newServer(t, http.StatusOK, `{"access_token":"at","token_type":"Bearer","expires_in":3600}`)
+			jsonSpec := &apiextensions.JSON{Raw: []byte(fmt.Sprintf(`{"spec":{
+				"tokenURL":%q,
+				"clientID":"my-client",
+				"auth":%s,
+				"scopes":["read","write"],
+				"audience":"https://api.example.com",
+				"parameters":{"resource":"urn:api"},
+				"caBundle":%q
+			}}`, srv.URL+"/token", tt.auth, serverCA(srv)))}
+
+			g := &Generator{}
+			values, state, err := g.generate(context.Background(), jsonSpec, kube, "default", now)
+			require.NoError(t, err)
+			assert.Nil(t, state)
+
+			assert.Equal(t, "client_credentials", req.form.Get("grant_type"))
+			assert.Equal(t, "read write", req.form.Get("scope"))
+			assert.Equal(t, "https://api.example.com", req.form.Get("audience"))
+			assert.Equal(t, "urn:api", req.form.Get("resource"))
+			tt.validate(t, srv, req)
+
+			assert.Equal(t, "at", string(values["access_token"]))
+			assert.Equal(t, "Bearer", string(values["token_type"]))
+			assert.Equal(t, "3600", string(values["expires_in"]))
+			expiry, err := time.Parse(time.RFC3339, string(values["expires_at"]))
+			require.NoError(t, err)
+			assert.WithinDuration(t, now.Add(time.Hour), expiry, 5*time.Second)
+		})
+	}
+}
+
+func TestGenerateErrors(t *testing.T) {
+	kube := fake.NewClientBuilder().WithObjects(&corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "client", Namespace: "default"},
+		Data:       map[string][]byte{"secret": []byte("s3cret")},
+	}).Build()
+	srv, _ := newServer(t, http.StatusUnauthorized, `{"error":"invalid_client"}`)
+
+	tests := []struct {
+		name        string
+		auth        string
+		expectedErr string
+	}{
+		{
+			name:        "invalid client",
+			auth:        `{"clientSecretPost":{"name":"client","key":"secret"}}`,
+			expectedErr: "invalid_client",
+		},
+		{
+			name:        "no auth",
+			auth:        `{}`,
+			expectedErr: errNoAuth,
+		},
+		{
+			name:        "missing secret",
+			auth:        `{"clientSecretBasic":{"name":"missing","key":"secret"}}`,
+			expectedErr: "unable to get client secret",
+		},
+		{
+			name:        "invalid private key",
+			auth:        `{"privateKeyJWT":{"privateKey":{"name":"client","key":"secret"}}}`,
+			expectedErr: errParseKey,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			jsonSpec := &apiextensions.JSON{Raw: []byte(fmt.Sprintf(`{"spec":{"tokenURL":%q,"clientID":"my-client","auth":%s,"caBundle":%q}}`, srv.URL, tt.auth, serverCA(srv)))}
+			g := &Generator{}
+			_, _, err := g.generate(context.Background(), jsonSpec, kube, "default", time.Now())
+			require.ErrorContains(t, err, tt.expectedErr)
+		})
+	}
+}
+
+func TestRenewalTime(t *testing.T) {
+	g := &Generator{}
+	jsonSpec := &apiextensions.JSON{Raw: []byte(`{"spec":{"renewBeforePercentage":50}}`)}
+	renewal, err := g.RenewalTime(jsonSpec, map[string][]byte{
+		"expires_at": []byte("2025-01-01T10:00:00Z"),
+		"expires_in": []byte(strconv.Itoa(3600)),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, time.Date(2025, 1, 1, 9, 30, 0, 0, time.UTC), renewal)
+
+	// tokens without expiry are not renewed
+	renewal, err = g.RenewalTime(jsonSpec, map[string][]byte{"access_token": []byte("at")})
+	require.NoError(t, err)
+	assert.True(t, renewal.IsZero())
+}
+
+func TestClientDoesNotKeepConnections(t *testing.T) {
+	g := &Generator{}
+	hc, err := g.client(context.Background(), &genv1alpha1.OAuth2TokenSpec{}, nil, "default", &tls.Config{MinVersion: tls.VersionTLS12})
+	require.NoError(t, err)
+	transport, ok := hc.Transport.(*http.Transport)
+	require.True(t, ok)
+	assert.True(t, transport.DisableKeepAlives)
+}
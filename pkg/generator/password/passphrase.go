@@ -0,0 +1,77 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package password
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/sethvargo/go-diceware/diceware"
+
+	genv1alpha1 "github.com/external-secrets/external-secrets/apis/generators/v1alpha1"
+)
+
+const (
+	defaultPassphraseWords     = 6
+	defaultPassphraseSeparator = "-"
+	maxPassphraseWords         = 100
+
+	errPassphraseWords = "the number of passphrase words must be between 1 and 100"
+)
+
+// generatePassphrase picks unique words from one of the
+// embedded diceware word lists and joins them.
+func generatePassphrase(spec *genv1alpha1.PassphraseSpec) (string, error) {
+	words := defaultPassphraseWords
+	if spec.Words != 0 {
+		words = spec.Words
+	}
+	if words < 1 || words > maxPassphraseWords {
+		return "", errors.New(errPassphraseWords)
+	}
+	separator := defaultPassphraseSeparator
+	if spec.Separator != nil {
+		separator = *spec.Separator
+	}
+	wordList := diceware.WordListEffLarge()
+	if spec.WordList == genv1alpha1.PassphraseWordListEFFShort {
+		wordList = diceware.WordListEffSmall()
+	}
+	list, err := diceware.GenerateWithWordList(words, wordList)
+	if err != nil {
+		return "", err
+	}
+	if spec.Capitalize {
+		for i, w := range list {
+			r, size := utf8.DecodeRuneInString(w)
+			list[i] = string(unicode.ToUpper(r)) + w[size:]
+		}
+	}
+	if spec.IncludeNumber {
+		i, err := randomInt(len(list))
+		if err != nil {
+			return "", err
+		}
+		digit, err := randomInt(10)
+		if err != nil {
+			return "", err
+		}
+		list[i] += strconv.Itoa(digit)
+	}
+	return strings.Join(list, separator), nil
+}
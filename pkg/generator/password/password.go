@@ -40,6 +40,8 @@ const (
 	errGetToken  = "unable to get authorization token: %w"
 )
 
+type policyGenerateFunc func(p *policy) (string, error)
+
 type generateFunc func(
 	len int,
 	symbols int,
@@ -53,6 +55,7 @@ func (g *Generator) Generate(_ context.Context, jsonSpec *apiextensions.JSON, _
 	return g.generate(
 		jsonSpec,
 		generateSafePassword,
+		generatePolicyPassword,
 	)
 }
 
@@ -60,7 +63,7 @@ func (g *Generator) Cleanup(_ context.Context, jsonSpec *apiextensions.JSON, sta
 	return nil
 }
 
-func (g *Generator) generate(jsonSpec *apiextensions.JSON, passGen generateFunc) (map[string][]byte, genv1alpha1.GeneratorProviderState, error) {
+func (g *Generator) generate(jsonSpec *apiextensions.JSON, passGen generateFunc, policyGen policyGenerateFunc) (map[string][]byte, genv1alpha1.GeneratorProviderState, error) {
 	if jsonSpec == nil {
 		return nil, nil, errors.New(errNoSpec)
 	}
@@ -68,6 +71,15 @@ func (g *Generator) generate(jsonSpec *apiextensions.JSON, passGen generateFunc)
 	if err != nil {
 		return nil, nil, fmt.Errorf(errParseSpec, err)
 	}
+	if res.Spec.Passphrase != nil {
+		pass, err := generatePassphrase(res.Spec.Passphrase)
+		if err != nil {
+			return nil, nil, err
+		}
+		return map[string][]byte{
+			"password": []byte(pass),
+		}, nil, nil
+	}
 	symbolCharacters := defaultSymbolChars
 	if res.Spec.SymbolCharacters != nil {
 		symbolCharacters = *res.Spec.SymbolCharacters
@@ -84,14 +96,19 @@ func (g *Generator) generate(jsonSpec *apiextensions.JSON, passGen generateFunc)
 	if res.Spec.Symbols != nil {
 		symbols = *res.Spec.Symbols
 	}
-	pass, err := passGen(
-		passLen,
-		symbols,
-		symbolCharacters,
-		digits,
-		res.Spec.NoUpper,
-		res.Spec.AllowRepeat,
-	)
+	var pass string
+	if hasPolicy(&res.Spec) {
+		pass, err = policyGen(newPolicy(&res.Spec, passLen, digits, symbols, symbolCharacters))
+	} else {
+		pass, err = passGen(
+			passLen,
+			symbols,
+			symbolCharacters,
+			digits,
+			res.Spec.NoUpper,
+			res.Spec.AllowRepeat,
+		)
+	}
 	if err != nil {
 		return nil, nil, err
 	}
@@ -21,12 +21,15 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	apiextensions "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+
+	genv1alpha1 "github.com/external-secrets/external-secrets/apis/generators/v1alpha1"
 )
 
 func TestGenerate(t *testing.T) {
 	type args struct {
-		jsonSpec *apiextensions.JSON
-		passGen  generateFunc
+		jsonSpec  *apiextensions.JSON
+		passGen   generateFunc
+		policyGen policyGenerateFunc
 	}
 	tests := []struct {
 		name    string
@@ -95,6 +98,39 @@ func TestGenerate(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name: "policy settings should use the policy generator",
+			args: args{
+				jsonSpec: &apiextensions.JSON{
+					Raw: []byte(`{"spec":{"length":12,"digits":2,"symbols":0,"minLowercase":2,"minUppercase":1,"excludeCharacters":"0Ol1I","firstCharacter":["lowercase"]}}`),
+				},
+				policyGen: func(p *policy) (string, error) {
+					assert.Equal(t, 12, p.length)
+					assert.Equal(t, 2, p.digits)
+					assert.Equal(t, 0, p.symbols)
+					assert.Equal(t, 2, p.minLowercase)
+					assert.Equal(t, 1, p.minUppercase)
+					assert.Len(t, p.digit, 8)
+					assert.Len(t, p.uppercase, 24)
+					assert.Len(t, p.lowercase, 25)
+					assert.Equal(t, []genv1alpha1.PasswordCharacterClass{genv1alpha1.PasswordCharacterClassLowercase}, p.firstCharacter)
+					return "foobar", nil
+				},
+			},
+			want: map[string][]byte{
+				"password": []byte(`foobar`),
+			},
+			wantErr: false,
+		},
+		{
+			name: "passphrase should ignore the password generators",
+			args: args{
+				jsonSpec: &apiextensions.JSON{
+					Raw: []byte(`{"spec":{"passphrase":{"words":1,"separator":"","wordList":"eff-short"},"minLowercase":2}}`),
+				},
+			},
+			wantErr: false,
+		},
 		{
 			name: "generator error should be returned",
 			args: args{
@@ -112,11 +148,15 @@ func TestGenerate(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			g := &Generator{}
-			got, _, err := g.generate(tt.args.jsonSpec, tt.args.passGen)
+			got, _, err := g.generate(tt.args.jsonSpec, tt.args.passGen, tt.args.policyGen)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("Generator.Generate() error = %v, wantErr %v", err, tt.wantErr)
 				return
 			}
+			if tt.want == nil && got != nil {
+				assert.NotEmpty(t, got["password"])
+				return
+			}
 			if !reflect.DeepEqual(got, tt.want) {
 				t.Errorf("Generator.Generate() = %v, want %v", got, tt.want)
 			}
@@ -0,0 +1,225 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package password
+
+import (
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"math/big"
+	"slices"
+	"strings"
+
+	genv1alpha1 "github.com/external-secrets/external-secrets/apis/generators/v1alpha1"
+)
+
+const (
+	defaultLowercaseChars = "abcdefghijklmnopqrstuvwxyz"
+	defaultUppercaseChars = "ABCDEFGHIJKLMNOPQRSTUVWXYZ"
+	defaultDigitChars     = "0123456789"
+
+	errPolicyLength    = "the password length %d is too short for %d digits, %d symbols, %d lowercase and %d uppercase characters"
+	errPolicyExhausted = "not enough %s characters available to generate the password"
+	errPolicyFirst     = "no character available for the first character of the password"
+	errRandom          = "unable to read random data: %w"
+)
+
+// char is a character of a generated password together with its class.
+type char struct {
+	r     rune
+	class genv1alpha1.PasswordCharacterClass
+}
+
+// policy describes the composition of a password with explicit
+// character classes.
+type policy struct {
+	length       int
+	digits       int
+	symbols      int
+	minLowercase int
+	minUppercase int
+	allowRepeat  bool
+
+	lowercase []char
+	uppercase []char
+	digit     []char
+	symbol    []char
+
+	firstCharacter []genv1alpha1.PasswordCharacterClass
+}
+
+// hasPolicy returns true if the spec uses any setting that the
+// simple password generator does not support.
+func hasPolicy(spec *genv1alpha1.PasswordSpec) bool {
+	return spec.MinLowercase != nil ||
+		spec.MinUppercase != nil ||
+		spec.LowercaseCharacters != nil ||
+		spec.UppercaseCharacters != nil ||
+		spec.DigitCharacters != nil ||
+		spec.ExcludeCharacters != nil ||
+		len(spec.FirstCharacter) > 0
+}
+
+func newPolicy(spec *genv1alpha1.PasswordSpec, length, digits, symbols int, symbolCharacters string) *policy {
+	exclude := ""
+	if spec.ExcludeCharacters != nil {
+		exclude = *spec.ExcludeCharacters
+	}
+	p := &policy{
+		length:         length,
+		digits:         digits,
+		symbols:        symbols,
+		allowRepeat:    spec.AllowRepeat,
+		lowercase:      alphabet(genv1alpha1.PasswordCharacterClassLowercase, valueOr(spec.LowercaseCharacters, defaultLowercaseChars), exclude),
+		digit:          alphabet(genv1alpha1.PasswordCharacterClassDigit, valueOr(spec.DigitCharacters, defaultDigitChars), exclude),
+		symbol:         alphabet(genv1alpha1.PasswordCharacterClassSymbol, symbolCharacters, exclude),
+		firstCharacter: spec.FirstCharacter,
+	}
+	if !spec.NoUpper {
+		p.uppercase = alphabet(genv1alpha1.PasswordCharacterClassUppercase, valueOr(spec.UppercaseCharacters, defaultUppercaseChars), exclude)
+	}
+	if spec.MinLowercase != nil {
+		p.minLowercase = *spec.MinLowercase
+	}
+	if spec.MinUppercase != nil {
+		p.minUppercase = *spec.MinUppercase
+	}
+	return p
+}
+
+// generatePolicyPassword picks the exact number of digits and symbols
+// and at least the minimum number of lowercase and uppercase characters,
+// fills the remaining length with letters and shuffles the result.
+func generatePolicyPassword(p *policy) (string, error) {
+	letters := p.length - p.digits - p.symbols
+	if p.digits < 0 || p.symbols < 0 || letters < p.minLowercase+p.minUppercase {
+		return "", fmt.Errorf(errPolicyLength, p.length, p.digits, p.symbols, p.minLowercase, p.minUppercase)
+	}
+	pass := make([]char, 0, p.length)
+	used := make(map[rune]bool, p.length)
+	var err error
+	pass, err = p.pick(pass, used, p.digit, p.digits, "digit")
+	if err != nil {
+		return "", err
+	}
+	pass, err = p.pick(pass, used, p.symbol, p.symbols, "symbol")
+	if err != nil {
+		return "", err
+	}
+	pass, err = p.pick(pass, used, p.lowercase, p.minLowercase, "lowercase")
+	if err != nil {
+		return "", err
+	}
+	pass, err = p.pick(pass, used, p.uppercase, p.minUppercase, "uppercase")
+	if err != nil {
+		return "", err
+	}
+	letters -= p.minLowercase + p.minUppercase
+	pass, err = p.pick(pass, used, slices.Concat(p.lowercase, p.uppercase), letters, "letter")
+	if err != nil {
+		return "", err
+	}
+	for i := len(pass) - 1; i > 0; i-- {
+		j, err := randomInt(i + 1)
+		if err != nil {
+			return "", err
+		}
+		pass[i], pass[j] = pass[j], pass[i]
+	}
+	if err := p.moveFirstCharacter(pass); err != nil {
+		return "", err
+	}
+	var sb strings.Builder
+	for _, c := range pass {
+		sb.WriteRune(c.r)
+	}
+	return sb.String(), nil
+}
+
+// pick appends n random characters of the alphabet to pass.
+// Characters that have already been used are skipped unless
+// repeating characters is allowed.
+func (p *policy) pick(pass []char, used map[rune]bool, chars []char, n int, name string) ([]char, error) {
+	for range n {
+		available := chars
+		if !p.allowRepeat {
+			available = slices.DeleteFunc(slices.Clone(chars), func(c char) bool {
+				return used[c.r]
+			})
+		}
+		if len(available) == 0 {
+			return nil, fmt.Errorf(errPolicyExhausted, name)
+		}
+		i, err := randomInt(len(available))
+		if err != nil {
+			return nil, err
+		}
+		used[available[i].r] = true
+		pass = append(pass, available[i])
+	}
+	return pass, nil
+}
+
+// moveFirstCharacter swaps a random character of an allowed class
+// to the front of the password.
+func (p *policy) moveFirstCharacter(pass []char) error {
+	if len(p.firstCharacter) == 0 || len(pass) == 0 {
+		return nil
+	}
+	var candidates []int
+	for i, c := range pass {
+		if slices.Contains(p.firstCharacter, c.class) {
+			candidates = append(candidates, i)
+		}
+	}
+	if len(candidates) == 0 {
+		return errors.New(errPolicyFirst)
+	}
+	i, err := randomInt(len(candidates))
+	if err != nil {
+		return err
+	}
+	pass[0], pass[candidates[i]] = pass[candidates[i]], pass[0]
+	return nil
+}
+
+// alphabet returns the unique characters of chars that are not excluded.
+func alphabet(class genv1alpha1.PasswordCharacterClass, chars, exclude string) []char {
+	seen := make(map[rune]bool)
+	var out []char
+	for _, r := range chars {
+		if seen[r] || strings.ContainsRune(exclude, r) {
+			continue
+		}
+		seen[r] = true
+		out = append(out, char{r: r, class: class})
+	}
+	return out
+}
+
+func valueOr(s *string, def string) string {
+	if s == nil {
+		return def
+	}
+	return *s
+}
+
+func randomInt(n int) (int, error) {
+	i, err := rand.Int(rand.Reader, big.NewInt(int64(n)))
+	if err != nil {
+		return 0, fmt.Errorf(errRandom, err)
+	}
+	return int(i.Int64()), nil
+}
@@ -0,0 +1,156 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package password
+
+import (
+	"strings"
+	"testing"
+	"unicode"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/utils/ptr"
+
+	genv1alpha1 "github.com/external-secrets/external-secrets/apis/generators/v1alpha1"
+)
+
+func count(s string, f func(rune) bool) int {
+	n := 0
+	for _, r := range s {
+		if f(r) {
+			n++
+		}
+	}
+	return n
+}
+
+func TestGeneratePolicyPassword(t *testing.T) {
+	tests := []struct {
+		name    string
+		spec    genv1alpha1.PasswordSpec
+		length  int
+		digits  int
+		symbols int
+		check   func(t *testing.T, pass string)
+		wantErr string
+	}{
+		{
+			name:   "per-class minimums",
+			spec:   genv1alpha1.PasswordSpec{MinLowercase: ptr.To(5), MinUppercase: ptr.To(5)},
+			length: 12,
+			digits: 2,
+			check: func(t *testing.T, pass string) {
+				assert.Len(t, pass, 12)
+				assert.Equal(t, 2, count(pass, unicode.IsDigit))
+				assert.GreaterOrEqual(t, count(pass, unicode.IsLower), 5)
+				assert.GreaterOrEqual(t, count(pass, unicode.IsUpper), 5)
+			},
+		},
+		{
+			name:    "custom alphabets and excluded characters",
+			spec:    genv1alpha1.PasswordSpec{LowercaseCharacters: ptr.To("abc"), DigitCharacters: ptr.To("01"), ExcludeCharacters: ptr.To("b1"), NoUpper: true, AllowRepeat: true},
+			length:  20,
+			digits:  5,
+			symbols: 5,
+			check: func(t *testing.T, pass string) {
+				assert.Len(t, pass, 20)
+				for _, r := range pass {
+					assert.Contains(t, "ac0-", string(r))
+				}
+			},
+		},
+		{
+			name:    "no leading digit",
+			spec:    genv1alpha1.PasswordSpec{FirstCharacter: []genv1alpha1.PasswordCharacterClass{genv1alpha1.PasswordCharacterClassLowercase, genv1alpha1.PasswordCharacterClassUppercase}},
+			length:  8,
+			digits:  6,
+			symbols: 1,
+			check: func(t *testing.T, pass string) {
+				assert.True(t, unicode.IsLetter(rune(pass[0])), pass)
+			},
+		},
+		{
+			name:    "first character class not in password",
+			spec:    genv1alpha1.PasswordSpec{FirstCharacter: []genv1alpha1.PasswordCharacterClass{genv1alpha1.PasswordCharacterClassSymbol}},
+			length:  8,
+			wantErr: errPolicyFirst,
+		},
+		{
+			name:    "minimums exceed length",
+			spec:    genv1alpha1.PasswordSpec{MinLowercase: ptr.To(5), MinUppercase: ptr.To(5)},
+			length:  12,
+			digits:  2,
+			symbols: 1,
+			wantErr: "too short",
+		},
+		{
+			name:    "uppercase minimum without uppercase characters",
+			spec:    genv1alpha1.PasswordSpec{MinUppercase: ptr.To(1), NoUpper: true},
+			length:  8,
+			wantErr: "not enough uppercase characters",
+		},
+		{
+			name:    "alphabet exhausted without repeats",
+			spec:    genv1alpha1.PasswordSpec{DigitCharacters: ptr.To("0123")},
+			length:  8,
+			digits:  5,
+			wantErr: "not enough digit characters",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for range 20 {
+				pass, err := generatePolicyPassword(newPolicy(&tt.spec, tt.length, tt.digits, tt.symbols, "-"))
+				if tt.wantErr != "" {
+					require.ErrorContains(t, err, tt.wantErr)
+					return
+				}
+				require.NoError(t, err)
+				if !tt.spec.AllowRepeat {
+					seen := map[rune]bool{}
+					for _, r := range pass {
+						assert.False(t, seen[r], "repeated character in %q", pass)
+						seen[r] = true
+					}
+				}
+				tt.check(t, pass)
+			}
+		})
+	}
+}
+
+func TestGeneratePassphrase(t *testing.T) {
+	pass, err := generatePassphrase(&genv1alpha1.PassphraseSpec{})
+	require.NoError(t, err)
+	assert.Len(t, strings.Split(pass, defaultPassphraseSeparator), defaultPassphraseWords)
+
+	pass, err = generatePassphrase(&genv1alpha1.PassphraseSpec{
+		Words:         4,
+		Separator:     ptr.To(" "),
+		WordList:      genv1alpha1.PassphraseWordListEFFShort,
+		Capitalize:    true,
+		IncludeNumber: true,
+	})
+	require.NoError(t, err)
+	words := strings.Split(pass, " ")
+	assert.Len(t, words, 4)
+	for _, w := range words {
+		assert.True(t, unicode.IsUpper(rune(w[0])), pass)
+	}
+	assert.Equal(t, 1, count(pass, unicode.IsDigit))
+
+	_, err = generatePassphrase(&genv1alpha1.PassphraseSpec{Words: maxPassphraseWords + 1})
+	assert.EqualError(t, err, errPassphraseWords)
+}
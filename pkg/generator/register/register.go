@@ -18,17 +18,25 @@ package register
 
 import (
 	_ "github.com/external-secrets/external-secrets/pkg/generator/acr"
+	_ "github.com/external-secrets/external-secrets/pkg/generator/agekey"
+	_ "github.com/external-secrets/external-secrets/pkg/generator/certificate"
+	_ "github.com/external-secrets/external-secrets/pkg/generator/databaseuser"
 	_ "github.com/external-secrets/external-secrets/pkg/generator/ecr"
 	_ "github.com/external-secrets/external-secrets/pkg/generator/fake"
 	_ "github.com/external-secrets/external-secrets/pkg/generator/gcr"
 	_ "github.com/external-secrets/external-secrets/pkg/generator/github"
+	_ "github.com/external-secrets/external-secrets/pkg/generator/gitlab"
 	_ "github.com/external-secrets/external-secrets/pkg/generator/grafana"
 	_ "github.com/external-secrets/external-secrets/pkg/generator/mfa"
+	_ "github.com/external-secrets/external-secrets/pkg/generator/oauth2token"
 	_ "github.com/external-secrets/external-secrets/pkg/generator/password"
 	_ "github.com/external-secrets/external-secrets/pkg/generator/quay"
+	_ "github.com/external-secrets/external-secrets/pkg/generator/serviceaccounttoken"
 	_ "github.com/external-secrets/external-secrets/pkg/generator/sshkey"
 	_ "github.com/external-secrets/external-secrets/pkg/generator/sts"
+	_ "github.com/external-secrets/external-secrets/pkg/generator/transform"
 	_ "github.com/external-secrets/external-secrets/pkg/generator/uuid"
 	_ "github.com/external-secrets/external-secrets/pkg/generator/vault"
 	_ "github.com/external-secrets/external-secrets/pkg/generator/webhook"
+	_ "github.com/external-secrets/external-secrets/pkg/generator/wireguardkey"
 )
@@ -0,0 +1,32 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package renewal computes when generated values with a limited lifetime are renewed.
+package renewal
+
+import "time"
+
+// DefaultPercentage is the percentage of the lifetime of a token
+// that is left when it is renewed, if not configured otherwise.
+const DefaultPercentage = 20
+
+// Time returns the time at which the given percentage of the lifetime is left.
+// If percentage is nil, defaultPercentage is used.
+func Time(expiry time.Time, lifetime time.Duration, percentage *int32, defaultPercentage int32) time.Time {
+	p := defaultPercentage
+	if percentage != nil {
+		p = *percentage
+	}
+	return expiry.Add(-lifetime * time.Duration(p) / 100)
+}
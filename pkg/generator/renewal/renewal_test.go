@@ -0,0 +1,50 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package renewal
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/utils/ptr"
+)
+
+func TestTime(t *testing.T) {
+	expiry := time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name       string
+		lifetime   time.Duration
+		percentage *int32
+		want       time.Time
+	}{
+		{
+			name:     "default percentage",
+			lifetime: time.Hour,
+			want:     time.Date(2025, 1, 1, 9, 48, 0, 0, time.UTC),
+		},
+		{
+			name:       "configured percentage",
+			lifetime:   100 * time.Hour,
+			percentage: ptr.To[int32](25),
+			want:       time.Date(2024, 12, 31, 9, 0, 0, 0, time.UTC),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, Time(expiry, tt.lifetime, tt.percentage, DefaultPercentage))
+		})
+	}
+}
@@ -0,0 +1,255 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package serviceaccounttoken
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/spf13/pflag"
+	apiextensions "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/client-go/kubernetes"
+	typedcorev1 "k8s.io/client-go/kubernetes/typed/core/v1"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/clientcmd"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	ctrlcfg "sigs.k8s.io/controller-runtime/pkg/client/config"
+	"sigs.k8s.io/yaml"
+
+	esv1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1"
+	genv1alpha1 "github.com/external-secrets/external-secrets/apis/generators/v1alpha1"
+	"github.com/external-secrets/external-secrets/pkg/feature"
+	"github.com/external-secrets/external-secrets/pkg/generator/renewal"
+	k8sprovider "github.com/external-secrets/external-secrets/pkg/provider/kubernetes"
+	"github.com/external-secrets/external-secrets/pkg/utils/resolvers"
+)
+
+type Generator struct{}
+
+const (
+	defaultExpirationSeconds = 3600
+	defaultClusterName       = "default"
+
+	keyToken      = "token"
+	keyExpiry     = "expiry"
+	keyKubeconfig = "kubeconfig"
+
+	errNoSpec        = "no config spec provided"
+	errLocalDisabled = "requesting tokens from the cluster the controller runs in is disabled, it can be enabled with --enable-serviceaccount-token-generator"
+	errParseSpec     = "unable to parse spec: %w"
+	errRemoteCluster = "unable to configure remote cluster: %w"
+	errKubeconfig    = "unable to generate kubeconfig: %w"
+	errParseExpiry   = "unable to parse token expiry: %w"
+)
+
+// enableLocalTokens allows to request tokens from the cluster the controller runs in.
+// The controller may request a token for every ServiceAccount, so this lets everyone
+// who can create an ExternalSecret obtain the token of any ServiceAccount in its namespace.
+var enableLocalTokens bool
+
+type coreV1Func func(cfg *rest.Config) (typedcorev1.CoreV1Interface, error)
+
+func (g *Generator) Generate(ctx context.Context, jsonSpec *apiextensions.JSON, kube client.Client, namespace string) (map[string][]byte, genv1alpha1.GeneratorProviderState, error) {
+	// controller-runtime/client does not support TokenRequest or other subresource APIs
+	// so we need to construct our own client and use it to fetch tokens
+	restCfg, err := ctrlcfg.GetConfig()
+	if err != nil {
+		return nil, nil, err
+	}
+	corev1, err := newCoreV1(restCfg)
+	if err != nil {
+		return nil, nil, err
+	}
+	return g.generate(ctx, jsonSpec, kube, restCfg, corev1, namespace, newCoreV1)
+}
+
+func (g *Generator) Cleanup(_ context.Context, _ *apiextensions.JSON, _ genv1alpha1.GeneratorProviderState, _ client.Client, _ string) error {
+	return nil
+}
+
+// RenewalTime returns the time at which RenewBeforePercentage of the lifetime of the token is left.
+func (g *Generator) RenewalTime(jsonSpec *apiextensions.JSON, values map[string][]byte) (time.Time, error) {
+	if jsonSpec == nil {
+		return time.Time{}, errors.New(errNoSpec)
+	}
+	res, err := parseSpec(jsonSpec.Raw)
+	if err != nil {
+		return time.Time{}, fmt.Errorf(errParseSpec, err)
+	}
+	expiry, err := time.Parse(time.RFC3339, string(values[keyExpiry]))
+	if err != nil {
+		return time.Time{}, fmt.Errorf(errParseExpiry, err)
+	}
+	// the API server may shorten the requested validity,
+	// so prefer the issue time of the token itself.
+	issuedAt, ok := tokenIssuedAt(string(values[keyToken]))
+	if !ok {
+		issuedAt = expiry.Add(-time.Duration(expirationSeconds(&res.Spec)) * time.Second)
+	}
+	return renewal.Time(expiry, expiry.Sub(issuedAt), res.Spec.RenewBeforePercentage, renewal.DefaultPercentage), nil
+}
+
+func (g *Generator) generate(
+	ctx context.Context,
+	jsonSpec *apiextensions.JSON,
+	kube client.Client,
+	restCfg *rest.Config,
+	corev1 typedcorev1.CoreV1Interface,
+	namespace string,
+	remoteCoreV1 coreV1Func) (map[string][]byte, genv1alpha1.GeneratorProviderState, error) {
+	if jsonSpec == nil {
+		return nil, nil, errors.New(errNoSpec)
+	}
+	res, err := parseSpec(jsonSpec.Raw)
+	if err != nil {
+		return nil, nil, fmt.Errorf(errParseSpec, err)
+	}
+	spec := &res.Spec
+	tokenNamespace := namespace
+	if spec.RemoteCluster == nil && !enableLocalTokens {
+		return nil, nil, errors.New(errLocalDisabled)
+	}
+	if spec.RemoteCluster != nil {
+		provider := &esv1.KubernetesProvider{
+			Server:  spec.RemoteCluster.Server,
+			AuthRef: spec.RemoteCluster.AuthRef,
+		}
+		if spec.RemoteCluster.Auth != nil {
+			provider.Auth = *spec.RemoteCluster.Auth
+		}
+		restCfg, err = k8sprovider.RESTConfig(ctx, provider, kube, corev1, resolvers.EmptyStoreKind, namespace)
+		if err != nil {
+			return nil, nil, fmt.Errorf(errRemoteCluster, err)
+		}
+		corev1, err = remoteCoreV1(restCfg)
+		if err != nil {
+			return nil, nil, fmt.Errorf(errRemoteCluster, err)
+		}
+		// access to the remote cluster is governed by its own credentials,
+		// so the ServiceAccount may reside in any namespace.
+		if spec.ServiceAccountRef.Namespace != nil {
+			tokenNamespace = *spec.ServiceAccountRef.Namespace
+		}
+	}
+	status, err := resolvers.ServiceAccountToken(ctx, corev1, resolvers.EmptyStoreKind, tokenNamespace, spec.ServiceAccountRef, nil, expirationSeconds(spec))
+	if err != nil {
+		return nil, nil, err
+	}
+	out := map[string][]byte{
+		keyToken:  []byte(status.Token),
+		keyExpiry: []byte(status.ExpirationTimestamp.UTC().Format(time.RFC3339)),
+	}
+	if spec.Kubeconfig != nil {
+		kubeconfig, err := generateKubeconfig(spec.Kubeconfig, restCfg, spec.ServiceAccountRef.Name, tokenNamespace, status.Token)
+		if err != nil {
+			return nil, nil, fmt.Errorf(errKubeconfig, err)
+		}
+		out[keyKubeconfig] = kubeconfig
+	}
+	return out, nil, nil
+}
+
+// generateKubeconfig returns a kubeconfig with a single context
+// that authenticates as the ServiceAccount.
+func generateKubeconfig(spec *genv1alpha1.ServiceAccountTokenKubeconfig, restCfg *rest.Config, user, namespace, token string) ([]byte, error) {
+	server := restCfg.Host
+	if spec.Server != "" {
+		server = spec.Server
+	}
+	caData := spec.CABundle
+	if len(caData) == 0 {
+		// the in-cluster config only references the CA file.
+		cfg := rest.CopyConfig(restCfg)
+		if err := rest.LoadTLSFiles(cfg); err != nil {
+			return nil, err
+		}
+		caData = cfg.CAData
+	}
+	name := defaultClusterName
+	if spec.ClusterName != "" {
+		name = spec.ClusterName
+	}
+	config := clientcmdapi.NewConfig()
+	config.Clusters[name] = &clientcmdapi.Cluster{
+		Server:                   server,
+		CertificateAuthorityData: caData,
+	}
+	config.AuthInfos[user] = &clientcmdapi.AuthInfo{
+		Token: token,
+	}
+	config.Contexts[name] = &clientcmdapi.Context{
+		Cluster:   name,
+		AuthInfo:  user,
+		Namespace: namespace,
+	}
+	config.CurrentContext = name
+	return clientcmd.Write(*config)
+}
+
+// tokenIssuedAt returns the iat claim of the token without verifying it.
+func tokenIssuedAt(token string) (time.Time, bool) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return time.Time{}, false
+	}
+	payload, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		return time.Time{}, false
+	}
+	var claims struct {
+		IssuedAt int64 `json:"iat"`
+	}
+	if err := json.Unmarshal(payload, &claims); err != nil || claims.IssuedAt == 0 {
+		return time.Time{}, false
+	}
+	return time.Unix(claims.IssuedAt, 0), true
+}
+
+func expirationSeconds(spec *genv1alpha1.ServiceAccountTokenSpec) int64 {
+	if spec.ExpirationSeconds != nil {
+		return *spec.ExpirationSeconds
+	}
+	return defaultExpirationSeconds
+}
+
+func newCoreV1(cfg *rest.Config) (typedcorev1.CoreV1Interface, error) {
+	clientset, err := kubernetes.NewForConfig(cfg)
+	if err != nil {
+		return nil, err
+	}
+	return clientset.CoreV1(), nil
+}
+
+func parseSpec(data []byte) (*genv1alpha1.ServiceAccountToken, error) {
+	var spec genv1alpha1.ServiceAccountToken
+	err := yaml.Unmarshal(data, &spec)
+	return &spec, err
+}
+
+func init() {
+	genv1alpha1.Register(genv1alpha1.ServiceAccountTokenKind, &Generator{})
+
+	fs := pflag.NewFlagSet("serviceaccounttoken", pflag.ExitOnError)
+	fs.BoolVar(&enableLocalTokens, "enable-serviceaccount-token-generator", false, "Allow the ServiceAccountToken generator to request tokens from the cluster the controller runs in. Everyone who can create an ExternalSecret can then obtain the token of any ServiceAccount in its namespace.")
+	feature.Register(feature.Feature{
+		Flags: fs,
+	})
+}
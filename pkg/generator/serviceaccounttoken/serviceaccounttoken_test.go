@@ -0,0 +1,202 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package serviceaccounttoken
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	authv1 "k8s.io/api/authentication/v1"
+	corev1 "k8s.io/api/core/v1"
+	apiextensions "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	kubefake "k8s.io/client-go/kubernetes/fake"
+	typedcorev1 "k8s.io/client-go/kubernetes/typed/core/v1"
+	"k8s.io/client-go/rest"
+	k8stesting "k8s.io/client-go/testing"
+	"k8s.io/client-go/tools/clientcmd"
+	clientfake "sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+var expiry = time.Date(2030, 1, 1, 12, 0, 0, 0, time.UTC)
+
+func caPEM(t *testing.T) []byte {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	tmpl := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		NotBefore:             time.Now(),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	require.NoError(t, err)
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+}
+
+// tokenClient returns a clientset that issues tokens and records the requests.
+func tokenClient(token string, requests *[]*authv1.TokenRequest, namespaces *[]string) typedcorev1.CoreV1Interface {
+	clientset := kubefake.NewClientset()
+	clientset.PrependReactor("create", "serviceaccounts", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		create := action.(k8stesting.CreateAction)
+		tr := create.GetObject().(*authv1.TokenRequest)
+		*requests = append(*requests, tr)
+		*namespaces = append(*namespaces, create.GetNamespace())
+		return true, &authv1.TokenRequest{
+			Status: authv1.TokenRequestStatus{
+				Token:               token,
+				ExpirationTimestamp: metav1.NewTime(expiry),
+			},
+		}, nil
+	})
+	return clientset.CoreV1()
+}
+
+func enableLocal(t *testing.T) {
+	enableLocalTokens = true
+	t.Cleanup(func() {
+		enableLocalTokens = false
+	})
+}
+
+func TestGenerate(t *testing.T) {
+	enableLocal(t)
+	var requests []*authv1.TokenRequest
+	var namespaces []string
+	local := tokenClient("local-token", &requests, &namespaces)
+	g := &Generator{}
+	got, _, err := g.generate(context.Background(), &apiextensions.JSON{
+		Raw: []byte(`{"spec":{"serviceAccountRef":{"name":"deployer","namespace":"kube-system","audiences":["ci"]},"expirationSeconds":1200}}`),
+	}, nil, &rest.Config{Host: "https://local"}, local, "apps", nil)
+	require.NoError(t, err)
+	assert.Equal(t, map[string][]byte{
+		keyToken:  []byte("local-token"),
+		keyExpiry: []byte("2030-01-01T12:00:00Z"),
+	}, got)
+	require.Len(t, requests, 1)
+	// the namespace of the reference must be ignored in the local cluster.
+	assert.Equal(t, []string{"apps"}, namespaces)
+	assert.Equal(t, []string{"ci"}, requests[0].Spec.Audiences)
+	assert.Equal(t, int64(1200), *requests[0].Spec.ExpirationSeconds)
+}
+
+func TestGenerateRemoteClusterWithKubeconfig(t *testing.T) {
+	kube := clientfake.NewClientBuilder().WithObjects(&corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "remote", Namespace: "apps"},
+		Data:       map[string][]byte{"token": []byte("remote-admin")},
+	}).Build()
+	var localRequests, remoteRequests []*authv1.TokenRequest
+	var localNamespaces, remoteNamespaces []string
+	local := tokenClient("local-token", &localRequests, &localNamespaces)
+	remote := tokenClient("remote-token", &remoteRequests, &remoteNamespaces)
+	ca := caPEM(t)
+	caBundle, err := json.Marshal(ca)
+	require.NoError(t, err)
+	var remoteCfg *rest.Config
+	g := &Generator{}
+	got, _, err := g.generate(context.Background(), &apiextensions.JSON{
+		Raw: []byte(`{"spec":{
+			"serviceAccountRef":{"name":"deployer","namespace":"ci"},
+			"remoteCluster":{"server":{"url":"https://remote:6443","caBundle":` + string(caBundle) + `},"auth":{"token":{"bearerToken":{"name":"remote","key":"token"}}}},
+			"kubeconfig":{"clusterName":"remote"}}}`),
+	}, kube, &rest.Config{Host: "https://local"}, local, "apps", func(cfg *rest.Config) (typedcorev1.CoreV1Interface, error) {
+		remoteCfg = cfg
+		return remote, nil
+	})
+	require.NoError(t, err)
+	assert.Empty(t, localRequests)
+	assert.Equal(t, []string{"ci"}, remoteNamespaces)
+	assert.Equal(t, int64(defaultExpirationSeconds), *remoteRequests[0].Spec.ExpirationSeconds)
+	require.NotNil(t, remoteCfg)
+	assert.Equal(t, "remote-admin", remoteCfg.BearerToken)
+	assert.Equal(t, "remote-token", string(got[keyToken]))
+
+	kubeconfig, err := clientcmd.Load(got[keyKubeconfig])
+	require.NoError(t, err)
+	assert.Equal(t, "remote", kubeconfig.CurrentContext)
+	assert.Equal(t, "https://remote:6443", kubeconfig.Clusters["remote"].Server)
+	assert.Equal(t, ca, kubeconfig.Clusters["remote"].CertificateAuthorityData)
+	assert.Equal(t, "remote-token", kubeconfig.AuthInfos["deployer"].Token)
+	assert.Equal(t, "ci", kubeconfig.Contexts["remote"].Namespace)
+}
+
+func TestGenerateErrors(t *testing.T) {
+	g := &Generator{}
+	_, _, err := g.generate(context.Background(), nil, nil, nil, nil, "apps", nil)
+	assert.EqualError(t, err, errNoSpec)
+
+	_, _, err = g.generate(context.Background(), &apiextensions.JSON{
+		Raw: []byte(`{"spec":{"serviceAccountRef":{"name":"deployer"},"remoteCluster":{"auth":{"token":{"bearerToken":{"name":"missing","key":"token"}}}}}}`),
+	}, clientfake.NewClientBuilder().Build(), &rest.Config{}, nil, "apps", nil)
+	assert.ErrorContains(t, err, "unable to configure remote cluster")
+}
+
+func TestGenerateLocalDisabled(t *testing.T) {
+	var requests []*authv1.TokenRequest
+	var namespaces []string
+	local := tokenClient("local-token", &requests, &namespaces)
+	g := &Generator{}
+	_, _, err := g.generate(context.Background(), &apiextensions.JSON{
+		Raw: []byte(`{"spec":{"serviceAccountRef":{"name":"deployer"}}}`),
+	}, nil, &rest.Config{Host: "https://local"}, local, "apps", nil)
+	assert.EqualError(t, err, errLocalDisabled)
+	assert.Empty(t, requests)
+}
+
+func TestRenewalTime(t *testing.T) {
+	issuedAt := expiry.Add(-time.Hour)
+	payload := base64.RawURLEncoding.EncodeToString([]byte(fmt.Sprintf(`{"iat":%d,"exp":%d}`, issuedAt.Unix(), expiry.Unix())))
+	g := &Generator{}
+	spec := &apiextensions.JSON{Raw: []byte(`{"spec":{"serviceAccountRef":{"name":"deployer"},"expirationSeconds":86400}}`)}
+
+	// the lifetime of the issued token takes precedence over the requested one.
+	renewal, err := g.RenewalTime(spec, map[string][]byte{
+		keyToken:  []byte("header." + payload + ".signature"),
+		keyExpiry: []byte(expiry.Format(time.RFC3339)),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, expiry.Add(-12*time.Minute), renewal.UTC())
+
+	renewal, err = g.RenewalTime(spec, map[string][]byte{
+		keyToken:  []byte("opaque"),
+		keyExpiry: []byte(expiry.Format(time.RFC3339)),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, expiry.Add(-24*time.Hour*20/100), renewal.UTC())
+
+	renewal, err = g.RenewalTime(&apiextensions.JSON{Raw: []byte(`{"spec":{"serviceAccountRef":{"name":"deployer"},"renewBeforePercentage":50}}`)}, map[string][]byte{
+		keyToken:  []byte("header." + payload + ".signature"),
+		keyExpiry: []byte(expiry.Format(time.RFC3339)),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, expiry.Add(-30*time.Minute), renewal.UTC())
+
+	_, err = g.RenewalTime(spec, map[string][]byte{})
+	assert.ErrorContains(t, err, "unable to parse token expiry")
+}
@@ -16,12 +16,16 @@ package sshkey
 
 import (
 	"context"
+	"crypto"
+	"crypto/ecdsa"
 	"crypto/ed25519"
+	"crypto/elliptic"
 	"crypto/rand"
 	"crypto/rsa"
 	"encoding/pem"
 	"errors"
 	"fmt"
+	"time"
 
 	"golang.org/x/crypto/ssh"
 	apiextensions "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
@@ -29,25 +33,53 @@ import (
 	"sigs.k8s.io/yaml"
 
 	genv1alpha1 "github.com/external-secrets/external-secrets/apis/generators/v1alpha1"
+	esmeta "github.com/external-secrets/external-secrets/apis/meta/v1"
+	"github.com/external-secrets/external-secrets/pkg/generator/renewal"
+	"github.com/external-secrets/external-secrets/pkg/utils/resolvers"
 )
 
 type Generator struct{}
 
 const (
-	defaultKeyType = "rsa"
-	defaultKeySize = 2048
+	defaultKeyType               = "rsa"
+	defaultKeySize               = 2048
+	defaultECDSAKeySize          = 256
+	defaultCertType              = "user"
+	defaultCertValidFor          = 24 * time.Hour
+	defaultCertClockSkew         = 5 * time.Minute
+	defaultRenewBeforePercentage = 33
+
+	keyCertificate = "certificate"
 
 	errNoSpec      = "no config spec provided"
 	errParseSpec   = "unable to parse spec: %w"
 	errGenerateKey = "unable to generate SSH key: %w"
 	errUnsupported = "unsupported key type: %s"
+	errKeySize     = "unsupported key size %d for ecdsa keys"
+	errGetCAKey    = "unable to get SSH CA key: %w"
+	errParseCAKey  = "unable to parse SSH CA key: %w"
+	errSignCert    = "unable to sign SSH certificate: %w"
+	errCertType    = "unsupported certificate type: %s"
+	errNoCert      = "no SSH certificate found"
 )
 
+// defaultUserExtensions are the extensions set by ssh-keygen for user certificates.
+var defaultUserExtensions = map[string]string{
+	"permit-X11-forwarding":   "",
+	"permit-agent-forwarding": "",
+	"permit-port-forwarding":  "",
+	"permit-pty":              "",
+	"permit-user-rc":          "",
+}
+
 type generateFunc func(keyType string, keySize *int, comment string) (privateKey, publicKey []byte, err error)
 
-func (g *Generator) Generate(_ context.Context, jsonSpec *apiextensions.JSON, _ client.Client, _ string) (map[string][]byte, genv1alpha1.GeneratorProviderState, error) {
+func (g *Generator) Generate(ctx context.Context, jsonSpec *apiextensions.JSON, kube client.Client, namespace string) (map[string][]byte, genv1alpha1.GeneratorProviderState, error) {
 	return g.generate(
+		ctx,
 		jsonSpec,
+		kube,
+		namespace,
 		generateSSHKey,
 	)
 }
@@ -56,7 +88,33 @@ func (g *Generator) Cleanup(_ context.Context, jsonSpec *apiextensions.JSON, sta
 	return nil
 }
 
-func (g *Generator) generate(jsonSpec *apiextensions.JSON, keyGen generateFunc) (map[string][]byte, genv1alpha1.GeneratorProviderState, error) {
+// RenewalTime returns the time at which RenewBeforePercentage of the validity period of the certificate is left.
+// Keys without a certificate do not expire.
+func (g *Generator) RenewalTime(jsonSpec *apiextensions.JSON, values map[string][]byte) (time.Time, error) {
+	if jsonSpec == nil {
+		return time.Time{}, errors.New(errNoSpec)
+	}
+	res, err := parseSpec(jsonSpec.Raw)
+	if err != nil {
+		return time.Time{}, fmt.Errorf(errParseSpec, err)
+	}
+	if res.Spec.Certificate == nil {
+		return time.Time{}, nil
+	}
+	pub, _, _, _, err := ssh.ParseAuthorizedKey(values[keyCertificate])
+	if err != nil {
+		return time.Time{}, fmt.Errorf("%s: %w", errNoCert, err)
+	}
+	cert, ok := pub.(*ssh.Certificate)
+	if !ok {
+		return time.Time{}, errors.New(errNoCert)
+	}
+	validAfter := time.Unix(int64(cert.ValidAfter), 0)
+	validBefore := time.Unix(int64(cert.ValidBefore), 0)
+	return renewal.Time(validBefore, validBefore.Sub(validAfter), res.Spec.Certificate.RenewBeforePercentage, defaultRenewBeforePercentage), nil
+}
+
+func (g *Generator) generate(ctx context.Context, jsonSpec *apiextensions.JSON, kube client.Client, namespace string, keyGen generateFunc) (map[string][]byte, genv1alpha1.GeneratorProviderState, error) {
 	if jsonSpec == nil {
 		return nil, nil, errors.New(errNoSpec)
 	}
@@ -76,20 +134,95 @@ func (g *Generator) generate(jsonSpec *apiextensions.JSON, keyGen generateFunc)
 		return nil, nil, fmt.Errorf(errGenerateKey, err)
 	}
 
-	return map[string][]byte{
+	result := map[string][]byte{
 		"privateKey": privateKey,
 		"publicKey":  publicKey,
-	}, nil, nil
+	}
+	if res.Spec.Certificate != nil {
+		caKey, err := resolvers.SecretKeyRef(ctx, kube, resolvers.EmptyStoreKind, namespace, &esmeta.SecretKeySelector{
+			Namespace: &namespace,
+			Name:      res.Spec.Certificate.CAKeySecretRef.Name,
+			Key:       res.Spec.Certificate.CAKeySecretRef.Key,
+		})
+		if err != nil {
+			return nil, nil, fmt.Errorf(errGetCAKey, err)
+		}
+		cert, err := signCertificate(res.Spec.Certificate, []byte(caKey), publicKey, res.Spec.Comment, time.Now())
+		if err != nil {
+			return nil, nil, err
+		}
+		result[keyCertificate] = cert
+	}
+	return result, nil, nil
+}
+
+// signCertificate signs the public key in authorized_keys format with the CA key
+// and returns the certificate in the format of an id_*-cert.pub file.
+func signCertificate(spec *genv1alpha1.SSHCertificateSpec, caKey, publicKey []byte, comment string, now time.Time) ([]byte, error) {
+	signer, err := ssh.ParsePrivateKey(caKey)
+	if err != nil {
+		return nil, fmt.Errorf(errParseCAKey, err)
+	}
+	pub, _, _, _, err := ssh.ParseAuthorizedKey(publicKey)
+	if err != nil {
+		return nil, fmt.Errorf(errSignCert, err)
+	}
+
+	certType := defaultCertType
+	if spec.CertType != "" {
+		certType = spec.CertType
+	}
+	validFor := defaultCertValidFor
+	if spec.ValidFor != nil {
+		validFor = spec.ValidFor.Duration
+	}
+	clockSkew := defaultCertClockSkew
+	if spec.ClockSkew != nil {
+		clockSkew = spec.ClockSkew.Duration
+	}
+
+	cert := &ssh.Certificate{
+		Key:             pub,
+		KeyId:           spec.KeyID,
+		ValidPrincipals: spec.Principals,
+		ValidAfter:      uint64(now.Add(-clockSkew).Unix()),
+		ValidBefore:     uint64(now.Add(validFor).Unix()),
+		Permissions: ssh.Permissions{
+			CriticalOptions: spec.CriticalOptions,
+			Extensions:      spec.Extensions,
+		},
+	}
+	switch certType {
+	case "user":
+		cert.CertType = ssh.UserCert
+		if cert.Permissions.Extensions == nil {
+			cert.Permissions.Extensions = defaultUserExtensions
+		}
+	case "host":
+		cert.CertType = ssh.HostCert
+	default:
+		return nil, fmt.Errorf(errCertType, certType)
+	}
+	if err := cert.SignCert(rand.Reader, signer); err != nil {
+		return nil, fmt.Errorf(errSignCert, err)
+	}
+	return marshalAuthorizedKey(cert, comment), nil
 }
 
 func generateSSHKey(keyType string, keySize *int, comment string) (privateKey, publicKey []byte, err error) {
 	switch keyType {
 	case "rsa":
-		bits := 2048
+		bits := defaultKeySize
 		if keySize != nil {
 			bits = *keySize
 		}
 		return generateRSAKey(bits, comment)
+	case "ecdsa":
+		size := defaultECDSAKeySize
+		if keySize != nil {
+			size = *keySize
+		}
+		return generateECDSAKey(size, comment)
 	case "ed25519":
 		return generateEd25519Key(comment)
 	default:
@@ -103,27 +236,27 @@ func generateRSAKey(keySize int, comment string) (privateKey, publicKey []byte,
 	if err != nil {
 		return nil, nil, err
 	}
+	return marshalKeyPair(rsaKey, comment)
+}
 
-	// Create SSH private key in OpenSSH format
-	sshPrivateKey, err := ssh.MarshalPrivateKey(rsaKey, comment)
-	if err != nil {
-		return nil, nil, err
+func generateECDSAKey(keySize int, comment string) (privateKey, publicKey []byte, err error) {
+	var curve elliptic.Curve
+	switch keySize {
+	case 256:
+		curve = elliptic.P256()
+	case 384:
+		curve = elliptic.P384()
+	case 521:
+		curve = elliptic.P521()
+	default:
+		return nil, nil, fmt.Errorf(errKeySize, keySize)
 	}
-
-	// Create SSH public key
-	sshPublicKey, err := ssh.NewPublicKey(&rsaKey.PublicKey)
+	// Generate ECDSA private key
+	ecdsaKey, err := ecdsa.GenerateKey(curve, rand.Reader)
 	if err != nil {
 		return nil, nil, err
 	}
-
-	publicKeyBytes := ssh.MarshalAuthorizedKey(sshPublicKey)
-	if comment != "" {
-		// Remove the newline and add comment
-		publicKeyStr := string(publicKeyBytes[:len(publicKeyBytes)-1]) + " " + comment + "\n"
-		publicKeyBytes = []byte(publicKeyStr)
-	}
-
-	return pem.EncodeToMemory(sshPrivateKey), publicKeyBytes, nil
+	return marshalKeyPair(ecdsaKey, comment)
 }
 
 func generateEd25519Key(comment string) (privateKey, publicKey []byte, err error) {
@@ -132,27 +265,33 @@ func generateEd25519Key(comment string) (privateKey, publicKey []byte, err error
 	if err != nil {
 		return nil, nil, err
 	}
+	return marshalKeyPair(ed25519PrivateKey, comment)
+}
 
+func marshalKeyPair(key crypto.Signer, comment string) (privateKey, publicKey []byte, err error) {
 	// Create SSH private key in OpenSSH format
-	sshPrivateKey, err := ssh.MarshalPrivateKey(ed25519PrivateKey, comment)
+	sshPrivateKey, err := ssh.MarshalPrivateKey(key, comment)
 	if err != nil {
 		return nil, nil, err
 	}
 
 	// Create SSH public key
-	sshPublicKey, err := ssh.NewPublicKey(ed25519PrivateKey.Public())
+	sshPublicKey, err := ssh.NewPublicKey(key.Public())
 	if err != nil {
 		return nil, nil, err
 	}
 
-	publicKeyBytes := ssh.MarshalAuthorizedKey(sshPublicKey)
+	return pem.EncodeToMemory(sshPrivateKey), marshalAuthorizedKey(sshPublicKey, comment), nil
+}
+
+func marshalAuthorizedKey(key ssh.PublicKey, comment string) []byte {
+	publicKeyBytes := ssh.MarshalAuthorizedKey(key)
 	if comment != "" {
 		// Remove the newline and add comment
 		publicKeyStr := string(publicKeyBytes[:len(publicKeyBytes)-1]) + " " + comment + "\n"
 		publicKeyBytes = []byte(publicKeyStr)
 	}
-
-	return pem.EncodeToMemory(sshPrivateKey), publicKeyBytes, nil
+	return publicKeyBytes
 }
 
 func parseSpec(data []byte) (*genv1alpha1.SSHKey, error) {
@@ -15,12 +15,22 @@ limitations under the License.
 package sshkey
 
 import (
+	"bytes"
 	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/pem"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/ssh"
+	corev1 "k8s.io/api/core/v1"
 	apiextensions "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
 	genv1alpha1 "github.com/external-secrets/external-secrets/apis/generators/v1alpha1"
 )
@@ -104,6 +114,28 @@ func TestGenerate(t *testing.T) {
 				assert.Contains(t, string(result["publicKey"]), "test@example.com")
 			},
 		},
+		{
+			name:     "ecdsa key with default size",
+			jsonSpec: &apiextensions.JSON{Raw: []byte(`{"spec":{"keyType":"ecdsa"}}`)},
+			wantErr:  false,
+			validate: func(t *testing.T, result map[string][]byte) {
+				assert.True(t, strings.HasPrefix(string(result["publicKey"]), "ecdsa-sha2-nistp256 "))
+			},
+		},
+		{
+			name:     "ecdsa key with custom size",
+			jsonSpec: &apiextensions.JSON{Raw: []byte(`{"spec":{"keyType":"ecdsa","keySize":521}}`)},
+			wantErr:  false,
+			validate: func(t *testing.T, result map[string][]byte) {
+				assert.True(t, strings.HasPrefix(string(result["publicKey"]), "ecdsa-sha2-nistp521 "))
+			},
+		},
+		{
+			name:        "ecdsa key with unsupported size",
+			jsonSpec:    &apiextensions.JSON{Raw: []byte(`{"spec":{"keyType":"ecdsa","keySize":2048}}`)},
+			wantErr:     true,
+			expectedErr: "unsupported key size 2048 for ecdsa keys",
+		},
 		{
 			name:        "unsupported key type",
 			jsonSpec:    &apiextensions.JSON{Raw: []byte(`{"spec":{"keyType":"unsupported"}}`)},
@@ -132,6 +164,83 @@ func TestGenerate(t *testing.T) {
 	}
 }
 
+func TestGenerateCertificate(t *testing.T) {
+	_, caKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	caPEM, err := ssh.MarshalPrivateKey(caKey, "ca")
+	require.NoError(t, err)
+	caPublicKey, err := ssh.NewPublicKey(caKey.Public())
+	require.NoError(t, err)
+	kube := fake.NewClientBuilder().WithObjects(&corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "ssh-ca", Namespace: "default"},
+		Data:       map[string][]byte{"key": pem.EncodeToMemory(caPEM)},
+	}).Build()
+
+	tests := []struct {
+		name        string
+		spec        string
+		expectedErr string
+		validate    func(t *testing.T, cert *ssh.Certificate)
+	}{
+		{
+			name: "user certificate with defaults",
+			spec: `{"spec":{"keyType":"ecdsa","certificate":{"caKeySecretRef":{"name":"ssh-ca","key":"key"},"keyID":"ci","principals":["runner"]}}}`,
+			validate: func(t *testing.T, cert *ssh.Certificate) {
+				assert.Equal(t, uint32(ssh.UserCert), cert.CertType)
+				assert.Equal(t, "ci", cert.KeyId)
+				assert.Equal(t, []string{"runner"}, cert.ValidPrincipals)
+				assert.Equal(t, uint64(defaultCertValidFor/time.Second+defaultCertClockSkew/time.Second), cert.ValidBefore-cert.ValidAfter)
+				assert.Equal(t, defaultUserExtensions, cert.Permissions.Extensions)
+				assert.Equal(t, "ecdsa-sha2-nistp256", cert.Key.Type())
+			},
+		},
+		{
+			name: "host certificate with options",
+			spec: `{"spec":{"keyType":"ed25519","certificate":{"caKeySecretRef":{"name":"ssh-ca","key":"key"},"certType":"host",
+				"validFor":"1h","clockSkew":"0s","criticalOptions":{"source-address":"10.0.0.0/8"}}}}`,
+			validate: func(t *testing.T, cert *ssh.Certificate) {
+				assert.Equal(t, uint32(ssh.HostCert), cert.CertType)
+				assert.Equal(t, uint64(3600), cert.ValidBefore-cert.ValidAfter)
+				assert.Equal(t, map[string]string{"source-address": "10.0.0.0/8"}, cert.Permissions.CriticalOptions)
+				assert.Empty(t, cert.Permissions.Extensions)
+			},
+		},
+		{
+			name:        "missing CA key",
+			spec:        `{"spec":{"certificate":{"caKeySecretRef":{"name":"missing","key":"key"}}}}`,
+			expectedErr: "unable to get SSH CA key",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := &Generator{}
+			result, _, err := g.Generate(context.Background(), &apiextensions.JSON{Raw: []byte(tt.spec)}, kube, "default")
+			if tt.expectedErr != "" {
+				assert.ErrorContains(t, err, tt.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			pub, _, _, _, err := ssh.ParseAuthorizedKey(result["certificate"])
+			require.NoError(t, err)
+			cert, ok := pub.(*ssh.Certificate)
+			require.True(t, ok)
+			assert.Equal(t, caPublicKey.Marshal(), cert.SignatureKey.Marshal())
+
+			// the certificate must be accepted by a server trusting the CA
+			checker := &ssh.CertChecker{
+				IsUserAuthority: func(auth ssh.PublicKey) bool { return bytes.Equal(auth.Marshal(), caPublicKey.Marshal()) },
+				IsHostAuthority: func(auth ssh.PublicKey, _ string) bool { return bytes.Equal(auth.Marshal(), caPublicKey.Marshal()) },
+			}
+			principal := ""
+			if len(cert.ValidPrincipals) > 0 {
+				principal = cert.ValidPrincipals[0]
+			}
+			require.NoError(t, checker.CheckCert(principal, cert))
+			tt.validate(t, cert)
+		})
+	}
+}
+
 func TestCleanup(t *testing.T) {
 	g := &Generator{}
 	err := g.Cleanup(context.Background(), nil, nil, nil, "")
@@ -182,3 +291,35 @@ func TestParseSpec(t *testing.T) {
 		})
 	}
 }
+
+func TestRenewalTime(t *testing.T) {
+	_, caKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	caPEM, err := ssh.MarshalPrivateKey(caKey, "ca")
+	require.NoError(t, err)
+	kube := fake.NewClientBuilder().WithObjects(&corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "ssh-ca", Namespace: "default"},
+		Data:       map[string][]byte{"key": pem.EncodeToMemory(caPEM)},
+	}).Build()
+	g := &Generator{}
+	spec := &apiextensions.JSON{Raw: []byte(`{"spec":{"keyType":"ed25519","certificate":{"caKeySecretRef":{"name":"ssh-ca","key":"key"},
+		"validFor":"100h","clockSkew":"0s","renewBeforePercentage":25}}}`)}
+	result, _, err := g.Generate(context.Background(), spec, kube, "default")
+	require.NoError(t, err)
+	pub, _, _, _, err := ssh.ParseAuthorizedKey(result[keyCertificate])
+	require.NoError(t, err)
+	cert, ok := pub.(*ssh.Certificate)
+	require.True(t, ok)
+
+	renewAt, err := g.RenewalTime(spec, result)
+	require.NoError(t, err)
+	assert.Equal(t, time.Unix(int64(cert.ValidAfter), 0).Add(75*time.Hour), renewAt)
+
+	_, err = g.RenewalTime(spec, map[string][]byte{})
+	assert.ErrorContains(t, err, errNoCert)
+
+	// keys without a certificate are not renewed
+	renewAt, err = g.RenewalTime(&apiextensions.JSON{Raw: []byte(`{"spec":{}}`)}, map[string][]byte{})
+	require.NoError(t, err)
+	assert.True(t, renewAt.IsZero())
+}
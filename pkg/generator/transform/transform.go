@@ -0,0 +1,94 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package transform implements a generator that renders the values
+// of other generators with templates.
+package transform
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	apiextensions "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+
+	genv1alpha1 "github.com/external-secrets/external-secrets/apis/generators/v1alpha1"
+	templatev2 "github.com/external-secrets/external-secrets/pkg/template/v2"
+)
+
+type Generator struct{}
+
+const (
+	errNoSpec    = "no config spec provided"
+	errParseSpec = "unable to parse spec: %w"
+	errNoInputs  = "at least one input is required"
+	errNoData    = "at least one data template is required"
+	errGetInput  = "unable to get the values of input %q: %w"
+	errRender    = "unable to render key %q: %w"
+)
+
+func (g *Generator) Generate(ctx context.Context, jsonSpec *apiextensions.JSON, kube client.Client, namespace string) (map[string][]byte, genv1alpha1.GeneratorProviderState, error) {
+	if jsonSpec == nil {
+		return nil, nil, errors.New(errNoSpec)
+	}
+	res, err := parseSpec(jsonSpec.Raw)
+	if err != nil {
+		return nil, nil, fmt.Errorf(errParseSpec, err)
+	}
+	if len(res.Spec.Inputs) == 0 {
+		return nil, nil, errors.New(errNoInputs)
+	}
+	if len(res.Spec.Data) == 0 {
+		return nil, nil, errors.New(errNoData)
+	}
+	// the values of the inputs are served as Secrets by the client of the generator chain
+	values := make(map[string]map[string]string, len(res.Spec.Inputs))
+	for _, input := range res.Spec.Inputs {
+		var secret corev1.Secret
+		if err := kube.Get(ctx, client.ObjectKey{Namespace: namespace, Name: input.Name}, &secret); err != nil {
+			return nil, nil, fmt.Errorf(errGetInput, input.Name, err)
+		}
+		inputValues := make(map[string]string, len(secret.Data))
+		for k, v := range secret.Data {
+			inputValues[k] = string(v)
+		}
+		values[input.Name] = inputValues
+	}
+	result := make(map[string][]byte, len(res.Spec.Data))
+	for key, tpl := range res.Spec.Data {
+		value, err := templatev2.Render(key, tpl, values)
+		if err != nil {
+			return nil, nil, fmt.Errorf(errRender, key, err)
+		}
+		result[key] = value
+	}
+	return result, nil, nil
+}
+
+func (g *Generator) Cleanup(_ context.Context, _ *apiextensions.JSON, _ genv1alpha1.GeneratorProviderState, _ client.Client, _ string) error {
+	return nil
+}
+
+func parseSpec(data []byte) (*genv1alpha1.Transform, error) {
+	var spec genv1alpha1.Transform
+	err := yaml.Unmarshal(data, &spec)
+	return &spec, err
+}
+
+func init() {
+	genv1alpha1.Register(genv1alpha1.TransformKind, &Generator{})
+}
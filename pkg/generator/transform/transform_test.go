@@ -0,0 +1,143 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package transform
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/bcrypt"
+	apiextensions "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	clientfake "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	gopkcs12 "software.sslmate.com/src/go-pkcs12"
+
+	esv1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1"
+	genv1alpha1 "github.com/external-secrets/external-secrets/apis/generators/v1alpha1"
+	_ "github.com/external-secrets/external-secrets/pkg/generator/certificate"
+	"github.com/external-secrets/external-secrets/pkg/generator/chain"
+	_ "github.com/external-secrets/external-secrets/pkg/generator/password"
+)
+
+const namespace = "default"
+
+func generatorRef(kind, name string) esv1.GeneratorRef {
+	return esv1.GeneratorRef{
+		APIVersion: genv1alpha1.SchemeGroupVersion.String(),
+		Kind:       kind,
+		Name:       name,
+	}
+}
+
+// generate generates the transform with its inputs like the controllers do.
+func generate(t *testing.T, spec genv1alpha1.TransformSpec, objs ...client.Object) (map[string][]byte, error) {
+	t.Helper()
+	scheme := runtime.NewScheme()
+	require.NoError(t, clientgoscheme.AddToScheme(scheme))
+	require.NoError(t, genv1alpha1.AddToScheme(scheme))
+	kube := clientfake.NewClientBuilder().WithScheme(scheme).WithObjects(objs...).Build()
+	raw, err := json.Marshal(&genv1alpha1.Transform{Spec: spec})
+	require.NoError(t, err)
+	resource := &apiextensions.JSON{Raw: raw}
+	inputKube, err := chain.Client(context.Background(), kube, scheme, namespace, resource, "0", nil)
+	require.NoError(t, err)
+	values, _, err := (&Generator{}).Generate(context.Background(), resource, inputKube, namespace)
+	return values, err
+}
+
+func TestGenerateBcrypt(t *testing.T) {
+	password := &genv1alpha1.Password{
+		ObjectMeta: metav1.ObjectMeta{Name: "password", Namespace: namespace},
+		Spec:       genv1alpha1.PasswordSpec{Length: 24},
+	}
+	salt := &genv1alpha1.Password{
+		ObjectMeta: metav1.ObjectMeta{Name: "salt", Namespace: namespace},
+		Spec:       genv1alpha1.PasswordSpec{Length: 16, NoUpper: true, AllowRepeat: true},
+	}
+	values, err := generate(t, genv1alpha1.TransformSpec{
+		Inputs: []genv1alpha1.GeneratorInput{
+			{Name: "password", GeneratorRef: generatorRef(genv1alpha1.PasswordKind, "password")},
+			{Name: "salt", GeneratorRef: generatorRef(genv1alpha1.PasswordKind, "salt")},
+		},
+		Data: map[string]string{
+			"password": "{{ .password.password }}",
+			"hash":     "{{ .password.password | bcryptCost 4 .salt.password }}",
+		},
+	}, password, salt)
+	require.NoError(t, err)
+	assert.Len(t, values["password"], 24)
+	assert.NoError(t, bcrypt.CompareHashAndPassword(values["hash"], values["password"]))
+}
+
+func TestGeneratePKCS12(t *testing.T) {
+	certificate := &genv1alpha1.Certificate{
+		ObjectMeta: metav1.ObjectMeta{Name: "tls", Namespace: namespace},
+		Spec: genv1alpha1.CertificateSpec{
+			Subject:  &genv1alpha1.CertificateSubject{CommonName: "example.com"},
+			DNSNames: []string{"example.com"},
+		},
+	}
+	values, err := generate(t, genv1alpha1.TransformSpec{
+		Inputs: []genv1alpha1.GeneratorInput{
+			{Name: "tls", GeneratorRef: generatorRef(genv1alpha1.CertificateKind, "tls")},
+		},
+		Data: map[string]string{
+			"keystore.p12": `{{ fullPemToPkcs12Pass (index .tls "tls.crt") (index .tls "tls.key") "changeit" | b64dec }}`,
+		},
+	}, certificate)
+	require.NoError(t, err)
+	_, cert, _, err := gopkcs12.DecodeChain(values["keystore.p12"], "changeit")
+	require.NoError(t, err)
+	assert.Equal(t, "example.com", cert.Subject.CommonName)
+}
+
+func TestGenerateErrors(t *testing.T) {
+	password := &genv1alpha1.Password{
+		ObjectMeta: metav1.ObjectMeta{Name: "password", Namespace: namespace},
+		Spec:       genv1alpha1.PasswordSpec{Length: 24},
+	}
+	inputs := []genv1alpha1.GeneratorInput{{Name: "password", GeneratorRef: generatorRef(genv1alpha1.PasswordKind, "password")}}
+	tests := []struct {
+		name    string
+		spec    genv1alpha1.TransformSpec
+		wantErr string
+	}{
+		{
+			name:    "no data",
+			spec:    genv1alpha1.TransformSpec{Inputs: inputs},
+			wantErr: errNoData,
+		},
+		{
+			name: "missing key",
+			spec: genv1alpha1.TransformSpec{
+				Inputs: inputs,
+				Data:   map[string]string{"hash": "{{ .password.missing }}"},
+			},
+			wantErr: `unable to render key "hash"`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := generate(t, tt.spec, password)
+			assert.ErrorContains(t, err, tt.wantErr)
+		})
+	}
+}
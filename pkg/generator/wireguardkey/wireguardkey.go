@@ -0,0 +1,105 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package wireguardkey
+
+import (
+	"context"
+	"crypto/ecdh"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+
+	apiextensions "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+
+	genv1alpha1 "github.com/external-secrets/external-secrets/apis/generators/v1alpha1"
+)
+
+type Generator struct{}
+
+const (
+	keySize = 32
+
+	errNoSpec      = "no config spec provided"
+	errParseSpec   = "unable to parse spec: %w"
+	errGenerateKey = "unable to generate key: %w"
+)
+
+func (g *Generator) Generate(_ context.Context, jsonSpec *apiextensions.JSON, _ client.Client, _ string) (map[string][]byte, genv1alpha1.GeneratorProviderState, error) {
+	return g.generate(jsonSpec, rand.Reader)
+}
+
+func (g *Generator) Cleanup(_ context.Context, _ *apiextensions.JSON, _ genv1alpha1.GeneratorProviderState, _ client.Client, _ string) error {
+	return nil
+}
+
+func (g *Generator) generate(jsonSpec *apiextensions.JSON, random io.Reader) (map[string][]byte, genv1alpha1.GeneratorProviderState, error) {
+	if jsonSpec == nil {
+		return nil, nil, errors.New(errNoSpec)
+	}
+	res, err := parseSpec(jsonSpec.Raw)
+	if err != nil {
+		return nil, nil, fmt.Errorf(errParseSpec, err)
+	}
+	privateKey, publicKey, err := generateKeyPair(random)
+	if err != nil {
+		return nil, nil, fmt.Errorf(errGenerateKey, err)
+	}
+	result := map[string][]byte{
+		"privateKey": privateKey,
+		"publicKey":  publicKey,
+	}
+	if res.Spec.PresharedKey {
+		psk := make([]byte, keySize)
+		if _, err := io.ReadFull(random, psk); err != nil {
+			return nil, nil, fmt.Errorf(errGenerateKey, err)
+		}
+		result["presharedKey"] = encode(psk)
+	}
+	return result, nil, nil
+}
+
+// generateKeyPair returns a base64 encoded key pair like `wg genkey` and `wg pubkey`.
+func generateKeyPair(random io.Reader) (privateKey, publicKey []byte, err error) {
+	raw := make([]byte, keySize)
+	if _, err := io.ReadFull(random, raw); err != nil {
+		return nil, nil, err
+	}
+	// clamp the scalar as described in RFC 7748
+	raw[0] &= 248
+	raw[31] = (raw[31] & 127) | 64
+	key, err := ecdh.X25519().NewPrivateKey(raw)
+	if err != nil {
+		return nil, nil, err
+	}
+	return encode(key.Bytes()), encode(key.PublicKey().Bytes()), nil
+}
+
+func encode(key []byte) []byte {
+	return []byte(base64.StdEncoding.EncodeToString(key))
+}
+
+func parseSpec(data []byte) (*genv1alpha1.WireGuardKey, error) {
+	var spec genv1alpha1.WireGuardKey
+	err := yaml.Unmarshal(data, &spec)
+	return &spec, err
+}
+
+func init() {
+	genv1alpha1.Register(genv1alpha1.WireGuardKeyKind, &Generator{})
+}
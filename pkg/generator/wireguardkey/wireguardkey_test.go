@@ -0,0 +1,102 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package wireguardkey
+
+import (
+	"bytes"
+	"crypto/ecdh"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apiextensions "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+)
+
+func TestGenerate(t *testing.T) {
+	tests := []struct {
+		name        string
+		jsonSpec    *apiextensions.JSON
+		wantErr     bool
+		expectedErr string
+		wantKeys    []string
+	}{
+		{
+			name:        "nil spec should return error",
+			jsonSpec:    nil,
+			wantErr:     true,
+			expectedErr: errNoSpec,
+		},
+		{
+			name:     "empty spec generates a key pair",
+			jsonSpec: &apiextensions.JSON{Raw: []byte(`{"spec":{}}`)},
+			wantKeys: []string{"privateKey", "publicKey"},
+		},
+		{
+			name:     "preshared key",
+			jsonSpec: &apiextensions.JSON{Raw: []byte(`{"spec":{"presharedKey":true}}`)},
+			wantKeys: []string{"privateKey", "publicKey", "presharedKey"},
+		},
+		{
+			name:     "invalid json",
+			jsonSpec: &apiextensions.JSON{Raw: []byte(`{"spec":`)},
+			wantErr:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := &Generator{}
+			result, _, err := g.generate(tt.jsonSpec, rand.Reader)
+			if tt.wantErr {
+				require.Error(t, err)
+				if tt.expectedErr != "" {
+					assert.Contains(t, err.Error(), tt.expectedErr)
+				}
+				return
+			}
+			require.NoError(t, err)
+			assert.Len(t, result, len(tt.wantKeys))
+			for _, k := range tt.wantKeys {
+				raw, err := base64.StdEncoding.DecodeString(string(result[k]))
+				require.NoError(t, err)
+				assert.Len(t, raw, keySize)
+			}
+			priv, _ := base64.StdEncoding.DecodeString(string(result["privateKey"]))
+			assert.Equal(t, byte(0), priv[0]&7)
+			assert.Equal(t, byte(64), priv[31]&192)
+			key, err := ecdh.X25519().NewPrivateKey(priv)
+			require.NoError(t, err)
+			assert.Equal(t, base64.StdEncoding.EncodeToString(key.PublicKey().Bytes()), string(result["publicKey"]))
+		})
+	}
+}
+
+func TestGenerateKeyPair(t *testing.T) {
+	// vector from RFC 7748 section 6.1, the private key is returned clamped
+	random := bytes.NewReader(must(hex.DecodeString("77076d0a7318a57d3c16c17251b26645df4c2f87ebc0992ab177fba51db92c2a")))
+	privateKey, publicKey, err := generateKeyPair(random)
+	require.NoError(t, err)
+	assert.Equal(t, "cAdtCnMYpX08FsFyUbJmRd9ML4frwJkqsXf7pR25LGo=", string(privateKey))
+	assert.Equal(t, "hSDwCYkwp1R0i33ctD73Wg2/Og0mOBr066SpjqqbTmo=", string(publicKey))
+}
+
+func must(b []byte, err error) []byte {
+	if err != nil {
+		panic(err)
+	}
+	return b
+}
@@ -15,27 +15,110 @@ limitations under the License.
 package metrics
 
 import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"sync"
+	"time"
+
 	"github.com/prometheus/client_golang/prometheus"
 	"sigs.k8s.io/controller-runtime/pkg/metrics"
 
+	esv1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1"
 	"github.com/external-secrets/external-secrets/pkg/constants"
 )
 
 const (
 	ExternalSecretSubsystem = "externalsecret"
 	providerAPICalls        = "provider_api_calls_count"
+	providerAPICallDuration = "provider_api_call_duration_seconds"
+	providerAPIErrors       = "provider_api_errors_total"
 )
 
+// ErrorClassifier maps an error returned by a provider API to one of the
+// constants.ErrorReason* values. It returns an empty string if the error is unknown.
+type ErrorClassifier func(err error) string
+
 var (
 	syncCallsTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
 		Subsystem: ExternalSecretSubsystem,
 		Name:      providerAPICalls,
 		Help:      "Number of API calls towards the secret provider",
 	}, []string{"provider", "call", "status"})
+
+	apiCallDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Subsystem: ExternalSecretSubsystem,
+		Name:      providerAPICallDuration,
+		Help:      "Duration of API calls towards the secret provider",
+		Buckets:   prometheus.ExponentialBuckets(0.005, 2, 12),
+	}, []string{"provider", "call"})
+
+	apiErrorsTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Subsystem: ExternalSecretSubsystem,
+		Name:      providerAPIErrors,
+		Help:      "Number of failed API calls towards the secret provider by reason",
+	}, []string{"provider", "call", "reason"})
+
+	classifierLock sync.RWMutex
+	classifiers    = map[string]ErrorClassifier{}
 )
 
-func ObserveAPICall(provider, call string, err error) {
+// RegisterErrorClassifier registers the error mapping of a provider.
+// It should be called from the init function of the provider package.
+func RegisterErrorClassifier(provider string, classifier ErrorClassifier) {
+	classifierLock.Lock()
+	defer classifierLock.Unlock()
+	classifiers[provider] = classifier
+}
+
+// ObserveAPICall records the outcome and the latency of a call
+// towards the secret provider that has been started at start.
+func ObserveAPICall(provider, call string, start time.Time, err error) {
 	syncCallsTotal.WithLabelValues(provider, call, deriveStatus(err)).Inc()
+	apiCallDuration.WithLabelValues(provider, call).Observe(time.Since(start).Seconds())
+	if err != nil {
+		apiErrorsTotal.WithLabelValues(provider, call, ClassifyError(provider, err)).Inc()
+	}
+}
+
+// ClassifyError returns the reason of a provider error.
+// The error mapping of the provider takes precedence over the generic mapping.
+func ClassifyError(provider string, err error) string {
+	classifierLock.RLock()
+	classifier, ok := classifiers[provider]
+	classifierLock.RUnlock()
+	if ok {
+		if reason := classifier(err); reason != "" {
+			return reason
+		}
+	}
+	var netErr net.Error
+	switch {
+	case errors.Is(err, context.DeadlineExceeded), errors.As(err, &netErr) && netErr.Timeout():
+		return constants.ErrorReasonTimeout
+	case errors.Is(err, esv1.NoSecretErr):
+		return constants.ErrorReasonNotFound
+	}
+	return constants.ErrorReasonUnknown
+}
+
+// ClassifyHTTPStatus maps a HTTP status code to an error reason.
+// It returns an empty string for status codes that do not map to a reason.
+func ClassifyHTTPStatus(code int) string {
+	switch code {
+	case http.StatusUnauthorized:
+		return constants.ErrorReasonAuth
+	case http.StatusForbidden:
+		return constants.ErrorReasonPermissionDenied
+	case http.StatusNotFound:
+		return constants.ErrorReasonNotFound
+	case http.StatusTooManyRequests:
+		return constants.ErrorReasonThrottled
+	case http.StatusRequestTimeout, http.StatusGatewayTimeout:
+		return constants.ErrorReasonTimeout
+	}
+	return ""
 }
 
 func deriveStatus(err error) string {
@@ -46,5 +129,5 @@ func deriveStatus(err error) string {
 }
 
 func init() {
-	metrics.Registry.MustRegister(syncCallsTotal)
+	metrics.Registry.MustRegister(syncCallsTotal, apiCallDuration, apiErrorsTotal)
 }
@@ -0,0 +1,76 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+
+	esv1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1"
+	"github.com/external-secrets/external-secrets/pkg/constants"
+)
+
+var errThrottled = errors.New("slow down")
+
+func TestClassifyError(t *testing.T) {
+	RegisterErrorClassifier("test", func(err error) string {
+		if errors.Is(err, errThrottled) {
+			return constants.ErrorReasonThrottled
+		}
+		return ""
+	})
+
+	tests := []struct {
+		name     string
+		provider string
+		err      error
+		want     string
+	}{
+		{name: "provider mapping", provider: "test", err: fmt.Errorf("get: %w", errThrottled), want: constants.ErrorReasonThrottled},
+		{name: "provider mapping without match", provider: "test", err: context.DeadlineExceeded, want: constants.ErrorReasonTimeout},
+		{name: "no provider mapping", provider: "other", err: errThrottled, want: constants.ErrorReasonUnknown},
+		{name: "no secret", provider: "other", err: esv1.NoSecretErr, want: constants.ErrorReasonNotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ClassifyError(tt.provider, tt.err); got != tt.want {
+				t.Errorf("ClassifyError() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestObserveAPICall(t *testing.T) {
+	RegisterErrorClassifier("observe", func(error) string {
+		return constants.ErrorReasonAuth
+	})
+	ObserveAPICall("observe", "Get", time.Now(), nil)
+	ObserveAPICall("observe", "Get", time.Now().Add(-time.Second), errors.New("denied"))
+
+	if got := testutil.ToFloat64(syncCallsTotal.WithLabelValues("observe", "Get", constants.StatusError)); got != 1 {
+		t.Errorf("expected 1 failed call, got %v", got)
+	}
+	if got := testutil.ToFloat64(apiErrorsTotal.WithLabelValues("observe", "Get", constants.ErrorReasonAuth)); got != 1 {
+		t.Errorf("expected 1 auth error, got %v", got)
+	}
+	if got := testutil.CollectAndCount(apiCallDuration, "externalsecret_provider_api_call_duration_seconds"); got != 1 {
+		t.Errorf("expected 1 histogram series, got %d", got)
+	}
+}
@@ -23,6 +23,7 @@ import (
 	"io"
 	"os"
 	"strings"
+	"time"
 
 	aws_cloud_id "github.com/akeylesslabs/akeyless-go-cloud-id/cloudprovider/aws"
 	azure_cloud_id "github.com/akeylesslabs/akeyless-go-cloud-id/cloudprovider/azure"
@@ -77,8 +78,9 @@ func (a *akeylessBase) GetToken(ctx context.Context, accessID, accType, accTypeP
 		authBody.CloudId = akeyless.PtrString(cloudID)
 	}
 
+	start := time.Now()
 	authOut, res, err := a.RestAPI.Auth(ctx).Body(*authBody).Execute()
-	metrics.ObserveAPICall(constants.ProviderAKEYLESSSM, constants.CallAKEYLESSSMAuth, err)
+	metrics.ObserveAPICall(constants.ProviderAKEYLESSSM, constants.CallAKEYLESSSMAuth, start, err)
 	if errors.As(err, &apiErr) {
 		return "", fmt.Errorf("authentication failed: %v", string(apiErr.Body()))
 	}
@@ -135,8 +137,9 @@ func (a *akeylessBase) DescribeItem(ctx context.Context, itemName string) (*akey
 	if err := SetBodyToken(&body, ctx); err != nil {
 		return nil, err
 	}
+	start := time.Now()
 	gsvOut, res, err := a.RestAPI.DescribeItem(ctx).Body(body).Execute()
-	metrics.ObserveAPICall(constants.ProviderAKEYLESSSM, constants.CallAKEYLESSSMDescribeItem, err)
+	metrics.ObserveAPICall(constants.ProviderAKEYLESSSM, constants.CallAKEYLESSSMDescribeItem, start, err)
 	if errors.As(err, &apiErr) {
 		var item *Item
 		err = json.Unmarshal(apiErr.Body(), &item)
@@ -162,8 +165,9 @@ func (a *akeylessBase) GetCertificate(ctx context.Context, certificateName strin
 	if err := SetBodyToken(&body, ctx); err != nil {
 		return "", err
 	}
+	start := time.Now()
 	gcvOut, res, err := a.RestAPI.GetCertificateValue(ctx).Body(body).Execute()
-	metrics.ObserveAPICall(constants.ProviderAKEYLESSSM, constants.CallAKEYLESSSMGetCertificateValue, err)
+	metrics.ObserveAPICall(constants.ProviderAKEYLESSSM, constants.CallAKEYLESSSMGetCertificateValue, start, err)
 	if errors.As(err, &apiErr) {
 		return "", fmt.Errorf("can't get certificate value: %v", string(apiErr.Body()))
 	}
@@ -190,8 +194,9 @@ func (a *akeylessBase) GetRotatedSecrets(ctx context.Context, secretName string,
 	if err := SetBodyToken(&body, ctx); err != nil {
 		return "", err
 	}
+	start := time.Now()
 	gsvOut, res, err := a.RestAPI.GetRotatedSecretValue(ctx).Body(body).Execute()
-	metrics.ObserveAPICall(constants.ProviderAKEYLESSSM, constants.CallAKEYLESSSMGetRotatedSecretValue, err)
+	metrics.ObserveAPICall(constants.ProviderAKEYLESSSM, constants.CallAKEYLESSSMGetRotatedSecretValue, start, err)
 	if errors.As(err, &apiErr) {
 		return "", fmt.Errorf("can't get rotated secret value: %v", string(apiErr.Body()))
 	}
@@ -231,8 +236,9 @@ func (a *akeylessBase) GetDynamicSecrets(ctx context.Context, secretName string)
 	if err := SetBodyToken(&body, ctx); err != nil {
 		return "", err
 	}
+	start := time.Now()
 	gsvOut, res, err := a.RestAPI.GetDynamicSecretValue(ctx).Body(body).Execute()
-	metrics.ObserveAPICall(constants.ProviderAKEYLESSSM, constants.CallAKEYLESSSMGetDynamicSecretValue, err)
+	metrics.ObserveAPICall(constants.ProviderAKEYLESSSM, constants.CallAKEYLESSSMGetDynamicSecretValue, start, err)
 	if errors.As(err, &apiErr) {
 		return "", fmt.Errorf("can't get dynamic secret value: %v", string(apiErr.Body()))
 	}
@@ -257,8 +263,9 @@ func (a *akeylessBase) GetStaticSecret(ctx context.Context, secretName string, v
 	if err := SetBodyToken(&body, ctx); err != nil {
 		return "", err
 	}
+	start := time.Now()
 	gsvOut, res, err := a.RestAPI.GetSecretValue(ctx).Body(body).Execute()
-	metrics.ObserveAPICall(constants.ProviderAKEYLESSSM, constants.CallAKEYLESSSMGetSecretValue, err)
+	metrics.ObserveAPICall(constants.ProviderAKEYLESSSM, constants.CallAKEYLESSSMGetSecretValue, start, err)
 	if errors.As(err, &apiErr) {
 		return "", fmt.Errorf("can't get secret value: %v", string(apiErr.Body()))
 	}
@@ -307,8 +314,9 @@ func (a *akeylessBase) ListSecrets(ctx context.Context, path, tag string) ([]str
 	if err := SetBodyToken(&body, ctx); err != nil {
 		return nil, err
 	}
+	start := time.Now()
 	lipOut, res, err := a.RestAPI.ListItems(ctx).Body(body).Execute()
-	metrics.ObserveAPICall(constants.ProviderAKEYLESSSM, constants.CallAKEYLESSSMListItems, err)
+	metrics.ObserveAPICall(constants.ProviderAKEYLESSSM, constants.CallAKEYLESSSMListItems, start, err)
 	if errors.As(err, &apiErr) {
 		return nil, fmt.Errorf("can't get secrets list: %v", string(apiErr.Body()))
 	}
@@ -340,11 +348,12 @@ func (a *akeylessBase) CreateSecret(ctx context.Context, remoteKey, data string)
 	if err := SetBodyToken(&body, ctx); err != nil {
 		return err
 	}
+	start := time.Now()
 	_, res, err := a.RestAPI.CreateSecret(ctx).Body(body).Execute()
 	defer func() {
 		_ = res.Body.Close()
 	}()
-	metrics.ObserveAPICall(constants.ProviderAKEYLESSSM, constants.CallAKEYLESSSMCreateSecret, err)
+	metrics.ObserveAPICall(constants.ProviderAKEYLESSSM, constants.CallAKEYLESSSMCreateSecret, start, err)
 	return err
 }
 
@@ -356,11 +365,12 @@ func (a *akeylessBase) UpdateSecret(ctx context.Context, remoteKey, data string)
 	if err := SetBodyToken(&body, ctx); err != nil {
 		return err
 	}
+	start := time.Now()
 	_, res, err := a.RestAPI.UpdateSecretVal(ctx).Body(body).Execute()
 	defer func() {
 		_ = res.Body.Close()
 	}()
-	metrics.ObserveAPICall(constants.ProviderAKEYLESSSM, constants.CallAKEYLESSSMUpdateSecretVal, err)
+	metrics.ObserveAPICall(constants.ProviderAKEYLESSSM, constants.CallAKEYLESSSMUpdateSecretVal, start, err)
 	return err
 }
 
@@ -371,11 +381,12 @@ func (a *akeylessBase) DeleteSecret(ctx context.Context, remoteKey string) error
 	if err := SetBodyToken(&body, ctx); err != nil {
 		return err
 	}
+	start := time.Now()
 	_, res, err := a.RestAPI.DeleteItem(ctx).Body(body).Execute()
 	defer func() {
 		_ = res.Body.Close()
 	}()
-	metrics.ObserveAPICall(constants.ProviderAKEYLESSSM, constants.CallAKEYLESSSMDeleteItem, err)
+	metrics.ObserveAPICall(constants.ProviderAKEYLESSSM, constants.CallAKEYLESSSMDeleteItem, start, err)
 	return err
 }
 
@@ -20,6 +20,7 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/ssm"
@@ -110,8 +111,9 @@ func (pm *ParameterStore) getTagsByName(ctx context.Context, ref *ssm.GetParamet
 		ResourceType: ssmTypes.ResourceTypeForTagging(parameterType),
 	}
 
+	start := time.Now()
 	data, err := pm.client.ListTagsForResource(ctx, &parameterTags)
-	metrics.ObserveAPICall(constants.ProviderAWSPS, constants.CallAWSPSListTagsForResource, err)
+	metrics.ObserveAPICall(constants.ProviderAWSPS, constants.CallAWSPSListTagsForResource, start, err)
 	if err != nil {
 		return nil, fmt.Errorf("error listing tags %w", err)
 	}
@@ -128,8 +130,9 @@ func (pm *ParameterStore) DeleteSecret(ctx context.Context, remoteRef esv1.PushS
 	secretValue := ssm.GetParameterInput{
 		Name: &secretName,
 	}
+	start := time.Now()
 	existing, err := pm.client.GetParameter(ctx, &secretValue)
-	metrics.ObserveAPICall(constants.ProviderAWSPS, constants.CallAWSPSGetParameter, err)
+	metrics.ObserveAPICall(constants.ProviderAWSPS, constants.CallAWSPSGetParameter, start, err)
 	var parameterNotFoundErr *ssmTypes.ParameterNotFound
 	ok := errors.As(err, &parameterNotFoundErr)
 	if err != nil && !ok {
@@ -150,8 +153,9 @@ func (pm *ParameterStore) DeleteSecret(ctx context.Context, remoteRef esv1.PushS
 		deleteInput := &ssm.DeleteParameterInput{
 			Name: &secretName,
 		}
+		start := time.Now()
 		_, err = pm.client.DeleteParameter(ctx, deleteInput)
-		metrics.ObserveAPICall(constants.ProviderAWSPS, constants.CallAWSPSDeleteParameter, err)
+		metrics.ObserveAPICall(constants.ProviderAWSPS, constants.CallAWSPSDeleteParameter, start, err)
 		if err != nil {
 			return fmt.Errorf("could not delete parameter %v: %w", secretName, err)
 		}
@@ -240,8 +244,9 @@ func (pm *ParameterStore) PushSecret(ctx context.Context, secret *corev1.Secret,
 		WithDecryption: aws.Bool(true),
 	}
 
+	start := time.Now()
 	existing, err := pm.client.GetParameter(ctx, &secretValue)
-	metrics.ObserveAPICall(constants.ProviderAWSPS, constants.CallAWSPSGetParameter, err)
+	metrics.ObserveAPICall(constants.ProviderAWSPS, constants.CallAWSPSGetParameter, start, err)
 	var parameterNotFoundErr *ssmTypes.ParameterNotFound
 	ok := errors.As(err, &parameterNotFoundErr)
 	if err != nil && !ok {
@@ -304,12 +309,13 @@ func (pm *ParameterStore) setExisting(ctx context.Context, existing *ssm.GetPara
 
 	tagKeysToRemove := util.FindTagKeysToRemove(tags, metaTags)
 	if len(tagKeysToRemove) > 0 {
+		start := time.Now()
 		_, err = pm.client.RemoveTagsFromResource(ctx, &ssm.RemoveTagsFromResourceInput{
 			ResourceId:   existing.Parameter.Name,
 			ResourceType: ssmTypes.ResourceTypeForTaggingParameter,
 			TagKeys:      tagKeysToRemove,
 		})
-		metrics.ObserveAPICall(constants.ProviderAWSPS, constants.CallAWSPSRemoveTagsParameter, err)
+		metrics.ObserveAPICall(constants.ProviderAWSPS, constants.CallAWSPSRemoveTagsParameter, start, err)
 		if err != nil {
 			return err
 		}
@@ -317,12 +323,13 @@ func (pm *ParameterStore) setExisting(ctx context.Context, existing *ssm.GetPara
 
 	tagsToUpdate, isModified := computeTagsToUpdate(tags, metaTags)
 	if isModified {
+		start := time.Now()
 		_, err = pm.client.AddTagsToResource(ctx, &ssm.AddTagsToResourceInput{
 			ResourceId:   existing.Parameter.Name,
 			ResourceType: ssmTypes.ResourceTypeForTaggingParameter,
 			Tags:         tagsToUpdate,
 		})
-		metrics.ObserveAPICall(constants.ProviderAWSPS, constants.CallAWSPSAddTagsParameter, err)
+		metrics.ObserveAPICall(constants.ProviderAWSPS, constants.CallAWSPSAddTagsParameter, start, err)
 		if err != nil {
 			return err
 		}
@@ -343,8 +350,9 @@ func (pm *ParameterStore) setManagedRemoteParameter(ctx context.Context, secretR
 		overwrite = false
 	}
 
+	start := time.Now()
 	_, err := pm.client.PutParameter(ctx, &secretRequest)
-	metrics.ObserveAPICall(constants.ProviderAWSPS, constants.CallAWSPSPutParameter, err)
+	metrics.ObserveAPICall(constants.ProviderAWSPS, constants.CallAWSPSPutParameter, start, err)
 	if err != nil {
 		return fmt.Errorf("unexpected error pushing parameter %v: %w", secretRequest.Name, err)
 	}
@@ -374,6 +382,7 @@ func (pm *ParameterStore) findByName(ctx context.Context, ref esv1.ExternalSecre
 	data := make(map[string][]byte)
 	var nextToken *string
 	for {
+		start := time.Now()
 		it, err := pm.client.GetParametersByPath(
 			ctx,
 			&ssm.GetParametersByPathInput{
@@ -382,7 +391,7 @@ func (pm *ParameterStore) findByName(ctx context.Context, ref esv1.ExternalSecre
 				Recursive:      aws.Bool(true),
 				WithDecryption: aws.Bool(true),
 			})
-		metrics.ObserveAPICall(constants.ProviderAWSPS, constants.CallAWSPSGetParametersByPath, err)
+		metrics.ObserveAPICall(constants.ProviderAWSPS, constants.CallAWSPSGetParametersByPath, start, err)
 		if err != nil {
 			var apiErr smithy.APIError
 			if errors.As(err, &apiErr) && apiErr.ErrorCode() == errCodeAccessDeniedException {
@@ -426,13 +435,14 @@ func (pm *ParameterStore) fallbackFindByName(ctx context.Context, ref esv1.Exter
 	data := make(map[string][]byte)
 	var nextToken *string
 	for {
+		start := time.Now()
 		it, err := pm.client.DescribeParameters(
 			ctx,
 			&ssm.DescribeParametersInput{
 				NextToken:        nextToken,
 				ParameterFilters: pathFilter,
 			})
-		metrics.ObserveAPICall(constants.ProviderAWSPS, constants.CallAWSPSDescribeParameter, err)
+		metrics.ObserveAPICall(constants.ProviderAWSPS, constants.CallAWSPSDescribeParameter, start, err)
 		if err != nil {
 			return nil, err
 		}
@@ -475,13 +485,14 @@ func (pm *ParameterStore) findByTags(ctx context.Context, ref esv1.ExternalSecre
 	data := make(map[string][]byte)
 	var nextToken *string
 	for {
+		start := time.Now()
 		it, err := pm.client.DescribeParameters(
 			ctx,
 			&ssm.DescribeParametersInput{
 				ParameterFilters: filters,
 				NextToken:        nextToken,
 			})
-		metrics.ObserveAPICall(constants.ProviderAWSPS, constants.CallAWSPSDescribeParameter, err)
+		metrics.ObserveAPICall(constants.ProviderAWSPS, constants.CallAWSPSDescribeParameter, start, err)
 		if err != nil {
 			return nil, err
 		}
@@ -501,11 +512,12 @@ func (pm *ParameterStore) findByTags(ctx context.Context, ref esv1.ExternalSecre
 }
 
 func (pm *ParameterStore) fetchAndSet(ctx context.Context, data map[string][]byte, name string) error {
+	start := time.Now()
 	out, err := pm.client.GetParameter(ctx, &ssm.GetParameterInput{
 		Name:           ptr.To(name),
 		WithDecryption: aws.Bool(true),
 	})
-	metrics.ObserveAPICall(constants.ProviderAWSPS, constants.CallAWSPSGetParameter, err)
+	metrics.ObserveAPICall(constants.ProviderAWSPS, constants.CallAWSPSGetParameter, start, err)
 	if err != nil {
 		return util.SanitizeErr(err)
 	}
@@ -518,12 +530,13 @@ func (pm *ParameterStore) fetchAndSet(ctx context.Context, data map[string][]byt
 func (pm *ParameterStore) GetSecret(ctx context.Context, ref esv1.ExternalSecretDataRemoteRef) ([]byte, error) {
 	var out *ssm.GetParameterOutput
 	var err error
+	start := time.Now()
 	if ref.MetadataPolicy == esv1.ExternalSecretMetadataPolicyFetch {
 		out, err = pm.getParameterTags(ctx, ref)
 	} else {
 		out, err = pm.getParameterValue(ctx, ref)
 	}
-	metrics.ObserveAPICall(constants.ProviderAWSPS, constants.CallAWSPSGetParameter, err)
+	metrics.ObserveAPICall(constants.ProviderAWSPS, constants.CallAWSPSGetParameter, start, err)
 	nsf := esv1.NoSecretError{}
 	var nf *ssmTypes.ParameterNotFound
 	if errors.As(err, &nf) || errors.As(err, &nsf) {
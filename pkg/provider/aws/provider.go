@@ -28,6 +28,8 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 
 	esv1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1"
+	"github.com/external-secrets/external-secrets/pkg/constants"
+	"github.com/external-secrets/external-secrets/pkg/metrics"
 	awsauth "github.com/external-secrets/external-secrets/pkg/provider/aws/auth"
 	"github.com/external-secrets/external-secrets/pkg/provider/aws/parameterstore"
 	"github.com/external-secrets/external-secrets/pkg/provider/aws/secretsmanager"
@@ -37,6 +39,7 @@ import (
 
 // https://github.com/external-secrets/external-secrets/issues/644
 var _ esv1.Provider = &Provider{}
+var _ esv1.ReferentProvider = &Provider{}
 
 // Provider satisfies the provider interface.
 type Provider struct{}
@@ -54,6 +57,12 @@ func (p *Provider) Capabilities() esv1.SecretStoreCapabilities {
 	return esv1.SecretStoreReadWrite
 }
 
+// IsReferent returns true if the store references credentials without a namespace.
+func (p *Provider) IsReferent(store esv1.GenericStore) bool {
+	prov := store.GetSpec().Provider.AWS
+	return prov != nil && util.IsReferentSpec(prov.Auth)
+}
+
 // NewClient constructs a new secrets client based on the provided store.
 func (p *Provider) NewClient(ctx context.Context, store esv1.GenericStore, kube client.Client, namespace string) (esv1.SecretsClient, error) {
 	return newClient(ctx, store, kube, namespace, awsauth.DefaultSTSProvider)
@@ -228,4 +237,6 @@ func init() {
 	esv1.Register(&Provider{}, &esv1.SecretStoreProvider{
 		AWS: &esv1.AWSProvider{},
 	}, esv1.MaintenanceStatusMaintained)
+	metrics.RegisterErrorClassifier(constants.ProviderAWSSM, util.ClassifyError)
+	metrics.RegisterErrorClassifier(constants.ProviderAWSPS, util.ClassifyError)
 }
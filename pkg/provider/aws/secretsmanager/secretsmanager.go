@@ -23,6 +23,7 @@ import (
 	"math/big"
 	"slices"
 	"strings"
+	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	awssm "github.com/aws/aws-sdk-go-v2/service/secretsmanager"
@@ -147,8 +148,9 @@ func (sm *SecretsManager) DeleteSecret(ctx context.Context, remoteRef esv1.PushS
 	secretInput := awssm.DescribeSecretInput{
 		SecretId: &secretName,
 	}
+	start := time.Now()
 	awsSecret, err := sm.client.GetSecretValue(ctx, &secretValue)
-	metrics.ObserveAPICall(constants.ProviderAWSSM, constants.CallAWSSMGetSecretValue, err)
+	metrics.ObserveAPICall(constants.ProviderAWSSM, constants.CallAWSSMGetSecretValue, start, err)
 	var aerr smithy.APIError
 	if err != nil {
 		if ok := errors.As(err, &aerr); !ok {
@@ -159,8 +161,9 @@ func (sm *SecretsManager) DeleteSecret(ctx context.Context, remoteRef esv1.PushS
 		}
 		return err
 	}
+	start = time.Now()
 	data, err := sm.client.DescribeSecret(ctx, &secretInput)
-	metrics.ObserveAPICall(constants.ProviderAWSSM, constants.CallAWSSMDescribeSecret, err)
+	metrics.ObserveAPICall(constants.ProviderAWSSM, constants.CallAWSSMDescribeSecret, start, err)
 	if err != nil {
 		return err
 	}
@@ -180,8 +183,9 @@ func (sm *SecretsManager) DeleteSecret(ctx context.Context, remoteRef esv1.PushS
 	if err != nil {
 		return err
 	}
+	start = time.Now()
 	_, err = sm.client.DeleteSecret(ctx, deleteInput)
-	metrics.ObserveAPICall(constants.ProviderAWSSM, constants.CallAWSSMDeleteSecret, err)
+	metrics.ObserveAPICall(constants.ProviderAWSSM, constants.CallAWSSMDeleteSecret, start, err)
 	return err
 }
 
@@ -223,8 +227,9 @@ func (sm *SecretsManager) PushSecret(ctx context.Context, secret *corev1.Secret,
 		SecretId: &secretName,
 	}
 
+	start := time.Now()
 	awsSecret, err := sm.client.GetSecretValue(ctx, &secretValue)
-	metrics.ObserveAPICall(constants.ProviderAWSSM, constants.CallAWSSMGetSecretValue, err)
+	metrics.ObserveAPICall(constants.ProviderAWSSM, constants.CallAWSSMGetSecretValue, start, err)
 
 	if psd.GetProperty() != "" {
 		currentSecret := sm.retrievePayload(awsSecret)
@@ -331,11 +336,12 @@ func (sm *SecretsManager) findByName(ctx context.Context, ref esv1.ExternalSecre
 	for {
 		// I put this into the for loop on purpose.
 		log.V(0).Info("using ListSecret to fetch all secrets; this is a costly operations, please use batching by defining a _path_")
+		start := time.Now()
 		it, err := sm.client.ListSecrets(ctx, &awssm.ListSecretsInput{
 			Filters:   filters,
 			NextToken: nextToken,
 		})
-		metrics.ObserveAPICall(constants.ProviderAWSSM, constants.CallAWSSMListSecrets, err)
+		metrics.ObserveAPICall(constants.ProviderAWSSM, constants.CallAWSSMListSecrets, start, err)
 		if err != nil {
 			return nil, err
 		}
@@ -537,15 +543,17 @@ func (sm *SecretsManager) createSecretWithContext(ctx context.Context, secretNam
 		input.SecretString = aws.String(string(value))
 	}
 
+	start := time.Now()
 	_, err = sm.client.CreateSecret(ctx, input)
-	metrics.ObserveAPICall(constants.ProviderAWSSM, constants.CallAWSSMCreateSecret, err)
+	metrics.ObserveAPICall(constants.ProviderAWSSM, constants.CallAWSSMCreateSecret, start, err)
 
 	return err
 }
 
 func (sm *SecretsManager) putSecretValueWithContext(ctx context.Context, secretInput awssm.DescribeSecretInput, awsSecret *awssm.GetSecretValueOutput, psd esv1.PushSecretData, value []byte) error {
+	start := time.Now()
 	data, err := sm.client.DescribeSecret(ctx, &secretInput)
-	metrics.ObserveAPICall(constants.ProviderAWSSM, constants.CallAWSSMDescribeSecret, err)
+	metrics.ObserveAPICall(constants.ProviderAWSSM, constants.CallAWSSMDescribeSecret, start, err)
 	if err != nil {
 		return err
 	}
@@ -574,8 +582,9 @@ func (sm *SecretsManager) putSecretValueWithContext(ctx context.Context, secretI
 		input.SecretString = aws.String(string(value))
 	}
 
+	start = time.Now()
 	_, err = sm.client.PutSecretValue(ctx, input)
-	metrics.ObserveAPICall(constants.ProviderAWSSM, constants.CallAWSSMPutSecretValue, err)
+	metrics.ObserveAPICall(constants.ProviderAWSSM, constants.CallAWSSMPutSecretValue, start, err)
 	if err != nil {
 		return err
 	}
@@ -595,11 +604,12 @@ func (sm *SecretsManager) patchTags(ctx context.Context, metadata *apiextensions
 
 	tagKeysToRemove := util.FindTagKeysToRemove(tags, meta.Spec.Tags)
 	if len(tagKeysToRemove) > 0 {
+		start := time.Now()
 		_, err = sm.client.UntagResource(ctx, &awssm.UntagResourceInput{
 			SecretId: secretId,
 			TagKeys:  tagKeysToRemove,
 		})
-		metrics.ObserveAPICall(constants.ProviderAWSSM, constants.CallAWSSMUntagResource, err)
+		metrics.ObserveAPICall(constants.ProviderAWSSM, constants.CallAWSSMUntagResource, start, err)
 		if err != nil {
 			return err
 		}
@@ -607,11 +617,12 @@ func (sm *SecretsManager) patchTags(ctx context.Context, metadata *apiextensions
 
 	tagsToUpdate, isModified := computeTagsToUpdate(tags, meta.Spec.Tags)
 	if isModified {
+		start := time.Now()
 		_, err = sm.client.TagResource(ctx, &awssm.TagResourceInput{
 			SecretId: secretId,
 			Tags:     tagsToUpdate,
 		})
-		metrics.ObserveAPICall(constants.ProviderAWSSM, constants.CallAWSSMTagResource, err)
+		metrics.ObserveAPICall(constants.ProviderAWSSM, constants.CallAWSSMTagResource, start, err)
 		if err != nil {
 			return err
 		}
@@ -624,11 +635,12 @@ func (sm *SecretsManager) fetchWithBatch(ctx context.Context, filters []types.Fi
 	var nextToken *string
 
 	for {
+		start := time.Now()
 		it, err := sm.client.BatchGetSecretValue(ctx, &awssm.BatchGetSecretValueInput{
 			Filters:   filters,
 			NextToken: nextToken,
 		})
-		metrics.ObserveAPICall(constants.ProviderAWSSM, constants.CallAWSSMBatchGetSecretValue, err)
+		metrics.ObserveAPICall(constants.ProviderAWSSM, constants.CallAWSSMBatchGetSecretValue, start, err)
 		if err != nil {
 			return nil, err
 		}
@@ -697,8 +709,9 @@ func (sm *SecretsManager) constructSecretValue(ctx context.Context, key, ver str
 			VersionStage: &ver,
 		}
 	}
+	start := time.Now()
 	secretOut, err := sm.client.GetSecretValue(ctx, getSecretValueInput)
-	metrics.ObserveAPICall(constants.ProviderAWSSM, constants.CallAWSSMGetSecretValue, err)
+	metrics.ObserveAPICall(constants.ProviderAWSSM, constants.CallAWSSMGetSecretValue, start, err)
 	var (
 		nf *types.ResourceNotFoundException
 		ie *types.InvalidParameterException
@@ -17,6 +17,10 @@ package util
 import (
 	"errors"
 	"regexp"
+
+	"github.com/aws/smithy-go"
+
+	"github.com/external-secrets/external-secrets/pkg/constants"
 )
 
 var regexReqIDs = []*regexp.Regexp{
@@ -32,3 +36,32 @@ func SanitizeErr(err error) error {
 	}
 	return errors.New(msg)
 }
+
+var errorReasons = map[string]string{
+	"ResourceNotFoundException":   constants.ErrorReasonNotFound,
+	"ParameterNotFound":           constants.ErrorReasonNotFound,
+	"ParameterVersionNotFound":    constants.ErrorReasonNotFound,
+	"AccessDeniedException":       constants.ErrorReasonPermissionDenied,
+	"AccessDenied":                constants.ErrorReasonPermissionDenied,
+	"UnrecognizedClientException": constants.ErrorReasonAuth,
+	"InvalidClientTokenId":        constants.ErrorReasonAuth,
+	"InvalidSignatureException":   constants.ErrorReasonAuth,
+	"ExpiredTokenException":       constants.ErrorReasonAuth,
+	"ExpiredToken":                constants.ErrorReasonAuth,
+	"MissingAuthenticationToken":  constants.ErrorReasonAuth,
+	"ThrottlingException":         constants.ErrorReasonThrottled,
+	"Throttling":                  constants.ErrorReasonThrottled,
+	"TooManyRequestsException":    constants.ErrorReasonThrottled,
+	"RequestLimitExceeded":        constants.ErrorReasonThrottled,
+	"RequestTimeout":              constants.ErrorReasonTimeout,
+	"RequestTimeoutException":     constants.ErrorReasonTimeout,
+}
+
+// ClassifyError maps the error code of an AWS API error to an error reason.
+func ClassifyError(err error) string {
+	var aerr smithy.APIError
+	if errors.As(err, &aerr) {
+		return errorReasons[aerr.ErrorCode()]
+	}
+	return ""
+}
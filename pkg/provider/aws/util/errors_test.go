@@ -16,9 +16,13 @@ package util
 
 import (
 	"errors"
+	"fmt"
 	"testing"
 
+	"github.com/aws/smithy-go"
 	"github.com/stretchr/testify/assert"
+
+	"github.com/external-secrets/external-secrets/pkg/constants"
 )
 
 func TestSanitize(t *testing.T) {
@@ -45,3 +49,39 @@ func TestSanitize(t *testing.T) {
 		assert.Equal(t, c.expected, out.Error())
 	}
 }
+
+func TestClassifyError(t *testing.T) {
+	tbl := []struct {
+		err      error
+		expected string
+	}{
+		{
+			err:      &smithy.GenericAPIError{Code: "ResourceNotFoundException"},
+			expected: constants.ErrorReasonNotFound,
+		},
+		{
+			err:      fmt.Errorf("wrapped: %w", &smithy.GenericAPIError{Code: "ThrottlingException"}),
+			expected: constants.ErrorReasonThrottled,
+		},
+		{
+			err:      &smithy.GenericAPIError{Code: "AccessDeniedException"},
+			expected: constants.ErrorReasonPermissionDenied,
+		},
+		{
+			err:      &smithy.GenericAPIError{Code: "UnrecognizedClientException"},
+			expected: constants.ErrorReasonAuth,
+		},
+		{
+			err:      &smithy.GenericAPIError{Code: "InternalServiceError"},
+			expected: "",
+		},
+		{
+			err:      errors.New("some generic error"),
+			expected: "",
+		},
+	}
+
+	for _, c := range tbl {
+		assert.Equal(t, c.expected, ClassifyError(c.err))
+	}
+}
@@ -100,6 +100,7 @@ const (
 // https://github.com/external-secrets/external-secrets/issues/644
 var _ esv1.SecretsClient = &Azure{}
 var _ esv1.Provider = &Azure{}
+var _ esv1.ReferentProvider = &Azure{}
 
 // interface to keyvault.BaseClient.
 type SecretClient interface {
@@ -140,6 +141,12 @@ func (a *Azure) Capabilities() esv1.SecretStoreCapabilities {
 	return esv1.SecretStoreReadWrite
 }
 
+// IsReferent returns true if the store references credentials without a namespace.
+func (a *Azure) IsReferent(store esv1.GenericStore) bool {
+	prov := store.GetSpec().Provider.AzureKV
+	return prov != nil && isReferentSpec(prov)
+}
+
 // NewClient constructs a new secrets client based on the provided store.
 func (a *Azure) NewClient(ctx context.Context, store esv1.GenericStore, kube client.Client, namespace string) (esv1.SecretsClient, error) {
 	return newClient(ctx, store, kube, namespace)
@@ -257,15 +264,17 @@ func canDelete(tags map[string]*string, err error) (bool, error) {
 }
 
 func (a *Azure) deleteKeyVaultKey(ctx context.Context, keyName string) error {
+	start := time.Now()
 	value, err := a.baseClient.GetKey(ctx, *a.provider.VaultURL, keyName, "")
-	metrics.ObserveAPICall(constants.ProviderAzureKV, constants.CallAzureKVGetKey, err)
+	metrics.ObserveAPICall(constants.ProviderAzureKV, constants.CallAzureKVGetKey, start, err)
 	ok, err := canDelete(value.Tags, err)
 	if err != nil {
 		return fmt.Errorf("error getting key %v: %w", keyName, err)
 	}
 	if ok {
+		start := time.Now()
 		_, err = a.baseClient.DeleteKey(ctx, *a.provider.VaultURL, keyName)
-		metrics.ObserveAPICall(constants.ProviderAzureKV, constants.CallAzureKVDeleteKey, err)
+		metrics.ObserveAPICall(constants.ProviderAzureKV, constants.CallAzureKVDeleteKey, start, err)
 		if err != nil {
 			return fmt.Errorf("error deleting key %v: %w", keyName, err)
 		}
@@ -274,15 +283,17 @@ func (a *Azure) deleteKeyVaultKey(ctx context.Context, keyName string) error {
 }
 
 func (a *Azure) deleteKeyVaultSecret(ctx context.Context, secretName string) error {
+	start := time.Now()
 	value, err := a.baseClient.GetSecret(ctx, *a.provider.VaultURL, secretName, "")
-	metrics.ObserveAPICall(constants.ProviderAzureKV, constants.CallAzureKVGetSecret, err)
+	metrics.ObserveAPICall(constants.ProviderAzureKV, constants.CallAzureKVGetSecret, start, err)
 	ok, err := canDelete(value.Tags, err)
 	if err != nil {
 		return fmt.Errorf("error getting secret %v: %w", secretName, err)
 	}
 	if ok {
+		start := time.Now()
 		_, err = a.baseClient.DeleteSecret(ctx, *a.provider.VaultURL, secretName)
-		metrics.ObserveAPICall(constants.ProviderAzureKV, constants.CallAzureKVDeleteSecret, err)
+		metrics.ObserveAPICall(constants.ProviderAzureKV, constants.CallAzureKVDeleteSecret, start, err)
 		if err != nil {
 			return fmt.Errorf("error deleting secret %v: %w", secretName, err)
 		}
@@ -291,15 +302,17 @@ func (a *Azure) deleteKeyVaultSecret(ctx context.Context, secretName string) err
 }
 
 func (a *Azure) deleteKeyVaultCertificate(ctx context.Context, certName string) error {
+	start := time.Now()
 	value, err := a.baseClient.GetCertificate(ctx, *a.provider.VaultURL, certName, "")
-	metrics.ObserveAPICall(constants.ProviderAzureKV, constants.CallAzureKVGetCertificate, err)
+	metrics.ObserveAPICall(constants.ProviderAzureKV, constants.CallAzureKVGetCertificate, start, err)
 	ok, err := canDelete(value.Tags, err)
 	if err != nil {
 		return fmt.Errorf("error getting certificate %v: %w", certName, err)
 	}
 	if ok {
+		start := time.Now()
 		_, err = a.baseClient.DeleteCertificate(ctx, *a.provider.VaultURL, certName)
-		metrics.ObserveAPICall(constants.ProviderAzureKV, constants.CallAzureKVDeleteCertificate, err)
+		metrics.ObserveAPICall(constants.ProviderAzureKV, constants.CallAzureKVDeleteCertificate, start, err)
 		if err != nil {
 			return fmt.Errorf("error deleting certificate %v: %w", certName, err)
 		}
@@ -422,8 +435,9 @@ func canCreate(tags map[string]*string, err error) (bool, error) {
 }
 
 func (a *Azure) setKeyVaultSecret(ctx context.Context, secretName string, value []byte, expires *date.UnixTime, tags map[string]string) error {
+	start := time.Now()
 	secret, err := a.baseClient.GetSecret(ctx, *a.provider.VaultURL, secretName, "")
-	metrics.ObserveAPICall(constants.ProviderAzureKV, constants.CallAzureKVGetSecret, err)
+	metrics.ObserveAPICall(constants.ProviderAzureKV, constants.CallAzureKVGetSecret, start, err)
 	ok, err := canCreate(secret.Tags, err)
 	if err != nil {
 		return fmt.Errorf("cannot get secret %v: %w", secretName, err)
@@ -459,8 +473,9 @@ func (a *Azure) setKeyVaultSecret(ctx context.Context, secretName string, value
 		secretParams.SecretAttributes.Expires = expires
 	}
 
+	start = time.Now()
 	_, err = a.baseClient.SetSecret(ctx, *a.provider.VaultURL, secretName, secretParams)
-	metrics.ObserveAPICall(constants.ProviderAzureKV, constants.CallAzureKVGetSecret, err)
+	metrics.ObserveAPICall(constants.ProviderAzureKV, constants.CallAzureKVGetSecret, start, err)
 	if err != nil {
 		return fmt.Errorf("could not set secret %v: %w", secretName, err)
 	}
@@ -473,8 +488,9 @@ func (a *Azure) setKeyVaultCertificate(ctx context.Context, secretName string, v
 	if err != nil {
 		return fmt.Errorf("value from secret is not a valid certificate: %w", err)
 	}
+	start := time.Now()
 	cert, err := a.baseClient.GetCertificate(ctx, *a.provider.VaultURL, secretName, "")
-	metrics.ObserveAPICall(constants.ProviderAzureKV, constants.CallAzureKVGetCertificate, err)
+	metrics.ObserveAPICall(constants.ProviderAzureKV, constants.CallAzureKVGetCertificate, start, err)
 	ok, err := canCreate(cert.Tags, err)
 	if err != nil {
 		return fmt.Errorf("cannot get certificate %v: %w", secretName, err)
@@ -497,8 +513,9 @@ func (a *Azure) setKeyVaultCertificate(ctx context.Context, secretName string, v
 		params.Tags[k] = &v
 	}
 
+	start = time.Now()
 	_, err = a.baseClient.ImportCertificate(ctx, *a.provider.VaultURL, secretName, params)
-	metrics.ObserveAPICall(constants.ProviderAzureKV, constants.CallAzureKVImportCertificate, err)
+	metrics.ObserveAPICall(constants.ProviderAzureKV, constants.CallAzureKVImportCertificate, start, err)
 	if err != nil {
 		return fmt.Errorf("could not import certificate %v: %w", secretName, err)
 	}
@@ -534,8 +551,9 @@ func (a *Azure) setKeyVaultKey(ctx context.Context, secretName string, value []b
 	if err != nil {
 		return fmt.Errorf("error unmarshalling key: %w", err)
 	}
+	start := time.Now()
 	keyFromVault, err := a.baseClient.GetKey(ctx, *a.provider.VaultURL, secretName, "")
-	metrics.ObserveAPICall(constants.ProviderAzureKV, constants.CallAzureKVGetKey, err)
+	metrics.ObserveAPICall(constants.ProviderAzureKV, constants.CallAzureKVGetKey, start, err)
 	ok, err := canCreate(keyFromVault.Tags, err)
 	if err != nil {
 		return fmt.Errorf("cannot get key %v: %w", secretName, err)
@@ -557,8 +575,9 @@ func (a *Azure) setKeyVaultKey(ctx context.Context, secretName string, value []b
 	for k, v := range tags {
 		params.Tags[k] = &v
 	}
+	start = time.Now()
 	_, err = a.baseClient.ImportKey(ctx, *a.provider.VaultURL, secretName, params)
-	metrics.ObserveAPICall(constants.ProviderAzureKV, constants.CallAzureKVImportKey, err)
+	metrics.ObserveAPICall(constants.ProviderAzureKV, constants.CallAzureKVImportKey, start, err)
 	if err != nil {
 		return fmt.Errorf("could not import key %v: %w", secretName, err)
 	}
@@ -637,8 +656,9 @@ func (a *Azure) GetAllSecrets(ctx context.Context, ref esv1.ExternalSecretFind)
 	checkTags := len(ref.Tags) > 0
 	checkName := ref.Name != nil && ref.Name.RegExp != ""
 
+	start := time.Now()
 	secretListIter, err := basicClient.GetSecretsComplete(ctx, *a.provider.VaultURL, nil)
-	metrics.ObserveAPICall(constants.ProviderAzureKV, constants.CallAzureKVGetSecrets, err)
+	metrics.ObserveAPICall(constants.ProviderAzureKV, constants.CallAzureKVGetSecrets, start, err)
 	err = parseError(err)
 	if err != nil {
 		return nil, err
@@ -654,8 +674,9 @@ func (a *Azure) GetAllSecrets(ctx context.Context, ref esv1.ExternalSecretFind)
 			}
 			continue
 		}
+		start := time.Now()
 		secretResp, err := basicClient.GetSecret(ctx, *a.provider.VaultURL, secretName, "")
-		metrics.ObserveAPICall(constants.ProviderAzureKV, constants.CallAzureKVGetSecret, err)
+		metrics.ObserveAPICall(constants.ProviderAzureKV, constants.CallAzureKVGetSecret, start, err)
 		err = parseError(err)
 		if err != nil {
 			return nil, err
@@ -740,8 +761,9 @@ func (a *Azure) GetSecret(ctx context.Context, ref esv1.ExternalSecretDataRemote
 	case defaultObjType:
 		// returns a SecretBundle with the secret value
 		// https://pkg.go.dev/github.com/Azure/azure-sdk-for-go/services/keyvault/v7.0/keyvault#SecretBundle
+		start := time.Now()
 		secretResp, err := a.baseClient.GetSecret(ctx, *a.provider.VaultURL, secretName, ref.Version)
-		metrics.ObserveAPICall(constants.ProviderAzureKV, constants.CallAzureKVGetSecret, err)
+		metrics.ObserveAPICall(constants.ProviderAzureKV, constants.CallAzureKVGetSecret, start, err)
 		err = parseError(err)
 		if err != nil {
 			return nil, err
@@ -753,8 +775,9 @@ func (a *Azure) GetSecret(ctx context.Context, ref esv1.ExternalSecretDataRemote
 	case objectTypeCert:
 		// returns a CertBundle. We return CER contents of x509 certificate
 		// see: https://pkg.go.dev/github.com/Azure/azure-sdk-for-go/services/keyvault/v7.0/keyvault#CertificateBundle
+		start := time.Now()
 		certResp, err := a.baseClient.GetCertificate(ctx, *a.provider.VaultURL, secretName, ref.Version)
-		metrics.ObserveAPICall(constants.ProviderAzureKV, constants.CallAzureKVGetCertificate, err)
+		metrics.ObserveAPICall(constants.ProviderAzureKV, constants.CallAzureKVGetCertificate, start, err)
 		err = parseError(err)
 		if err != nil {
 			return nil, err
@@ -767,8 +790,9 @@ func (a *Azure) GetSecret(ctx context.Context, ref esv1.ExternalSecretDataRemote
 		// returns a KeyBundle that contains a jwk
 		// azure kv returns only public keys
 		// see: https://pkg.go.dev/github.com/Azure/azure-sdk-for-go/services/keyvault/v7.0/keyvault#KeyBundle
+		start := time.Now()
 		keyResp, err := a.baseClient.GetKey(ctx, *a.provider.VaultURL, secretName, ref.Version)
-		metrics.ObserveAPICall(constants.ProviderAzureKV, constants.CallAzureKVGetKey, err)
+		metrics.ObserveAPICall(constants.ProviderAzureKV, constants.CallAzureKVGetKey, start, err)
 		err = parseError(err)
 		if err != nil {
 			return nil, err
@@ -785,8 +809,9 @@ func (a *Azure) GetSecret(ctx context.Context, ref esv1.ExternalSecretDataRemote
 // returns a SecretBundle with the tags values.
 func (a *Azure) getSecretTags(ctx context.Context, ref esv1.ExternalSecretDataRemoteRef) (map[string]*string, error) {
 	_, secretName := getObjType(ref)
+	start := time.Now()
 	secretResp, err := a.baseClient.GetSecret(ctx, *a.provider.VaultURL, secretName, ref.Version)
-	metrics.ObserveAPICall(constants.ProviderAzureKV, constants.CallAzureKVGetSecret, err)
+	metrics.ObserveAPICall(constants.ProviderAzureKV, constants.CallAzureKVGetSecret, start, err)
 	err = parseError(err)
 	if err != nil {
 		return nil, err
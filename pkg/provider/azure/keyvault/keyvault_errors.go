@@ -0,0 +1,40 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package keyvault
+
+import (
+	"errors"
+
+	"github.com/Azure/go-autorest/autorest"
+
+	"github.com/external-secrets/external-secrets/pkg/constants"
+	"github.com/external-secrets/external-secrets/pkg/metrics"
+)
+
+// classifyError maps the status code of an Azure Key Vault API error to an error reason.
+func classifyError(err error) string {
+	var aerr autorest.DetailedError
+	if !errors.As(err, &aerr) {
+		return ""
+	}
+	if code, ok := aerr.StatusCode.(int); ok {
+		return metrics.ClassifyHTTPStatus(code)
+	}
+	return ""
+}
+
+func init() {
+	metrics.RegisterErrorClassifier(constants.ProviderAzureKV, classifyError)
+}
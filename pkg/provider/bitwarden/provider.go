@@ -27,6 +27,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 
 	esv1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1"
+	"github.com/external-secrets/external-secrets/pkg/tracing"
 	"github.com/external-secrets/external-secrets/pkg/utils"
 	"github.com/external-secrets/external-secrets/pkg/utils/resolvers"
 )
@@ -132,5 +133,5 @@ func newHTTPSClient(ctx context.Context, c client.Client, storeKind, namespace s
 		TLSClientConfig: &tls.Config{RootCAs: pool, MinVersion: tls.VersionTLS12},
 	}
 
-	return &http.Client{Transport: tr, Timeout: time.Second * 10}, nil
+	return &http.Client{Transport: tracing.Transport(tr), Timeout: time.Second * 10}, nil
 }
@@ -148,8 +148,9 @@ func (providerchef *Providerchef) Close(_ context.Context) error {
 // Validate checks if the client is configured correctly
 // to be able to retrieve secrets from the provider.
 func (providerchef *Providerchef) Validate() (esv1.ValidationResult, error) {
+	start := time.Now()
 	_, err := providerchef.userService.Get(providerchef.clientName)
-	metrics.ObserveAPICall(ProviderChef, CallChefGetUser, err)
+	metrics.ObserveAPICall(ProviderChef, CallChefGetUser, start, err)
 	if err != nil {
 		return esv1.ValidationResultError, errors.New(errStoreValidateFailed)
 	}
@@ -194,8 +195,9 @@ func getSingleDatabagItemWithContext(ctx context.Context, providerchef *Provider
 		resultChan := make(chan result, 1)
 		go func() {
 			defer close(resultChan)
+			start := time.Now()
 			ditem, err := providerchef.databagService.GetItem(dataBagName, databagItemName)
-			metrics.ObserveAPICall(ProviderChef, CallChefGetDataBagItem, err)
+			metrics.ObserveAPICall(ProviderChef, CallChefGetDataBagItem, start, err)
 			if err != nil {
 				resultChan <- result{err: fmt.Errorf(errNoDatabagItemFound, databagItemName, dataBagName)}
 				return
@@ -261,8 +263,9 @@ func (providerchef *Providerchef) GetSecretMap(ctx context.Context, ref esv1.Ext
 	}
 	getAllSecrets := make(map[string][]byte)
 	providerchef.log.Info("fetching all items from", "databag:", databagName)
+	start := time.Now()
 	dataItems, err := providerchef.databagService.ListItems(databagName)
-	metrics.ObserveAPICall(ProviderChef, CallChefListDataBagItems, err)
+	metrics.ObserveAPICall(ProviderChef, CallChefListDataBagItems, start, err)
 	if err != nil {
 		return nil, fmt.Errorf(errCannotListDataBagItems, databagName)
 	}
@@ -26,6 +26,7 @@ import (
 	"time"
 
 	esv1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1"
+	"github.com/external-secrets/external-secrets/pkg/tracing"
 )
 
 const (
@@ -65,7 +66,7 @@ func NewAPI(baseURL, username, password, hostPort string) *API {
 		TLSClientConfig: &tls.Config{MinVersion: tls.VersionTLS12},
 	}
 
-	api.client = &http.Client{Transport: tr}
+	api.client = &http.Client{Transport: tracing.Transport(tr)}
 	return api
 }
 
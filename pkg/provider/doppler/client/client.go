@@ -24,6 +24,8 @@ import (
 	"net/url"
 	"strings"
 	"time"
+
+	"github.com/external-secrets/external-secrets/pkg/tracing"
 )
 
 type DopplerClient struct {
@@ -301,10 +303,10 @@ func (c *DopplerClient) performRequest(path, method string, headers headers, par
 		tlsConfig.InsecureSkipVerify = true
 	}
 
-	httpClient.Transport = &http.Transport{
+	httpClient.Transport = tracing.Transport(&http.Transport{
 		DisableKeepAlives: true,
 		TLSClientConfig:   tlsConfig,
-	}
+	})
 
 	r, err := httpClient.Do(req)
 	if err != nil {
@@ -24,6 +24,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 
 	esv1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1"
+	"github.com/external-secrets/external-secrets/pkg/tracing"
 	"github.com/external-secrets/external-secrets/pkg/utils"
 	"github.com/external-secrets/external-secrets/pkg/utils/resolvers"
 )
@@ -64,7 +65,7 @@ func (p *Provider) NewClient(ctx context.Context, store esv1.GenericStore, kube
 	}
 
 	sdkmsClient := sdkms.Client{
-		HTTPClient: http.DefaultClient,
+		HTTPClient: &http.Client{Transport: tracing.Transport(http.DefaultTransport)},
 		Auth:       sdkms.APIKey(apiKey),
 		Endpoint:   config.APIURL,
 	}
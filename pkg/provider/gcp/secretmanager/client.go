@@ -23,6 +23,7 @@ import (
 	"slices"
 	"strconv"
 	"strings"
+	"time"
 
 	secretmanager "cloud.google.com/go/secretmanager/apiv1"
 	"cloud.google.com/go/secretmanager/apiv1/secretmanagerpb"
@@ -105,10 +106,11 @@ var log = ctrl.Log.WithName("provider").WithName("gcp").WithName("secretsmanager
 
 func (c *Client) DeleteSecret(ctx context.Context, remoteRef esv1.PushSecretRemoteRef) error {
 	name := getName(c.store.ProjectID, c.store.Location, remoteRef.GetRemoteKey())
+	start := time.Now()
 	gcpSecret, err := c.smClient.GetSecret(ctx, &secretmanagerpb.GetSecretRequest{
 		Name: name,
 	})
-	metrics.ObserveAPICall(constants.ProviderGCPSM, constants.CallGCPSMGetSecret, err)
+	metrics.ObserveAPICall(constants.ProviderGCPSM, constants.CallGCPSMGetSecret, start, err)
 	if err != nil {
 		if status.Code(err) == codes.NotFound {
 			return nil
@@ -124,8 +126,9 @@ func (c *Client) DeleteSecret(ctx context.Context, remoteRef esv1.PushSecretRemo
 		Name: name,
 		Etag: gcpSecret.Etag,
 	}
+	start = time.Now()
 	err = c.smClient.DeleteSecret(ctx, deleteSecretVersionReq)
-	metrics.ObserveAPICall(constants.ProviderGCPSM, constants.CallGCPSMDeleteSecret, err)
+	metrics.ObserveAPICall(constants.ProviderGCPSM, constants.CallGCPSMDeleteSecret, start, err)
 	return err
 }
 
@@ -173,10 +176,11 @@ func (c *Client) PushSecret(ctx context.Context, secret *corev1.Secret, pushSecr
 		payload = secret.Data[pushSecretData.GetSecretKey()]
 	}
 	secretName := getName(c.store.ProjectID, c.store.Location, pushSecretData.GetRemoteKey())
+	start := time.Now()
 	gcpSecret, err := c.smClient.GetSecret(ctx, &secretmanagerpb.GetSecretRequest{
 		Name: secretName,
 	})
-	metrics.ObserveAPICall(constants.ProviderGCPSM, constants.CallGCPSMGetSecret, err)
+	metrics.ObserveAPICall(constants.ProviderGCPSM, constants.CallGCPSMGetSecret, start, err)
 
 	if err != nil {
 		if status.Code(err) != codes.NotFound {
@@ -241,12 +245,13 @@ func (c *Client) PushSecret(ctx context.Context, secret *corev1.Secret, pushSecr
 			})
 		}
 
+		start := time.Now()
 		gcpSecret, err = c.smClient.CreateSecret(ctx, &secretmanagerpb.CreateSecretRequest{
 			Parent:   parent,
 			SecretId: pushSecretData.GetRemoteKey(),
 			Secret:   scrt,
 		})
-		metrics.ObserveAPICall(constants.ProviderGCPSM, constants.CallGCPSMCreateSecret, err)
+		metrics.ObserveAPICall(constants.ProviderGCPSM, constants.CallGCPSMCreateSecret, start, err)
 		if err != nil {
 			return err
 		}
@@ -292,13 +297,14 @@ func (c *Client) PushSecret(ctx context.Context, secret *corev1.Secret, pushSecr
 			}
 		}
 
+		start := time.Now()
 		_, err = c.smClient.UpdateSecret(ctx, &secretmanagerpb.UpdateSecretRequest{
 			Secret: scrt,
 			UpdateMask: &field_mask.FieldMask{
 				Paths: []string{"labels", "annotations"},
 			},
 		})
-		metrics.ObserveAPICall(constants.ProviderGCPSM, constants.CallGCPSMUpdateSecret, err)
+		metrics.ObserveAPICall(constants.ProviderGCPSM, constants.CallGCPSMUpdateSecret, start, err)
 		if err != nil {
 			return err
 		}
@@ -310,10 +316,11 @@ func (c *Client) PushSecret(ctx context.Context, secret *corev1.Secret, pushSecr
 	}
 	defer unlock()
 
+	start = time.Now()
 	gcpVersion, err := c.smClient.AccessSecretVersion(ctx, &secretmanagerpb.AccessSecretVersionRequest{
 		Name: fmt.Sprintf("%s/versions/latest", secretName),
 	})
-	metrics.ObserveAPICall(constants.ProviderGCPSM, constants.CallGCPSMAccessSecretVersion, err)
+	metrics.ObserveAPICall(constants.ProviderGCPSM, constants.CallGCPSMAccessSecretVersion, start, err)
 
 	if err != nil && status.Code(err) != codes.NotFound {
 		return err
@@ -342,8 +349,9 @@ func (c *Client) PushSecret(ctx context.Context, secret *corev1.Secret, pushSecr
 		},
 	}
 
+	start = time.Now()
 	_, err = c.smClient.AddSecretVersion(ctx, addSecretVersionReq)
-	metrics.ObserveAPICall(constants.ProviderGCPSM, constants.CallGCPSMAddSecretVersion, err)
+	metrics.ObserveAPICall(constants.ProviderGCPSM, constants.CallGCPSMAddSecretVersion, start, err)
 	return err
 }
 
@@ -373,10 +381,11 @@ func (c *Client) findByName(ctx context.Context, ref esv1.ExternalSecretFind) (m
 		req.Filter = fmt.Sprintf("name:%s", *ref.Path)
 	}
 	// Call the API.
+	start := time.Now()
 	it := c.smClient.ListSecrets(ctx, req)
 	secretMap := make(map[string][]byte)
 	var resp *secretmanagerpb.Secret
-	defer metrics.ObserveAPICall(constants.ProviderGCPSM, constants.CallGCPSMListSecrets, err)
+	defer metrics.ObserveAPICall(constants.ProviderGCPSM, constants.CallGCPSMListSecrets, start, err)
 	for {
 		resp, err = it.Next()
 		if errors.Is(err, iterator.Done) {
@@ -432,10 +441,11 @@ func (c *Client) findByTags(ctx context.Context, ref esv1.ExternalSecretFind) (m
 	log.V(1).Info("gcp sm findByTags", "tagFilter", tagFilter)
 	req.Filter = tagFilter
 	// Call the API.
+	start := time.Now()
 	it := c.smClient.ListSecrets(ctx, req)
 	var resp *secretmanagerpb.Secret
 	var err error
-	defer metrics.ObserveAPICall(constants.ProviderGCPSM, constants.CallGCPSMListSecrets, err)
+	defer metrics.ObserveAPICall(constants.ProviderGCPSM, constants.CallGCPSMListSecrets, start, err)
 	secretMap := make(map[string][]byte)
 	for {
 		resp, err = it.Next()
@@ -496,8 +506,9 @@ func (c *Client) GetSecret(ctx context.Context, ref esv1.ExternalSecretDataRemot
 	req := &secretmanagerpb.AccessSecretVersionRequest{
 		Name: name,
 	}
+	start := time.Now()
 	result, err := c.smClient.AccessSecretVersion(ctx, req)
-	metrics.ObserveAPICall(constants.ProviderGCPSM, constants.CallGCPSMAccessSecretVersion, err)
+	metrics.ObserveAPICall(constants.ProviderGCPSM, constants.CallGCPSMAccessSecretVersion, start, err)
 	err = parseError(err)
 	if err != nil {
 		return nil, fmt.Errorf(errClientGetSecretAccess, err)
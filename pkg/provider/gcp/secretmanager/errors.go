@@ -0,0 +1,48 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package secretmanager
+
+import (
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/external-secrets/external-secrets/pkg/constants"
+	"github.com/external-secrets/external-secrets/pkg/metrics"
+)
+
+// classifyError maps the gRPC status code of a GCP API error to an error reason.
+func classifyError(err error) string {
+	s, ok := status.FromError(err)
+	if !ok {
+		return ""
+	}
+	switch s.Code() {
+	case codes.NotFound:
+		return constants.ErrorReasonNotFound
+	case codes.Unauthenticated:
+		return constants.ErrorReasonAuth
+	case codes.PermissionDenied:
+		return constants.ErrorReasonPermissionDenied
+	case codes.ResourceExhausted:
+		return constants.ErrorReasonThrottled
+	case codes.DeadlineExceeded:
+		return constants.ErrorReasonTimeout
+	}
+	return ""
+}
+
+func init() {
+	metrics.RegisterErrorClassifier(constants.ProviderGCPSM, classifyError)
+}
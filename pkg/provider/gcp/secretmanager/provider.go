@@ -37,6 +37,7 @@ type Provider struct{}
 // https://github.com/external-secrets/external-secrets/issues/644
 var _ esv1.SecretsClient = &Client{}
 var _ esv1.Provider = &Provider{}
+var _ esv1.ReferentProvider = &Provider{}
 
 func init() {
 	esv1.Register(&Provider{}, &esv1.SecretStoreProvider{
@@ -57,6 +58,12 @@ func (p *Provider) Capabilities() esv1.SecretStoreCapabilities {
 	return esv1.SecretStoreReadWrite
 }
 
+// IsReferent returns true if the store references credentials without a namespace.
+func (p *Provider) IsReferent(store esv1.GenericStore) bool {
+	prov := store.GetSpec().Provider.GCPSM
+	return prov != nil && isReferentSpec(prov)
+}
+
 // NewClient constructs a GCP Provider.
 func (p *Provider) NewClient(ctx context.Context, store esv1.GenericStore, kube kclient.Client, namespace string) (esv1.SecretsClient, error) {
 	storeSpec := store.GetSpec()
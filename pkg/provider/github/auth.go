@@ -21,6 +21,7 @@ import (
 	"github.com/bradleyfalzon/ghinstallation/v2"
 	github "github.com/google/go-github/v56/github"
 
+	"github.com/external-secrets/external-secrets/pkg/tracing"
 	"github.com/external-secrets/external-secrets/pkg/utils/resolvers"
 )
 
@@ -30,7 +31,7 @@ func (g *Client) AuthWithPrivateKey(ctx context.Context) (*github.Client, error)
 		return nil, fmt.Errorf("couldn't get private key from secret: resolvers.SecretKeyRef failed with error %w", err)
 	}
 
-	itr, err := ghinstallation.New(http.DefaultTransport, g.provider.AppID, g.provider.InstallationID, []byte(privateKey))
+	itr, err := ghinstallation.New(tracing.Transport(http.DefaultTransport), g.provider.AppID, g.provider.InstallationID, []byte(privateKey))
 	if err != nil {
 		return nil, fmt.Errorf("could not instantiate new installation transport: %w", err)
 	}
@@ -23,6 +23,7 @@ import (
 	"sort"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/tidwall/gjson"
 	gitlab "gitlab.com/gitlab-org/api/client-go"
@@ -153,8 +154,9 @@ func (g *gitlabBase) fetchProjectVariables(effectiveEnvironment string, matcher
 	nonWildcardSet := make(map[string]bool)
 	for projectPage := 1; ; projectPage++ {
 		popts.Page = projectPage
+		start := time.Now()
 		projectData, response, err := g.projectVariablesClient.ListVariables(g.store.ProjectID, popts)
-		metrics.ObserveAPICall(constants.ProviderGitLab, constants.CallGitLabProjectListVariables, err)
+		metrics.ObserveAPICall(constants.ProviderGitLab, constants.CallGitLabProjectListVariables, start, err)
 		if err != nil {
 			return err
 		}
@@ -211,8 +213,9 @@ func (g *gitlabBase) setVariablesForGroupID(
 ) error {
 	for groupPage := 1; ; groupPage++ {
 		gopts.Page = groupPage
+		start := time.Now()
 		groupVars, response, err := g.groupVariablesClient.ListVariables(groupID, gopts)
-		metrics.ObserveAPICall(constants.ProviderGitLab, constants.CallGitLabGroupListVariables, err)
+		metrics.ObserveAPICall(constants.ProviderGitLab, constants.CallGitLabGroupListVariables, start, err)
 		if err != nil {
 			return err
 		}
@@ -257,8 +260,9 @@ func ExtractTag(tags map[string]string) (string, error) {
 }
 
 func (g *gitlabBase) getGroupVariables(groupID string, ref esv1.ExternalSecretDataRemoteRef, gopts *gitlab.GetGroupVariableOptions) (*gitlab.GroupVariable, *gitlab.Response, error) {
+	start := time.Now()
 	groupVar, resp, err := g.groupVariablesClient.GetVariable(groupID, ref.Key, gopts)
-	metrics.ObserveAPICall(constants.ProviderGitLab, constants.CallGitLabGroupGetVariable, err)
+	metrics.ObserveAPICall(constants.ProviderGitLab, constants.CallGitLabGroupGetVariable, start, err)
 	if err != nil {
 		if resp != nil && resp.StatusCode == http.StatusNotFound && !isEmptyOrWildcard(g.store.Environment) {
 			if gopts == nil {
@@ -268,8 +272,9 @@ func (g *gitlabBase) getGroupVariables(groupID string, ref esv1.ExternalSecretDa
 				gopts.Filter = &gitlab.VariableFilter{}
 			}
 			gopts.Filter.EnvironmentScope = "*"
+			start = time.Now()
 			groupVar, resp, err = g.groupVariablesClient.GetVariable(groupID, ref.Key, gopts)
-			metrics.ObserveAPICall(constants.ProviderGitLab, constants.CallGitLabGroupGetVariable, err)
+			metrics.ObserveAPICall(constants.ProviderGitLab, constants.CallGitLabGroupGetVariable, start, err)
 			if err != nil || resp == nil {
 				return nil, resp, fmt.Errorf("error getting group variable %s from GitLab: %w", ref.Key, err)
 			}
@@ -409,8 +414,9 @@ func (g *gitlabBase) Close(_ context.Context) error {
 
 func (g *gitlabBase) ResolveGroupIds() error {
 	if g.store.InheritFromGroups {
+		start := time.Now()
 		projectGroups, resp, err := g.projectsClient.ListProjectsGroups(g.store.ProjectID, nil)
-		metrics.ObserveAPICall(constants.ProviderGitLab, constants.CallGitLabListProjectsGroups, err)
+		metrics.ObserveAPICall(constants.ProviderGitLab, constants.CallGitLabListProjectsGroups, start, err)
 		if resp.StatusCode >= 400 && err != nil {
 			return err
 		}
@@ -427,8 +433,9 @@ func (g *gitlabBase) ResolveGroupIds() error {
 // Validate will use the gitlab projectVariablesClient/groupVariablesClient to validate the gitlab provider using the ListVariable call to ensure get permissions without needing a specific key.
 func (g *gitlabBase) Validate() (esv1.ValidationResult, error) {
 	if g.store.ProjectID != "" {
+		start := time.Now()
 		_, resp, err := g.projectVariablesClient.ListVariables(g.store.ProjectID, nil)
-		metrics.ObserveAPICall(constants.ProviderGitLab, constants.CallGitLabProjectListVariables, err)
+		metrics.ObserveAPICall(constants.ProviderGitLab, constants.CallGitLabProjectListVariables, start, err)
 		if err != nil {
 			return esv1.ValidationResultError, fmt.Errorf(errList, err)
 		} else if resp == nil || resp.StatusCode != http.StatusOK {
@@ -444,8 +451,9 @@ func (g *gitlabBase) Validate() (esv1.ValidationResult, error) {
 
 	if len(g.store.GroupIDs) > 0 {
 		for _, groupID := range g.store.GroupIDs {
+			start := time.Now()
 			_, resp, err := g.groupVariablesClient.ListVariables(groupID, nil)
-			metrics.ObserveAPICall(constants.ProviderGitLab, constants.CallGitLabGroupListVariables, err)
+			metrics.ObserveAPICall(constants.ProviderGitLab, constants.CallGitLabGroupListVariables, start, err)
 			if err != nil {
 				return esv1.ValidationResultError, fmt.Errorf(errList, err)
 			} else if resp == nil || resp.StatusCode != http.StatusOK {
@@ -21,6 +21,7 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"time"
 
 	gitlab "gitlab.com/gitlab-org/api/client-go"
 	kclient "sigs.k8s.io/controller-runtime/pkg/client"
@@ -29,6 +30,7 @@ import (
 	esv1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1"
 	"github.com/external-secrets/external-secrets/pkg/constants"
 	"github.com/external-secrets/external-secrets/pkg/metrics"
+	"github.com/external-secrets/external-secrets/pkg/tracing"
 	"github.com/external-secrets/external-secrets/pkg/utils"
 )
 
@@ -83,7 +85,13 @@ func (g *gitlabBase) getClient(ctx context.Context, provider *esv1.GitlabProvide
 	if err != nil {
 		return nil, err
 	}
+	return NewAPIClient(ctx, provider, credentials, g.kube, g.storeKind, g.namespace)
+}
 
+// NewAPIClient creates a client for the GitLab instance configured in provider,
+// which authenticates with the given access token.
+// Only the URL and the CA configuration of the provider are used.
+func NewAPIClient(ctx context.Context, provider *esv1.GitlabProvider, token string, kube kclient.Client, storeKind, namespace string) (*gitlab.Client, error) {
 	// Create projectVariablesClient options
 	var opts []gitlab.ClientOptionFunc
 	if provider.URL != "" {
@@ -95,9 +103,9 @@ func (g *gitlabBase) getClient(ctx context.Context, provider *esv1.GitlabProvide
 		ca, err := utils.FetchCACertFromSource(ctx, utils.CreateCertOpts{
 			CABundle:   provider.CABundle,
 			CAProvider: provider.CAProvider,
-			StoreKind:  g.storeKind,
-			Namespace:  g.namespace,
-			Client:     g.kube,
+			StoreKind:  storeKind,
+			Namespace:  namespace,
+			Client:     kube,
 		})
 		if err != nil {
 			return nil, fmt.Errorf("failed to read ca bundle: %w", err)
@@ -113,7 +121,7 @@ func (g *gitlabBase) getClient(ctx context.Context, provider *esv1.GitlabProvide
 			},
 		}
 
-		httpClient := &http.Client{Transport: transport}
+		httpClient := &http.Client{Transport: tracing.Transport(transport)}
 		opts = append(opts, gitlab.WithHTTPClient(httpClient))
 	}
 
@@ -121,7 +129,7 @@ func (g *gitlabBase) getClient(ctx context.Context, provider *esv1.GitlabProvide
 	// in a similar way to extend functionality of the provider
 
 	// Create a new GitLab Client using credentials and options
-	client, err := gitlab.NewClient(credentials, opts...)
+	client, err := gitlab.NewClient(token, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -130,13 +138,15 @@ func (g *gitlabBase) getClient(ctx context.Context, provider *esv1.GitlabProvide
 }
 
 func (g *gitlabBase) getVariables(ref esv1.ExternalSecretDataRemoteRef, vopts *gitlab.GetProjectVariableOptions) (*gitlab.ProjectVariable, *gitlab.Response, error) {
+	start := time.Now()
 	data, resp, err := g.projectVariablesClient.GetVariable(g.store.ProjectID, ref.Key, vopts)
-	metrics.ObserveAPICall(constants.ProviderGitLab, constants.CallGitLabProjectVariableGet, err)
+	metrics.ObserveAPICall(constants.ProviderGitLab, constants.CallGitLabProjectVariableGet, start, err)
 	if err != nil {
 		if resp != nil && resp.StatusCode == http.StatusNotFound && !isEmptyOrWildcard(g.store.Environment) {
 			vopts.Filter.EnvironmentScope = "*"
+			start = time.Now()
 			data, resp, err = g.projectVariablesClient.GetVariable(g.store.ProjectID, ref.Key, vopts)
-			metrics.ObserveAPICall(constants.ProviderGitLab, constants.CallGitLabProjectVariableGet, err)
+			metrics.ObserveAPICall(constants.ProviderGitLab, constants.CallGitLabProjectVariableGet, start, err)
 			if err != nil || resp == nil {
 				return nil, resp, fmt.Errorf("error getting variable %s from GitLab: %w", ref.Key, err)
 			}
@@ -376,6 +376,7 @@ func getSecretData(ibm *providerIBM, secretName *string, secretType, secretGroup
 		// follow the new mechanism by calling GetSecretByNameTypeWithContext
 		ctx, cancel := context.WithTimeout(context.Background(), contextTimeout)
 		defer cancel()
+		start := time.Now()
 		response, _, err := ibm.IBMClient.GetSecretByNameTypeWithContext(
 			ctx,
 			&sm.GetSecretByNameTypeOptions{
@@ -383,7 +384,7 @@ func getSecretData(ibm *providerIBM, secretName *string, secretType, secretGroup
 				SecretGroupName: &secretGroupName,
 				SecretType:      &secretType,
 			})
-		metrics.ObserveAPICall(constants.ProviderIBMSM, constants.CallIBMSMGetSecretByNameType, err)
+		metrics.ObserveAPICall(constants.ProviderIBMSM, constants.CallIBMSMGetSecretByNameType, start, err)
 		if err != nil {
 			return nil, err
 		}
@@ -392,12 +393,13 @@ func getSecretData(ibm *providerIBM, secretName *string, secretType, secretGroup
 
 	ctx, cancel := context.WithTimeout(context.Background(), contextTimeout)
 	defer cancel()
+	start := time.Now()
 	response, _, err := ibm.IBMClient.GetSecretWithContext(
 		ctx,
 		&sm.GetSecretOptions{
 			ID: secretName,
 		})
-	metrics.ObserveAPICall(constants.ProviderIBMSM, constants.CallIBMSMGetSecret, err)
+	metrics.ObserveAPICall(constants.ProviderIBMSM, constants.CallIBMSMGetSecret, start, err)
 	if err != nil {
 		return nil, err
 	}
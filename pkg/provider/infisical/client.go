@@ -20,6 +20,7 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+	"time"
 
 	infisical "github.com/infisical/go-sdk"
 	"github.com/tidwall/gjson"
@@ -81,6 +82,7 @@ func (p *Provider) GetSecret(ctx context.Context, ref esv1.ExternalSecretDataRem
 		return nil, err
 	}
 
+	start := time.Now()
 	secret, err := p.sdkClient.Secrets().Retrieve(infisical.RetrieveSecretOptions{
 		Environment:            p.apiScope.EnvironmentSlug,
 		ProjectSlug:            p.apiScope.ProjectSlug,
@@ -89,7 +91,7 @@ func (p *Provider) GetSecret(ctx context.Context, ref esv1.ExternalSecretDataRem
 		IncludeImports:         true,
 		ExpandSecretReferences: p.apiScope.ExpandSecretReferences,
 	})
-	metrics.ObserveAPICall(constants.ProviderName, getSecretByKeyV3, err)
+	metrics.ObserveAPICall(constants.ProviderName, getSecretByKeyV3, start, err)
 
 	if err != nil {
 		return nil, err
@@ -138,6 +140,7 @@ func (p *Provider) GetAllSecrets(ctx context.Context, ref esv1.ExternalSecretFin
 		return nil, errTagsNotImplemented
 	}
 
+	start := time.Now()
 	secrets, err := p.sdkClient.Secrets().List(infisical.ListSecretsOptions{
 		Environment:            p.apiScope.EnvironmentSlug,
 		ProjectSlug:            p.apiScope.ProjectSlug,
@@ -146,7 +149,7 @@ func (p *Provider) GetAllSecrets(ctx context.Context, ref esv1.ExternalSecretFin
 		ExpandSecretReferences: p.apiScope.ExpandSecretReferences,
 		IncludeImports:         true,
 	})
-	metrics.ObserveAPICall(constants.ProviderName, getSecretsV3, err)
+	metrics.ObserveAPICall(constants.ProviderName, getSecretsV3, start, err)
 	if err != nil {
 		return nil, err
 	}
@@ -183,6 +186,7 @@ func (p *Provider) GetAllSecrets(ctx context.Context, ref esv1.ExternalSecretFin
 // If the validation result is unknown it will be ignored.
 func (p *Provider) Validate() (esv1.ValidationResult, error) {
 	// try to fetch the secrets to ensure provided credentials has access to read secrets
+	start := time.Now()
 	_, err := p.sdkClient.Secrets().List(infisical.ListSecretsOptions{
 		Environment:            p.apiScope.EnvironmentSlug,
 		ProjectSlug:            p.apiScope.ProjectSlug,
@@ -190,7 +194,7 @@ func (p *Provider) Validate() (esv1.ValidationResult, error) {
 		SecretPath:             p.apiScope.SecretPath,
 		ExpandSecretReferences: p.apiScope.ExpandSecretReferences,
 	})
-	metrics.ObserveAPICall(constants.ProviderName, getSecretsV3, err)
+	metrics.ObserveAPICall(constants.ProviderName, getSecretsV3, start, err)
 
 	if err != nil {
 		return esv1.ValidationResultError, fmt.Errorf("cannot read secrets with provided project scope project:%s environment:%s secret-path:%s recursive:%t, %w", p.apiScope.ProjectSlug, p.apiScope.EnvironmentSlug, p.apiScope.SecretPath, p.apiScope.Recursive, err)
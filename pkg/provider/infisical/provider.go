@@ -18,6 +18,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	infisicalSdk "github.com/infisical/go-sdk"
 	kclient "sigs.k8s.io/controller-runtime/pkg/client"
@@ -84,8 +85,9 @@ func performUniversalAuthLogin(ctx context.Context, store esv1.GenericStore, inf
 		return err
 	}
 
+	start := time.Now()
 	_, err = sdkClient.Auth().UniversalAuthLogin(clientID, clientSecret)
-	metrics.ObserveAPICall(constants.ProviderName, machineIdentityLoginViaUniversalAuth, err)
+	metrics.ObserveAPICall(constants.ProviderName, machineIdentityLoginViaUniversalAuth, start, err)
 
 	if err != nil {
 		return fmt.Errorf("failed to authenticate via universal auth %w", err)
@@ -110,8 +112,9 @@ func performAzureAuthLogin(ctx context.Context, store esv1.GenericStore, infisic
 		}
 	}
 
+	start := time.Now()
 	_, err = sdkClient.Auth().AzureAuthLogin(identityID, resource)
-	metrics.ObserveAPICall(constants.ProviderName, machineIdentityLoginViaAzureAuth, err)
+	metrics.ObserveAPICall(constants.ProviderName, machineIdentityLoginViaAzureAuth, start, err)
 
 	if err != nil {
 		return fmt.Errorf("failed to authenticate via azure auth %w", err)
@@ -127,8 +130,9 @@ func performGcpIdTokenAuthLogin(ctx context.Context, store esv1.GenericStore, in
 		return fmt.Errorf(errSecretDataFormat, err)
 	}
 
+	start := time.Now()
 	_, err = sdkClient.Auth().GcpIdTokenAuthLogin(identityID)
-	metrics.ObserveAPICall(constants.ProviderName, machineIdentityLoginViaGcpIdTokenAuth, err)
+	metrics.ObserveAPICall(constants.ProviderName, machineIdentityLoginViaGcpIdTokenAuth, start, err)
 
 	if err != nil {
 		return fmt.Errorf("failed to authenticate via gcp id token auth %w", err)
@@ -149,8 +153,9 @@ func performGcpIamAuthLogin(ctx context.Context, store esv1.GenericStore, infisi
 		return fmt.Errorf("failed to get secret data serviceAccountKeyFilePath %w", err)
 	}
 
+	start := time.Now()
 	_, err = sdkClient.Auth().GcpIamAuthLogin(identityID, serviceAccountKeyFilePath)
-	metrics.ObserveAPICall(constants.ProviderName, machineIdentityLoginViaGcpServiceAccountAuth, err)
+	metrics.ObserveAPICall(constants.ProviderName, machineIdentityLoginViaGcpServiceAccountAuth, start, err)
 
 	if err != nil {
 		return fmt.Errorf("failed to authenticate via gcp iam auth %w", err)
@@ -171,8 +176,9 @@ func performJwtAuthLogin(ctx context.Context, store esv1.GenericStore, infisical
 		return fmt.Errorf("failed to get secret data jwt %w", err)
 	}
 
+	start := time.Now()
 	_, err = sdkClient.Auth().JwtAuthLogin(identityID, jwt)
-	metrics.ObserveAPICall(constants.ProviderName, machineIdentityLoginViaJwtAuth, err)
+	metrics.ObserveAPICall(constants.ProviderName, machineIdentityLoginViaJwtAuth, start, err)
 
 	if err != nil {
 		return fmt.Errorf("failed to authenticate via jwt auth %w", err)
@@ -198,8 +204,9 @@ func performLdapAuthLogin(ctx context.Context, store esv1.GenericStore, infisica
 		return fmt.Errorf("failed to get secret data ldapUsername %w", err)
 	}
 
+	start := time.Now()
 	_, err = sdkClient.Auth().LdapAuthLogin(identityID, ldapPassword, ldapUsername)
-	metrics.ObserveAPICall(constants.ProviderName, machineIdentityLoginViaLdapAuth, err)
+	metrics.ObserveAPICall(constants.ProviderName, machineIdentityLoginViaLdapAuth, start, err)
 
 	if err != nil {
 		return fmt.Errorf("failed to authenticate via ldap auth %w", err)
@@ -249,6 +256,7 @@ func performOciAuthLogin(ctx context.Context, store esv1.GenericStore, infisical
 		return fmt.Errorf("failed to get secret data region %w", err)
 	}
 
+	start := time.Now()
 	_, err = sdkClient.Auth().OciAuthLogin(infisicalSdk.OciAuthLoginOptions{
 		IdentityID:  identityID,
 		PrivateKey:  privateKey,
@@ -258,7 +266,7 @@ func performOciAuthLogin(ctx context.Context, store esv1.GenericStore, infisical
 		TenancyID:   tenancyID,
 		Region:      region,
 	})
-	metrics.ObserveAPICall(constants.ProviderName, machineIdentityLoginViaOciAuth, err)
+	metrics.ObserveAPICall(constants.ProviderName, machineIdentityLoginViaOciAuth, start, err)
 
 	if err != nil {
 		return fmt.Errorf("failed to authenticate via oci auth %w", err)
@@ -327,8 +335,9 @@ func (p *Provider) NewClient(ctx context.Context, store esv1.GenericStore, kube
 
 func (p *Provider) Close(ctx context.Context) error {
 	p.cancelSdkClient()
+	start := time.Now()
 	err := p.sdkClient.Auth().RevokeAccessToken()
-	metrics.ObserveAPICall(constants.ProviderName, revokeAccessToken, err)
+	metrics.ObserveAPICall(constants.ProviderName, revokeAccessToken, start, err)
 
 	return err
 }
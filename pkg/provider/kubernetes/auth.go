@@ -21,8 +21,10 @@ import (
 
 	authenticationv1 "k8s.io/api/authentication/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	typedcorev1 "k8s.io/client-go/kubernetes/typed/core/v1"
 	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/clientcmd"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
 
 	esv1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1"
 	esmeta "github.com/external-secrets/external-secrets/apis/meta/v1"
@@ -34,6 +36,21 @@ const (
 	errUnableCreateToken = "cannot create service account token: %q"
 )
 
+// RESTConfig returns the configuration to connect to the cluster described by the
+// server, auth and authRef fields of the given provider.
+// The secrets and service accounts it references are resolved with the
+// privileged ctrlClient and ctrlClientset, honoring the storeKind and namespace.
+func RESTConfig(ctx context.Context, provider *esv1.KubernetesProvider, ctrlClient kclient.Client, ctrlClientset typedcorev1.CoreV1Interface, storeKind, namespace string) (*rest.Config, error) {
+	c := &Client{
+		ctrlClient:    ctrlClient,
+		ctrlClientset: ctrlClientset,
+		store:         provider,
+		storeKind:     storeKind,
+		namespace:     namespace,
+	}
+	return c.getAuth(ctx)
+}
+
 func (c *Client) getAuth(ctx context.Context) (*rest.Config, error) {
 	if c.store.AuthRef != nil {
 		cfg, err := c.fetchSecretKey(ctx, *c.store.AuthRef)
@@ -21,6 +21,7 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/tidwall/gjson"
 	v1 "k8s.io/api/core/v1"
@@ -81,8 +82,9 @@ func (c *Client) DeleteSecret(ctx context.Context, remoteRef esv1.PushSecretRemo
 		return errors.New("requires property in RemoteRef to delete secret value")
 	}
 
+	start := time.Now()
 	extSecret, getErr := c.userSecretClient.Get(ctx, remoteRef.GetRemoteKey(), metav1.GetOptions{})
-	metrics.ObserveAPICall(constants.ProviderKubernetes, constants.CallKubernetesGetSecret, getErr)
+	metrics.ObserveAPICall(constants.ProviderKubernetes, constants.CallKubernetesGetSecret, start, getErr)
 	if getErr != nil {
 		if apierrors.IsNotFound(getErr) {
 			// return gracefully if no secret exists
@@ -177,8 +179,9 @@ func (c *Client) mergePushSecretData(remoteRef esv1.PushSecretData, remoteSecret
 }
 
 func (c *Client) createOrUpdate(ctx context.Context, targetSecret *v1.Secret, f func() error) error {
+	start := time.Now()
 	target, err := c.userSecretClient.Get(ctx, targetSecret.Name, metav1.GetOptions{})
-	metrics.ObserveAPICall(constants.ProviderKubernetes, constants.CallKubernetesGetSecret, err)
+	metrics.ObserveAPICall(constants.ProviderKubernetes, constants.CallKubernetesGetSecret, start, err)
 	if err != nil {
 		if !apierrors.IsNotFound(err) {
 			return err
@@ -186,8 +189,9 @@ func (c *Client) createOrUpdate(ctx context.Context, targetSecret *v1.Secret, f
 		if err := f(); err != nil {
 			return err
 		}
+		start := time.Now()
 		_, err := c.userSecretClient.Create(ctx, targetSecret, metav1.CreateOptions{})
-		metrics.ObserveAPICall(constants.ProviderKubernetes, constants.CallKubernetesCreateSecret, err)
+		metrics.ObserveAPICall(constants.ProviderKubernetes, constants.CallKubernetesCreateSecret, start, err)
 		if err != nil {
 			return err
 		}
@@ -204,8 +208,9 @@ func (c *Client) createOrUpdate(ctx context.Context, targetSecret *v1.Secret, f
 		return nil
 	}
 
+	start = time.Now()
 	_, err = c.userSecretClient.Update(ctx, targetSecret, metav1.UpdateOptions{})
-	metrics.ObserveAPICall(constants.ProviderKubernetes, constants.CallKubernetesUpdateSecret, err)
+	metrics.ObserveAPICall(constants.ProviderKubernetes, constants.CallKubernetesUpdateSecret, start, err)
 	if err != nil {
 		return err
 	}
@@ -228,8 +233,9 @@ func (c *Client) marshalData(secret *v1.Secret) ([]byte, error) {
 }
 
 func (c *Client) GetSecretMap(ctx context.Context, ref esv1.ExternalSecretDataRemoteRef) (map[string][]byte, error) {
+	start := time.Now()
 	secret, err := c.userSecretClient.Get(ctx, ref.Key, metav1.GetOptions{})
-	metrics.ObserveAPICall(constants.ProviderKubernetes, constants.CallKubernetesGetSecret, err)
+	metrics.ObserveAPICall(constants.ProviderKubernetes, constants.CallKubernetesGetSecret, start, err)
 	if apierrors.IsNotFound(err) {
 		return nil, esv1.NoSecretError{}
 	}
@@ -342,8 +348,9 @@ func (c *Client) findByTags(ctx context.Context, ref esv1.ExternalSecretFind) (m
 	if err != nil {
 		return nil, fmt.Errorf("unable to validate selector tags: %w", err)
 	}
+	start := time.Now()
 	secrets, err := c.userSecretClient.List(ctx, metav1.ListOptions{LabelSelector: sel.String()})
-	metrics.ObserveAPICall(constants.ProviderKubernetes, constants.CallKubernetesListSecrets, err)
+	metrics.ObserveAPICall(constants.ProviderKubernetes, constants.CallKubernetesListSecrets, start, err)
 	if err != nil {
 		return nil, fmt.Errorf("unable to list secrets: %w", err)
 	}
@@ -359,8 +366,9 @@ func (c *Client) findByTags(ctx context.Context, ref esv1.ExternalSecretFind) (m
 }
 
 func (c *Client) findByName(ctx context.Context, ref esv1.ExternalSecretFind) (map[string][]byte, error) {
+	start := time.Now()
 	secrets, err := c.userSecretClient.List(ctx, metav1.ListOptions{})
-	metrics.ObserveAPICall(constants.ProviderKubernetes, constants.CallKubernetesListSecrets, err)
+	metrics.ObserveAPICall(constants.ProviderKubernetes, constants.CallKubernetesListSecrets, start, err)
 	if err != nil {
 		return nil, fmt.Errorf("unable to list secrets: %w", err)
 	}
@@ -396,8 +404,9 @@ func convertMap(in map[string][]byte) map[string]string {
 
 // fullDelete removes remote secret completely.
 func (c *Client) fullDelete(ctx context.Context, secretName string) error {
+	start := time.Now()
 	err := c.userSecretClient.Delete(ctx, secretName, metav1.DeleteOptions{})
-	metrics.ObserveAPICall(constants.ProviderKubernetes, constants.CallKubernetesDeleteSecret, err)
+	metrics.ObserveAPICall(constants.ProviderKubernetes, constants.CallKubernetesDeleteSecret, start, err)
 
 	// gracefully return on not found
 	if apierrors.IsNotFound(err) {
@@ -409,8 +418,9 @@ func (c *Client) fullDelete(ctx context.Context, secretName string) error {
 // removeProperty removes single data property from remote secret.
 func (c *Client) removeProperty(ctx context.Context, extSecret *v1.Secret, remoteRef esv1.PushSecretRemoteRef) error {
 	delete(extSecret.Data, remoteRef.GetProperty())
+	start := time.Now()
 	_, err := c.userSecretClient.Update(ctx, extSecret, metav1.UpdateOptions{})
-	metrics.ObserveAPICall(constants.ProviderKubernetes, constants.CallKubernetesUpdateSecret, err)
+	metrics.ObserveAPICall(constants.ProviderKubernetes, constants.CallKubernetesUpdateSecret, start, err)
 	return err
 }
 
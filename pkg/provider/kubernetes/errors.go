@@ -0,0 +1,43 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubernetes
+
+import (
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+
+	"github.com/external-secrets/external-secrets/pkg/constants"
+	"github.com/external-secrets/external-secrets/pkg/metrics"
+)
+
+// classifyError maps a Kubernetes API error to an error reason.
+func classifyError(err error) string {
+	switch {
+	case apierrors.IsNotFound(err):
+		return constants.ErrorReasonNotFound
+	case apierrors.IsUnauthorized(err):
+		return constants.ErrorReasonAuth
+	case apierrors.IsForbidden(err):
+		return constants.ErrorReasonPermissionDenied
+	case apierrors.IsTooManyRequests(err):
+		return constants.ErrorReasonThrottled
+	case apierrors.IsTimeout(err), apierrors.IsServerTimeout(err):
+		return constants.ErrorReasonTimeout
+	}
+	return ""
+}
+
+func init() {
+	metrics.RegisterErrorClassifier(constants.ProviderKubernetes, classifyError)
+}
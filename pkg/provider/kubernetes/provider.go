@@ -33,6 +33,7 @@ import (
 // https://github.com/external-secrets/external-secrets/issues/644
 var _ esv1.SecretsClient = &Client{}
 var _ esv1.Provider = &Provider{}
+var _ esv1.ReferentProvider = &Provider{}
 
 type KClient interface {
 	Get(ctx context.Context, name string, opts metav1.GetOptions) (*v1.Secret, error)
@@ -93,6 +94,12 @@ func (p *Provider) Capabilities() esv1.SecretStoreCapabilities {
 	return esv1.SecretStoreReadWrite
 }
 
+// IsReferent returns true if the store references credentials without a namespace.
+func (p *Provider) IsReferent(store esv1.GenericStore) bool {
+	prov := store.GetSpec().Provider.Kubernetes
+	return prov != nil && isReferentSpec(prov)
+}
+
 // NewClient constructs a Kubernetes Provider.
 func (p *Provider) NewClient(ctx context.Context, store esv1.GenericStore, kube kclient.Client, namespace string) (esv1.SecretsClient, error) {
 	restCfg, err := ctrlcfg.GetConfig()
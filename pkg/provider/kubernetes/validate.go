@@ -19,6 +19,7 @@ import (
 	"errors"
 	"fmt"
 	"slices"
+	"time"
 
 	authv1 "k8s.io/api/authorization/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -89,8 +90,9 @@ func (c *Client) Validate() (esv1.ValidationResult, error) {
 			Namespace: c.store.RemoteNamespace,
 		},
 	}
+	start := time.Now()
 	authReview, err := c.userReviewClient.Create(ctx, &t, metav1.CreateOptions{})
-	metrics.ObserveAPICall(constants.ProviderKubernetes, constants.CallKubernetesCreateSelfSubjectRulesReview, err)
+	metrics.ObserveAPICall(constants.ProviderKubernetes, constants.CallKubernetesCreateSelfSubjectRulesReview, start, err)
 	if err != nil {
 		return esv1.ValidationResultUnknown, fmt.Errorf("could not verify if client is valid: %w", err)
 	}
@@ -27,6 +27,8 @@ import (
 	"time"
 
 	aesdecrypt "github.com/Onboardbase/go-cryptojs-aes-decrypt/decrypt"
+
+	"github.com/external-secrets/external-secrets/pkg/tracing"
 )
 
 const HTTPTimeoutDuration = 20 * time.Second
@@ -139,7 +141,7 @@ func NewOnboardbaseClient(onboardbaseAPIKey, onboardbasePasscode string) (*Onboa
 		UserAgent:           "onboardbase-external-secrets",
 		httpClient: &http.Client{
 			Timeout:   HTTPTimeoutDuration,
-			Transport: httpTransport,
+			Transport: tracing.Transport(httpTransport),
 		},
 	}
 
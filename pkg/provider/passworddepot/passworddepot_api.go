@@ -24,6 +24,8 @@ import (
 	"net/http"
 	"strings"
 	"time"
+
+	"github.com/external-secrets/external-secrets/pkg/tracing"
 )
 
 const (
@@ -129,7 +131,7 @@ func NewAPI(ctx context.Context, baseURL, username, password, hostPort string) (
 		TLSClientConfig: &tls.Config{MinVersion: tls.VersionTLS12},
 	}
 
-	api.client = &http.Client{Transport: tr}
+	api.client = &http.Client{Transport: tracing.Transport(tr)}
 	err := api.login(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("failed to login: %w", err)
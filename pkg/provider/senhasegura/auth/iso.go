@@ -28,6 +28,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	esv1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1"
+	"github.com/external-secrets/external-secrets/pkg/tracing"
 	"github.com/external-secrets/external-secrets/pkg/utils/resolvers"
 )
 
@@ -118,7 +119,7 @@ func (s *SenhaseguraIsoSession) GetIsoToken(clientID, clientSecret, systemURL st
 		TLSClientConfig: &tls.Config{InsecureSkipVerify: ignoreSslCertificate},
 	}
 
-	client := &http.Client{Transport: tr}
+	client := &http.Client{Transport: tracing.Transport(tr)}
 
 	r, err := http.NewRequest("POST", u.String(), strings.NewReader(data.Encode()))
 	if err != nil {
@@ -27,6 +27,7 @@ import (
 
 	esv1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1"
 	senhaseguraAuth "github.com/external-secrets/external-secrets/pkg/provider/senhasegura/auth"
+	"github.com/external-secrets/external-secrets/pkg/tracing"
 )
 
 type clientDSMInterface interface {
@@ -185,7 +186,7 @@ func (dsm *DSM) FetchSecrets() (respObj IsoDappResponse, err error) {
 		TLSClientConfig: &tls.Config{InsecureSkipVerify: dsm.isoSession.IgnoreSslCertificate},
 	}
 
-	client := &http.Client{Transport: tr}
+	client := &http.Client{Transport: tracing.Transport(tr)}
 
 	r, err := http.NewRequest("GET", u.String(), http.NoBody)
 	if err != nil {
@@ -18,6 +18,7 @@ import (
 	"context"
 	"errors"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/vault/api/auth/approle"
 
@@ -68,8 +69,9 @@ func (c *client) requestTokenWithAppRoleRef(ctx context.Context, appRole *esv1.V
 	if err != nil {
 		return err
 	}
+	start := time.Now()
 	_, err = c.auth.Login(ctx, appRoleClient)
-	metrics.ObserveAPICall(constants.ProviderHCVault, constants.CallHCVaultLogin, err)
+	metrics.ObserveAPICall(constants.ProviderHCVault, constants.CallHCVaultLogin, start, err)
 	if err != nil {
 		return err
 	}
@@ -20,6 +20,7 @@ import (
 	"fmt"
 	"net/http"
 	"strings"
+	"time"
 
 	vault "github.com/hashicorp/vault/api"
 
@@ -66,8 +67,9 @@ func (c *client) requestTokenWithCertAuth(ctx context.Context, certAuth *esv1.Va
 	}
 
 	url := strings.Join([]string{"auth", "cert", "login"}, "/")
+	start := time.Now()
 	vaultResult, err := c.logical.WriteWithContext(ctx, url, nil)
-	metrics.ObserveAPICall(constants.ProviderHCVault, constants.CallHCVaultWriteSecretData, err)
+	metrics.ObserveAPICall(constants.ProviderHCVault, constants.CallHCVaultWriteSecretData, start, err)
 	if err != nil {
 		return fmt.Errorf(errVaultRequest, err)
 	}
@@ -18,6 +18,7 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"time"
 
 	authkubernetes "github.com/hashicorp/vault/api/auth/kubernetes"
 	corev1 "k8s.io/api/core/v1"
@@ -60,8 +61,9 @@ func (c *client) requestTokenWithKubernetesAuth(ctx context.Context, kubernetesA
 	if err != nil {
 		return err
 	}
+	start := time.Now()
 	_, err = c.auth.Login(ctx, k)
-	metrics.ObserveAPICall(constants.ProviderHCVault, constants.CallHCVaultLogin, err)
+	metrics.ObserveAPICall(constants.ProviderHCVault, constants.CallHCVaultLogin, start, err)
 	if err != nil {
 		return err
 	}
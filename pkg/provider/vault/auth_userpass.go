@@ -17,6 +17,7 @@ package vault
 import (
 	"context"
 	"strings"
+	"time"
 
 	authuserpass "github.com/hashicorp/vault/api/auth/userpass"
 
@@ -49,8 +50,9 @@ func (c *client) requestTokenWithUserPassAuth(ctx context.Context, userPassAuth
 	if err != nil {
 		return err
 	}
+	start := time.Now()
 	_, err = c.auth.Login(ctx, l)
-	metrics.ObserveAPICall(constants.ProviderHCVault, constants.CallHCVaultLogin, err)
+	metrics.ObserveAPICall(constants.ProviderHCVault, constants.CallHCVaultLogin, start, err)
 	if err != nil {
 		return err
 	}
@@ -36,7 +36,8 @@ import (
 )
 
 var (
-	_           esv1.Provider = &Provider{}
+	_           esv1.Provider         = &Provider{}
+	_           esv1.ReferentProvider = &Provider{}
 	enableCache bool
 	logger      = ctrl.Log.WithName("provider").WithName("vault")
 	clientCache *cache.Cache[util.Client]
@@ -84,6 +85,12 @@ func (p *Provider) Capabilities() esv1.SecretStoreCapabilities {
 	return esv1.SecretStoreReadWrite
 }
 
+// IsReferent returns true if the store references credentials without a namespace.
+func (p *Provider) IsReferent(store esv1.GenericStore) bool {
+	prov := store.GetSpec().Provider.Vault
+	return prov != nil && isReferentSpec(prov)
+}
+
 // NewClient implements the Client interface.
 func (p *Provider) NewClient(ctx context.Context, store esv1.GenericStore, kube kclient.Client, namespace string) (esv1.SecretsClient, error) {
 	// controller-runtime/client does not support TokenRequest or other subresource APIs
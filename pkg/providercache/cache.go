@@ -0,0 +1,168 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package providercache implements a response cache that is shared by all
+// provider clients of a store, so that many ExternalSecrets reading the same
+// remote ref cause a single call to the provider.
+package providercache
+
+import (
+	"container/list"
+	"sync"
+	"time"
+
+	"golang.org/x/sync/singleflight"
+)
+
+// Cache is a lru cache of provider responses that is bounded by the size of
+// the cached values in bytes. Entries expire after a fixed TTL.
+// Concurrent lookups of the same missing key are collapsed into a single call.
+type Cache struct {
+	mu       sync.Mutex
+	ttl      time.Duration
+	maxBytes int64
+	size     int64
+	lru      *list.List
+	entries  map[string]*list.Element
+	// versions holds the last seen version of every store.
+	versions map[string]string
+	group    singleflight.Group
+	now      func() time.Time
+}
+
+type entry struct {
+	key     string
+	store   string
+	value   any
+	size    int64
+	expires time.Time
+}
+
+// New constructs a cache which holds at most maxBytes of secret data for the duration of ttl.
+func New(maxBytes int64, ttl time.Duration) *Cache {
+	return &Cache{
+		ttl:      ttl,
+		maxBytes: maxBytes,
+		lru:      list.New(),
+		entries:  make(map[string]*list.Element),
+		versions: make(map[string]string),
+		now:      time.Now,
+	}
+}
+
+// SetStoreVersion records the current version of a store.
+// If the version changed all entries of that store are purged.
+func (c *Cache) SetStoreVersion(store, version string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if previous, ok := c.versions[store]; ok && previous != version {
+		c.purgeLocked(store)
+	}
+	c.versions[store] = version
+}
+
+// Invalidate removes all entries of the store.
+func (c *Cache) Invalidate(store string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.purgeLocked(store)
+}
+
+// Size returns the number of bytes currently held by the cache.
+func (c *Cache) Size() int64 {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.size
+}
+
+// GetOrLoad returns the cached value of key or calls load to populate it.
+// Concurrent calls with the same key share a single call to load.
+// hit reports whether no call to load was made for this caller.
+func (c *Cache) GetOrLoad(store, key string, load func() (any, int64, error)) (value any, hit bool, err error) {
+	if value, ok := c.get(key); ok {
+		return value, true, nil
+	}
+	loaded := false
+	value, err, _ = c.group.Do(key, func() (any, error) {
+		// another flight may have populated the key in the meantime
+		if value, ok := c.get(key); ok {
+			return value, nil
+		}
+		loaded = true
+		value, size, err := load()
+		if err != nil {
+			return nil, err
+		}
+		c.add(store, key, value, size)
+		return value, nil
+	})
+	return value, !loaded, err
+}
+
+func (c *Cache) get(key string) (any, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	el, ok := c.entries[key]
+	if !ok {
+		return nil, false
+	}
+	e := el.Value.(*entry)
+	if c.now().After(e.expires) {
+		c.removeLocked(el)
+		return nil, false
+	}
+	c.lru.MoveToFront(el)
+	return e.value, true
+}
+
+func (c *Cache) add(store, key string, value any, size int64) {
+	size += int64(len(key))
+	if size > c.maxBytes {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if el, ok := c.entries[key]; ok {
+		c.removeLocked(el)
+	}
+	c.entries[key] = c.lru.PushFront(&entry{
+		key:     key,
+		store:   store,
+		value:   value,
+		size:    size,
+		expires: c.now().Add(c.ttl),
+	})
+	c.size += size
+	cacheSize.Set(float64(c.size))
+	for c.size > c.maxBytes {
+		c.removeLocked(c.lru.Back())
+	}
+}
+
+func (c *Cache) purgeLocked(store string) {
+	for el := c.lru.Front(); el != nil; {
+		next := el.Next()
+		if el.Value.(*entry).store == store {
+			c.removeLocked(el)
+		}
+		el = next
+	}
+}
+
+func (c *Cache) removeLocked(el *list.Element) {
+	e := c.lru.Remove(el).(*entry)
+	delete(c.entries, e.key)
+	c.size -= e.size
+	cacheSize.Set(float64(c.size))
+}
@@ -0,0 +1,179 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package providercache
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strconv"
+
+	corev1 "k8s.io/api/core/v1"
+
+	esv1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1"
+)
+
+const (
+	callGetSecret     = "GetSecret"
+	callGetSecretMap  = "GetSecretMap"
+	callGetAllSecrets = "GetAllSecrets"
+)
+
+// Client wraps a SecretsClient and serves GetSecret, GetSecretMap and GetAllSecrets
+// from the cache. Successful writes purge all cached responses of the store.
+type Client struct {
+	esv1.SecretsClient
+	cache    *Cache
+	store    string
+	prefix   string
+	provider string
+}
+
+var _ esv1.SecretsClient = &Client{}
+
+// NewClient returns a SecretsClient that caches the responses of client in c.
+// namespace is the namespace the client has been created for.
+func NewClient(c *Cache, client esv1.SecretsClient, store esv1.GenericStore, namespace string) *Client {
+	provider, _ := esv1.GetProviderName(store)
+	storeID := fmt.Sprintf("%s/%s/%s", store.GetKind(), store.GetNamespace(), store.GetName())
+	version := string(store.GetUID()) + "/" + strconv.FormatInt(store.GetGeneration(), 10)
+	c.SetStoreVersion(storeID, version)
+
+	prefix := storeID + "@" + version
+	// stores with referent authentication use the credentials of the namespace
+	// the client has been created for, so their responses must not be shared across namespaces.
+	if store.GetKind() == esv1.ClusterSecretStoreKind && isReferent(store) {
+		prefix += "/" + namespace
+	}
+	return &Client{
+		SecretsClient: client,
+		cache:         c,
+		store:         storeID,
+		prefix:        prefix,
+		provider:      provider,
+	}
+}
+
+func (c *Client) key(call string, ref any) (string, error) {
+	raw, err := json.Marshal(ref)
+	if err != nil {
+		return "", fmt.Errorf("unable to build cache key: %w", err)
+	}
+	return c.prefix + "|" + call + "|" + string(raw), nil
+}
+
+func (c *Client) GetSecret(ctx context.Context, ref esv1.ExternalSecretDataRemoteRef) ([]byte, error) {
+	key, err := c.key(callGetSecret, ref)
+	if err != nil {
+		return nil, err
+	}
+	value, hit, err := c.cache.GetOrLoad(c.store, key, func() (any, int64, error) {
+		secret, err := c.SecretsClient.GetSecret(context.WithoutCancel(ctx), ref)
+		return secret, int64(len(secret)), err
+	})
+	c.observe(callGetSecret, hit, err)
+	if err != nil {
+		return nil, err
+	}
+	return slices.Clone(value.([]byte)), nil
+}
+
+func (c *Client) GetSecretMap(ctx context.Context, ref esv1.ExternalSecretDataRemoteRef) (map[string][]byte, error) {
+	key, err := c.key(callGetSecretMap, ref)
+	if err != nil {
+		return nil, err
+	}
+	value, hit, err := c.cache.GetOrLoad(c.store, key, func() (any, int64, error) {
+		secrets, err := c.SecretsClient.GetSecretMap(context.WithoutCancel(ctx), ref)
+		return secrets, mapSize(secrets), err
+	})
+	c.observe(callGetSecretMap, hit, err)
+	if err != nil {
+		return nil, err
+	}
+	return cloneMap(value.(map[string][]byte)), nil
+}
+
+func (c *Client) GetAllSecrets(ctx context.Context, ref esv1.ExternalSecretFind) (map[string][]byte, error) {
+	key, err := c.key(callGetAllSecrets, ref)
+	if err != nil {
+		return nil, err
+	}
+	value, hit, err := c.cache.GetOrLoad(c.store, key, func() (any, int64, error) {
+		secrets, err := c.SecretsClient.GetAllSecrets(context.WithoutCancel(ctx), ref)
+		return secrets, mapSize(secrets), err
+	})
+	c.observe(callGetAllSecrets, hit, err)
+	if err != nil {
+		return nil, err
+	}
+	return cloneMap(value.(map[string][]byte)), nil
+}
+
+func (c *Client) PushSecret(ctx context.Context, secret *corev1.Secret, data esv1.PushSecretData) error {
+	err := c.SecretsClient.PushSecret(ctx, secret, data)
+	if err == nil {
+		c.cache.Invalidate(c.store)
+	}
+	return err
+}
+
+func (c *Client) DeleteSecret(ctx context.Context, remoteRef esv1.PushSecretRemoteRef) error {
+	err := c.SecretsClient.DeleteSecret(ctx, remoteRef)
+	if err == nil {
+		c.cache.Invalidate(c.store)
+	}
+	return err
+}
+
+func (c *Client) observe(call string, hit bool, err error) {
+	if err != nil {
+		return
+	}
+	if hit {
+		cacheHits.WithLabelValues(c.provider, call).Inc()
+		return
+	}
+	cacheMisses.WithLabelValues(c.provider, call).Inc()
+}
+
+func mapSize(m map[string][]byte) int64 {
+	var size int64
+	for k, v := range m {
+		size += int64(len(k) + len(v))
+	}
+	return size
+}
+
+func cloneMap(m map[string][]byte) map[string][]byte {
+	out := make(map[string][]byte, len(m))
+	for k, v := range m {
+		out[k] = slices.Clone(v)
+	}
+	return out
+}
+
+// isReferent returns true if the store resolves its credentials in the namespace
+// of the ExternalSecret. Stores of providers that do not implement
+// esv1.ReferentProvider are treated as referent.
+func isReferent(store esv1.GenericStore) bool {
+	provider, err := esv1.GetProvider(store)
+	if err != nil {
+		return true
+	}
+	referent, ok := provider.(esv1.ReferentProvider)
+	return !ok || referent.IsReferent(store)
+}
@@ -0,0 +1,92 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package providercache
+
+import (
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/spf13/pflag"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+
+	esv1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1"
+	"github.com/external-secrets/external-secrets/pkg/feature"
+	esmetrics "github.com/external-secrets/external-secrets/pkg/metrics"
+)
+
+const (
+	defaultTTL      = time.Minute
+	defaultMaxBytes = 64 << 20
+)
+
+var (
+	log = ctrl.Log.WithName("providercache")
+
+	sharedCache *Cache
+
+	cacheHits = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Subsystem: esmetrics.ExternalSecretSubsystem,
+		Name:      "provider_cache_hits_total",
+		Help:      "Number of provider calls served from the provider response cache",
+	}, []string{"provider", "call"})
+
+	cacheMisses = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Subsystem: esmetrics.ExternalSecretSubsystem,
+		Name:      "provider_cache_misses_total",
+		Help:      "Number of provider calls not found in the provider response cache",
+	}, []string{"provider", "call"})
+
+	cacheSize = prometheus.NewGauge(prometheus.GaugeOpts{
+		Subsystem: esmetrics.ExternalSecretSubsystem,
+		Name:      "provider_cache_size_bytes",
+		Help:      "Size of the secret data held by the provider response cache",
+	})
+)
+
+// Enabled returns true if the shared response cache is enabled.
+func Enabled() bool {
+	return sharedCache != nil
+}
+
+// Wrap returns a client that serves responses from the shared cache.
+// namespace is the namespace the client has been created for.
+func Wrap(client esv1.SecretsClient, store esv1.GenericStore, namespace string) esv1.SecretsClient {
+	return NewClient(sharedCache, client, store, namespace)
+}
+
+func init() {
+	metrics.Registry.MustRegister(cacheHits, cacheMisses, cacheSize)
+
+	var (
+		enabled  bool
+		ttl      time.Duration
+		maxBytes int64
+	)
+	fs := pflag.NewFlagSet("providercache", pflag.ExitOnError)
+	fs.BoolVar(&enabled, "enable-provider-response-cache", false, "Enable a response cache shared by all ExternalSecrets using the same store. Identical requests are collapsed into a single provider call.")
+	fs.DurationVar(&ttl, "provider-response-cache-ttl", defaultTTL, "Time provider responses are cached. Only used if --enable-provider-response-cache is set.")
+	fs.Int64Var(&maxBytes, "provider-response-cache-max-bytes", defaultMaxBytes, "Maximum size of secret data held by the provider response cache. Only used if --enable-provider-response-cache is set.")
+	feature.Register(feature.Feature{
+		Flags: fs,
+		Initialize: func() {
+			if !enabled {
+				return
+			}
+			log.Info("initializing provider response cache", "ttl", ttl, "maxBytes", maxBytes)
+			sharedCache = New(maxBytes, ttl)
+		},
+	})
+}
@@ -0,0 +1,217 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package providercache
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	esv1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1"
+	esmeta "github.com/external-secrets/external-secrets/apis/meta/v1"
+	// register the kubernetes provider, which supports referent authentication.
+	_ "github.com/external-secrets/external-secrets/pkg/provider/kubernetes"
+	"github.com/external-secrets/external-secrets/pkg/provider/testing/fake"
+)
+
+func load(value string, calls *atomic.Int32) func() (any, int64, error) {
+	return func() (any, int64, error) {
+		calls.Add(1)
+		return []byte(value), int64(len(value)), nil
+	}
+}
+
+func TestCacheTTL(t *testing.T) {
+	now := time.Now()
+	c := New(1024, time.Minute)
+	c.now = func() time.Time { return now }
+	var calls atomic.Int32
+
+	if _, hit, _ := c.GetOrLoad("store", "key", load("value", &calls)); hit {
+		t.Error("expected a miss on first access")
+	}
+	if _, hit, _ := c.GetOrLoad("store", "key", load("value", &calls)); !hit {
+		t.Error("expected a hit on second access")
+	}
+	now = now.Add(2 * time.Minute)
+	if _, hit, _ := c.GetOrLoad("store", "key", load("value", &calls)); hit {
+		t.Error("expected a miss after the ttl")
+	}
+	if calls.Load() != 2 {
+		t.Errorf("expected 2 loads, got %d", calls.Load())
+	}
+}
+
+func TestCacheMaxBytes(t *testing.T) {
+	c := New(20, time.Minute)
+	var calls atomic.Int32
+	_, _, _ = c.GetOrLoad("store", "a", load("0123456789", &calls))
+	_, _, _ = c.GetOrLoad("store", "b", load("0123456789", &calls))
+	if c.Size() > 20 {
+		t.Errorf("expected cache size to be bounded by 20 bytes, got %d", c.Size())
+	}
+	if _, ok := c.get("a"); ok {
+		t.Error("expected least recently used entry to be evicted")
+	}
+	if _, ok := c.get("b"); !ok {
+		t.Error("expected most recently used entry to be cached")
+	}
+	_, _, _ = c.GetOrLoad("store", "c", load("this value is larger than the cache", &calls))
+	if _, ok := c.get("c"); ok {
+		t.Error("expected value larger than the cache not to be cached")
+	}
+}
+
+func TestCacheStoreVersion(t *testing.T) {
+	c := New(1024, time.Minute)
+	var calls atomic.Int32
+	c.SetStoreVersion("store", "1")
+	_, _, _ = c.GetOrLoad("store", "a", load("value", &calls))
+	_, _, _ = c.GetOrLoad("other", "b", load("value", &calls))
+	c.SetStoreVersion("store", "1")
+	if _, ok := c.get("a"); !ok {
+		t.Error("expected entry to be kept if the store version did not change")
+	}
+	c.SetStoreVersion("store", "2")
+	if _, ok := c.get("a"); ok {
+		t.Error("expected entry to be purged after the store version changed")
+	}
+	if _, ok := c.get("b"); !ok {
+		t.Error("expected entries of other stores to be kept")
+	}
+}
+
+func TestCacheSingleflight(t *testing.T) {
+	c := New(1024, time.Minute)
+	var calls atomic.Int32
+	release := make(chan struct{})
+	slowLoad := func() (any, int64, error) {
+		calls.Add(1)
+		<-release
+		return []byte("value"), 5, nil
+	}
+
+	var wg sync.WaitGroup
+	for range 10 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, _, _ = c.GetOrLoad("store", "key", slowLoad)
+		}()
+	}
+	time.Sleep(50 * time.Millisecond)
+	close(release)
+	wg.Wait()
+	if calls.Load() != 1 {
+		t.Errorf("expected concurrent requests to be collapsed into 1 load, got %d", calls.Load())
+	}
+}
+
+func newStore(kind string, provider *esv1.SecretStoreProvider) esv1.GenericStore {
+	meta := metav1.ObjectMeta{Name: "store", UID: "1234", Generation: 1}
+	spec := esv1.SecretStoreSpec{Provider: provider}
+	if kind == esv1.ClusterSecretStoreKind {
+		return &esv1.ClusterSecretStore{ObjectMeta: meta, Spec: spec}
+	}
+	meta.Namespace = "default"
+	return &esv1.SecretStore{ObjectMeta: meta, Spec: spec}
+}
+
+func TestClient(t *testing.T) {
+	c := New(1024, time.Minute)
+	store := newStore(esv1.SecretStoreKind, &esv1.SecretStoreProvider{Fake: &esv1.FakeProvider{}})
+	var calls int
+	fakeClient := fake.New()
+	fakeClient.GetSecretFn = func(_ context.Context, ref esv1.ExternalSecretDataRemoteRef) ([]byte, error) {
+		calls++
+		if ref.Key == "missing" {
+			return nil, esv1.NoSecretErr
+		}
+		return []byte(ref.Key), nil
+	}
+
+	for range 3 {
+		client := NewClient(c, fakeClient, store, "default")
+		secret, err := client.GetSecret(context.Background(), esv1.ExternalSecretDataRemoteRef{Key: "db"})
+		if err != nil {
+			t.Fatal(err)
+		}
+		// callers must not be able to modify the cached value
+		secret[0] = 'x'
+		if _, err := client.GetSecret(context.Background(), esv1.ExternalSecretDataRemoteRef{Key: "missing"}); !errors.Is(err, esv1.NoSecretErr) {
+			t.Fatalf("expected NoSecretErr, got %v", err)
+		}
+	}
+	if calls != 4 {
+		t.Errorf("expected 1 call for the cached key and 3 for the missing key, got %d", calls)
+	}
+	secret, _ := NewClient(c, fakeClient, store, "default").GetSecret(context.Background(), esv1.ExternalSecretDataRemoteRef{Key: "db"})
+	if string(secret) != "db" {
+		t.Errorf("expected cached value to be unmodified, got %q", secret)
+	}
+
+	store.SetGeneration(2)
+	_, _ = NewClient(c, fakeClient, store, "default").GetSecret(context.Background(), esv1.ExternalSecretDataRemoteRef{Key: "db"})
+	if calls != 5 {
+		t.Errorf("expected a call after the store spec changed, got %d calls", calls)
+	}
+}
+
+func TestClientClusterStore(t *testing.T) {
+	tests := []struct {
+		name      string
+		namespace *string
+		wantCalls int
+	}{
+		{
+			name:      "shared across namespaces",
+			namespace: ptr.To("eso"),
+			wantCalls: 1,
+		},
+		{
+			name:      "referent authentication",
+			wantCalls: 2,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := New(1024, time.Minute)
+			store := newStore(esv1.ClusterSecretStoreKind, &esv1.SecretStoreProvider{Kubernetes: &esv1.KubernetesProvider{
+				Auth: esv1.KubernetesAuth{ServiceAccount: &esmeta.ServiceAccountSelector{Name: "reader", Namespace: tt.namespace}},
+			}})
+			var calls int
+			fakeClient := fake.New()
+			fakeClient.GetSecretFn = func(_ context.Context, ref esv1.ExternalSecretDataRemoteRef) ([]byte, error) {
+				calls++
+				return []byte(ref.Key), nil
+			}
+
+			for _, namespace := range []string{"foo", "foo", "bar"} {
+				if _, err := NewClient(c, fakeClient, store, namespace).GetSecret(context.Background(), esv1.ExternalSecretDataRemoteRef{Key: "db"}); err != nil {
+					t.Fatal(err)
+				}
+			}
+			if calls != tt.wantCalls {
+				t.Errorf("expected %d calls, got %d", tt.wantCalls, calls)
+			}
+		})
+	}
+}
@@ -22,6 +22,9 @@ import (
 	v2 "github.com/external-secrets/external-secrets/pkg/template/v2"
 )
 
+// ErrLimitExceeded is returned if a template exceeds one of the configured resource limits.
+var ErrLimitExceeded = v2.ErrLimitExceeded
+
 type ExecFunc func(tpl, data map[string][]byte, scope esapi.TemplateScope, target esapi.TemplateTarget, secret *corev1.Secret) error
 
 func EngineForVersion(version esapi.TemplateEngineVersion) (ExecFunc, error) {
@@ -0,0 +1,430 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package template
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"maps"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode/utf16"
+
+	"github.com/BurntSushi/toml"
+	"gopkg.in/ini.v1"
+)
+
+var dotenvKey = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_.]*$`)
+
+// toTOML takes a map and marshals it to toml with sorted keys.
+func toTOML(v any) (string, error) {
+	buf := &bytes.Buffer{}
+	if err := toml.NewEncoder(buf).Encode(v); err != nil {
+		return "", err
+	}
+	return strings.TrimSuffix(buf.String(), "\n"), nil
+}
+
+// fromTOML converts a TOML document into a map[string]any.
+// It inserts the error message string into m["Error"] in the returned map.
+func fromTOML(str string) map[string]any {
+	m := map[string]any{}
+	if _, err := toml.Decode(str, &m); err != nil {
+		m["Error"] = err.Error()
+	}
+	return m
+}
+
+// toINI takes a map and marshals it to an INI file with sorted keys.
+// Maps are written as sections, all other values as keys of the default section.
+func toINI(v any) (string, error) {
+	m, err := toStringKeyMap(v)
+	if err != nil {
+		return "", err
+	}
+	file := ini.Empty()
+	for _, key := range slices.Sorted(maps.Keys(m)) {
+		if _, isMap := m[key].(map[string]any); isMap {
+			continue
+		}
+		value, err := scalarString(key, m[key])
+		if err != nil {
+			return "", err
+		}
+		if _, err := file.Section("").NewKey(key, value); err != nil {
+			return "", err
+		}
+	}
+	for _, name := range slices.Sorted(maps.Keys(m)) {
+		values, isMap := m[name].(map[string]any)
+		if !isMap {
+			continue
+		}
+		section, err := file.NewSection(name)
+		if err != nil {
+			return "", err
+		}
+		for _, key := range slices.Sorted(maps.Keys(values)) {
+			value, err := scalarString(name+"."+key, values[key])
+			if err != nil {
+				return "", err
+			}
+			if _, err := section.NewKey(key, value); err != nil {
+				return "", err
+			}
+		}
+	}
+	buf := &bytes.Buffer{}
+	if _, err := file.WriteTo(buf); err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(buf.String()), nil
+}
+
+// fromINI converts an INI file into a map[string]any.
+// Keys of the default section are top level keys, all other sections are maps.
+// It inserts the error message string into m["Error"] in the returned map.
+func fromINI(str string) map[string]any {
+	m := map[string]any{}
+	file, err := ini.Load([]byte(str))
+	if err != nil {
+		m["Error"] = err.Error()
+		return m
+	}
+	for _, section := range file.Sections() {
+		values := section.KeysHash()
+		if section.Name() == ini.DefaultSection {
+			for k, v := range values {
+				m[k] = v
+			}
+			continue
+		}
+		sectionMap := make(map[string]any, len(values))
+		for k, v := range values {
+			sectionMap[k] = v
+		}
+		m[section.Name()] = sectionMap
+	}
+	return m
+}
+
+// toProperties takes a map and marshals it to a Java properties file with sorted keys.
+// Nested maps are flattened using dots, e.g. spring.datasource.url.
+func toProperties(v any) (string, error) {
+	m, err := toStringKeyMap(v)
+	if err != nil {
+		return "", err
+	}
+	flat := map[string]string{}
+	if err := flatten("", m, flat); err != nil {
+		return "", err
+	}
+	var sb strings.Builder
+	for _, key := range slices.Sorted(maps.Keys(flat)) {
+		sb.WriteString(escapeProperty(key, true))
+		sb.WriteByte('=')
+		sb.WriteString(escapeProperty(flat[key], false))
+		sb.WriteByte('\n')
+	}
+	return strings.TrimSuffix(sb.String(), "\n"), nil
+}
+
+// fromProperties converts a Java properties file into a map[string]any.
+func fromProperties(str string) map[string]any {
+	m := map[string]any{}
+	lines := strings.Split(strings.ReplaceAll(str, "\r\n", "\n"), "\n")
+	for i := 0; i < len(lines); i++ {
+		line := strings.TrimLeft(lines[i], " \t\f")
+		if line == "" || line[0] == '#' || line[0] == '!' {
+			continue
+		}
+		// join continuation lines, which end with an odd number of backslashes
+		for endsWithEscape(line) && i+1 < len(lines) {
+			i++
+			line = line[:len(line)-1] + strings.TrimLeft(lines[i], " \t\f")
+		}
+		key, value := splitProperty(line)
+		m[unescapeProperty(key)] = unescapeProperty(value)
+	}
+	return m
+}
+
+// toDotenv takes a map and marshals it to a dotenv file with sorted keys.
+// Values are quoted if required, so that they are not expanded.
+func toDotenv(v any) (string, error) {
+	m, err := toStringKeyMap(v)
+	if err != nil {
+		return "", err
+	}
+	var sb strings.Builder
+	for _, key := range slices.Sorted(maps.Keys(m)) {
+		if !dotenvKey.MatchString(key) {
+			return "", fmt.Errorf("invalid dotenv key %q", key)
+		}
+		value, err := scalarString(key, m[key])
+		if err != nil {
+			return "", err
+		}
+		sb.WriteString(key)
+		sb.WriteByte('=')
+		sb.WriteString(quoteDotenv(value))
+		sb.WriteByte('\n')
+	}
+	return strings.TrimSuffix(sb.String(), "\n"), nil
+}
+
+// fromDotenv converts a dotenv file into a map[string]any.
+// Variables are not expanded.
+// It inserts the error message string into m["Error"] in the returned map.
+func fromDotenv(str string) map[string]any {
+	m := map[string]any{}
+	rest := strings.ReplaceAll(str, "\r\n", "\n")
+	for rest != "" {
+		var line string
+		line, rest, _ = strings.Cut(rest, "\n")
+		line = strings.TrimSpace(line)
+		if line == "" || line[0] == '#' {
+			continue
+		}
+		line = strings.TrimPrefix(line, "export ")
+		key, value, found := strings.Cut(line, "=")
+		key = strings.TrimSpace(key)
+		if !found || !dotenvKey.MatchString(key) {
+			m["Error"] = fmt.Sprintf("invalid dotenv line %q", line)
+			return m
+		}
+		value = strings.TrimLeft(value, " \t")
+		if value != "" && (value[0] == '"' || value[0] == '\'') {
+			// quoted values may span multiple lines
+			parsed, remaining, err := unquoteDotenv(value + "\n" + rest)
+			if err != nil {
+				m["Error"] = fmt.Sprintf("invalid value of key %q: %v", key, err)
+				return m
+			}
+			m[key] = parsed
+			_, rest, _ = strings.Cut(remaining, "\n")
+			continue
+		}
+		if i := strings.Index(value, " #"); i >= 0 {
+			value = value[:i]
+		}
+		m[key] = strings.TrimSpace(value)
+	}
+	return m
+}
+
+// toStringKeyMap converts the template data and maps decoded by fromJson or fromYaml to a map[string]any.
+func toStringKeyMap(v any) (map[string]any, error) {
+	switch t := v.(type) {
+	case map[string]any:
+		return t, nil
+	case map[string]string:
+		m := make(map[string]any, len(t))
+		for k, v := range t {
+			m[k] = v
+		}
+		return m, nil
+	case map[string][]byte:
+		m := make(map[string]any, len(t))
+		for k, v := range t {
+			m[k] = string(v)
+		}
+		return m, nil
+	default:
+		return nil, fmt.Errorf("expected a map, got %T", v)
+	}
+}
+
+func scalarString(key string, v any) (string, error) {
+	switch t := v.(type) {
+	case nil:
+		return "", nil
+	case string:
+		return t, nil
+	case []byte:
+		return string(t), nil
+	case bool, int, int32, int64, uint, uint32, uint64, float32, float64:
+		return fmt.Sprint(t), nil
+	default:
+		return "", fmt.Errorf("value of key %q is not a scalar: %T", key, v)
+	}
+}
+
+func flatten(prefix string, m map[string]any, out map[string]string) error {
+	for k, v := range m {
+		key := k
+		if prefix != "" {
+			key = prefix + "." + k
+		}
+		if nested, ok := v.(map[string]any); ok {
+			if err := flatten(key, nested, out); err != nil {
+				return err
+			}
+			continue
+		}
+		value, err := scalarString(key, v)
+		if err != nil {
+			return err
+		}
+		out[key] = value
+	}
+	return nil
+}
+
+// escapeProperty escapes a key or value the same way as java.util.Properties#store.
+func escapeProperty(s string, isKey bool) string {
+	var sb strings.Builder
+	for i, r := range s {
+		switch {
+		case r == ' ' && (isKey || i == 0):
+			sb.WriteString(`\ `)
+		case r == '\\':
+			sb.WriteString(`\\`)
+		case r == '\t':
+			sb.WriteString(`\t`)
+		case r == '\n':
+			sb.WriteString(`\n`)
+		case r == '\r':
+			sb.WriteString(`\r`)
+		case r == '\f':
+			sb.WriteString(`\f`)
+		case r == '=' || r == ':' || r == '#' || r == '!':
+			sb.WriteByte('\\')
+			sb.WriteRune(r)
+		case r < 0x20 || r > 0x7e:
+			for _, c := range utf16.Encode([]rune{r}) {
+				fmt.Fprintf(&sb, `\u%04X`, c)
+			}
+		default:
+			sb.WriteRune(r)
+		}
+	}
+	return sb.String()
+}
+
+func unescapeProperty(s string) string {
+	var sb strings.Builder
+	var surrogates []uint16
+	flush := func() {
+		if len(surrogates) > 0 {
+			sb.WriteString(string(utf16.Decode(surrogates)))
+			surrogates = surrogates[:0]
+		}
+	}
+	for i := 0; i < len(s); i++ {
+		if s[i] != '\\' || i+1 == len(s) {
+			flush()
+			sb.WriteByte(s[i])
+			continue
+		}
+		i++
+		if s[i] == 'u' && i+4 < len(s) {
+			if c, err := strconv.ParseUint(s[i+1:i+5], 16, 16); err == nil {
+				surrogates = append(surrogates, uint16(c))
+				i += 4
+				continue
+			}
+		}
+		flush()
+		switch s[i] {
+		case 't':
+			sb.WriteByte('\t')
+		case 'n':
+			sb.WriteByte('\n')
+		case 'r':
+			sb.WriteByte('\r')
+		case 'f':
+			sb.WriteByte('\f')
+		default:
+			sb.WriteByte(s[i])
+		}
+	}
+	flush()
+	return sb.String()
+}
+
+func endsWithEscape(line string) bool {
+	n := 0
+	for i := len(line) - 1; i >= 0 && line[i] == '\\'; i-- {
+		n++
+	}
+	return n%2 == 1
+}
+
+// splitProperty splits a logical line at the first unescaped separator.
+func splitProperty(line string) (string, string) {
+	for i := 0; i < len(line); i++ {
+		switch line[i] {
+		case '\\':
+			i++
+		case '=', ':':
+			return line[:i], strings.TrimLeft(line[i+1:], " \t\f")
+		case ' ', '\t', '\f':
+			value := strings.TrimLeft(line[i:], " \t\f")
+			if value != "" && (value[0] == '=' || value[0] == ':') {
+				value = strings.TrimLeft(value[1:], " \t\f")
+			}
+			return line[:i], value
+		}
+	}
+	return line, ""
+}
+
+func quoteDotenv(value string) string {
+	if value == "" {
+		return value
+	}
+	if strings.IndexFunc(value, func(r rune) bool {
+		return !(r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9' || strings.ContainsRune("_-./:@,+", r))
+	}) < 0 {
+		return value
+	}
+	// single quoted values are not expanded
+	if !strings.ContainsAny(value, "'\n") {
+		return "'" + value + "'"
+	}
+	replacer := strings.NewReplacer(`\`, `\\`, `"`, `\"`, "$", `\$`, "`", "\\`", "\n", `\n`)
+	return `"` + replacer.Replace(value) + `"`
+}
+
+// unquoteDotenv unquotes the value at the start of s and returns the remaining input.
+func unquoteDotenv(s string) (string, string, error) {
+	quote := s[0]
+	var sb strings.Builder
+	for i := 1; i < len(s); i++ {
+		switch {
+		case s[i] == quote:
+			return sb.String(), s[i+1:], nil
+		case quote == '"' && s[i] == '\\' && i+1 < len(s):
+			i++
+			switch s[i] {
+			case 'n':
+				sb.WriteByte('\n')
+			case 'r':
+				sb.WriteByte('\r')
+			case 't':
+				sb.WriteByte('\t')
+			default:
+				sb.WriteByte(s[i])
+			}
+		default:
+			sb.WriteByte(s[i])
+		}
+	}
+	return "", "", errors.New("missing closing quote")
+}
@@ -0,0 +1,132 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package template
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestTOML(t *testing.T) {
+	out, err := toTOML(map[string]any{"b": "2", "a": "1", "db": map[string]any{"host": "localhost", "port": 5432}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := "a = \"1\"\nb = \"2\"\n\n[db]\n  host = \"localhost\"\n  port = 5432"
+	if out != want {
+		t.Errorf("toTOML() = %q, want %q", out, want)
+	}
+	m := fromTOML(out)
+	if m["a"] != "1" || m["db"].(map[string]any)["port"] != int64(5432) {
+		t.Errorf("fromTOML() = %v", m)
+	}
+	if _, ok := fromTOML("a = ")["Error"]; !ok {
+		t.Error("expected an error for invalid toml")
+	}
+}
+
+func TestINI(t *testing.T) {
+	out, err := toINI(map[string]any{"name": "app", "db": map[string]any{"user": "admin", "password": "p;a#ss"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := "name = app\n\n[db]\npassword = `p;a#ss`\nuser     = admin"
+	if out != want {
+		t.Errorf("toINI() = %q, want %q", out, want)
+	}
+	want2 := map[string]any{"name": "app", "db": map[string]any{"user": "admin", "password": "p;a#ss"}}
+	if m := fromINI(out); !reflect.DeepEqual(m, want2) {
+		t.Errorf("fromINI() = %v, want %v", m, want2)
+	}
+	if _, err := toINI(map[string]any{"db": map[string]any{"nested": map[string]any{}}}); err == nil {
+		t.Error("expected an error for nested sections")
+	}
+}
+
+func TestProperties(t *testing.T) {
+	in := map[string]string{
+		"spring.datasource.url": "jdbc:postgresql://db:5432/app",
+		"key with spaces":       " leading space",
+		"multiline":             "a\nb",
+		"unicode":               "grüße 🔑",
+	}
+	out, err := toProperties(in)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `key\ with\ spaces=\ leading space
+multiline=a\nb
+spring.datasource.url=jdbc\:postgresql\://db\:5432/app
+unicode=gr\u00FC\u00DFe \uD83D\uDD11`
+	if out != want {
+		t.Errorf("toProperties() = %q, want %q", out, want)
+	}
+	m := fromProperties(out)
+	for k, v := range in {
+		if m[k] != v {
+			t.Errorf("fromProperties()[%q] = %q, want %q", k, m[k], v)
+		}
+	}
+
+	nested, err := toProperties(map[string]any{"spring": map[string]any{"profiles": "prod"}})
+	if err != nil || nested != "spring.profiles=prod" {
+		t.Errorf("toProperties() = %q, %v", nested, err)
+	}
+
+	parsed := fromProperties("# comment\n! comment\n  a : 1\nb 2\nc=long \\\n    value\n")
+	want2 := map[string]any{"a": "1", "b": "2", "c": "long value"}
+	if !reflect.DeepEqual(parsed, want2) {
+		t.Errorf("fromProperties() = %v, want %v", parsed, want2)
+	}
+}
+
+func TestDotenv(t *testing.T) {
+	in := map[string]string{
+		"PLAIN":     "value",
+		"SPACES":    "hello world",
+		"EXPANSION": "$HOME",
+		"QUOTES":    "it's \"quoted\"",
+		"MULTILINE": "a\nb",
+		"EMPTY":     "",
+	}
+	out, err := toDotenv(in)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `EMPTY=
+EXPANSION='$HOME'
+MULTILINE="a\nb"
+PLAIN=value
+QUOTES="it's \"quoted\""
+SPACES='hello world'`
+	if out != want {
+		t.Errorf("toDotenv() = %q, want %q", out, want)
+	}
+	m := fromDotenv(out)
+	for k, v := range in {
+		if m[k] != v {
+			t.Errorf("fromDotenv()[%q] = %q, want %q", k, m[k], v)
+		}
+	}
+	if _, err := toDotenv(map[string]string{"1INVALID": "x"}); err == nil {
+		t.Error("expected an error for an invalid key")
+	}
+
+	parsed := fromDotenv("# comment\nexport A=1 # inline\nB=\"multi\nline\"\nC='$x'\n")
+	want2 := map[string]any{"A": "1", "B": "multi\nline", "C": "$x"}
+	if !reflect.DeepEqual(parsed, want2) {
+		t.Errorf("fromDotenv() = %v, want %v", parsed, want2)
+	}
+}
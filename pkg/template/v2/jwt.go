@@ -0,0 +1,199 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package template
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/lestrrat-go/jwx/v2/jwa"
+	"github.com/lestrrat-go/jwx/v2/jwk"
+	"github.com/lestrrat-go/jwx/v2/jws"
+)
+
+const errNoPublicKey = "no public key found"
+
+// numericClaims are the registered claims which must be numbers.
+// Their values are often created by sprig functions returning strings, e.g. now | unixEpoch.
+var numericClaims = []string{"iat", "exp", "nbf"}
+
+// pemToJwks builds a JSON Web Key Set from the public keys, certificates and private keys
+// of a list of PEM blocks. Only the public part of the keys is included.
+// The key IDs are the RFC 7638 thumbprints of the keys.
+func pemToJwks(input string) (string, error) {
+	set := jwk.NewSet()
+	data := []byte(input)
+	for {
+		block, rest := pem.Decode(data)
+		if block == nil {
+			break
+		}
+		data = rest
+		pub, err := publicKeyOf(block)
+		if err != nil {
+			return "", err
+		}
+		if pub == nil {
+			continue
+		}
+		key, err := jwk.FromRaw(pub)
+		if err != nil {
+			return "", err
+		}
+		alg, err := signatureAlgorithm(pub)
+		if err != nil {
+			return "", err
+		}
+		if err := jwk.AssignKeyID(key); err != nil {
+			return "", err
+		}
+		if err := key.Set(jwk.AlgorithmKey, alg); err != nil {
+			return "", err
+		}
+		if err := key.Set(jwk.KeyUsageKey, jwk.ForSignature); err != nil {
+			return "", err
+		}
+		if err := set.AddKey(key); err != nil {
+			return "", err
+		}
+	}
+	if set.Len() == 0 {
+		return "", errors.New(errNoPublicKey)
+	}
+	out, err := json.Marshal(set)
+	if err != nil {
+		return "", err
+	}
+	return string(out), nil
+}
+
+// jwtSign signs the claims with a PEM encoded private key and returns a compact JWT.
+// The algorithm is RS256, ES256, ES384, ES512 or EdDSA depending on the key.
+// claims is a map, e.g. created with dict, or a JSON object.
+func jwtSign(key string, claims any) (string, error) {
+	block, _ := pem.Decode([]byte(key))
+	if block == nil {
+		return "", errors.New(errParsePrivKey)
+	}
+	privateKey, err := parsePrivateKey(block.Bytes)
+	if err != nil {
+		return "", err
+	}
+	signer, ok := privateKey.(crypto.Signer)
+	if !ok {
+		return "", errors.New(errParsePrivKey)
+	}
+	alg, err := signatureAlgorithm(signer.Public())
+	if err != nil {
+		return "", err
+	}
+	payload, err := claimsPayload(claims)
+	if err != nil {
+		return "", err
+	}
+	headers := jws.NewHeaders()
+	if err := headers.Set(jws.TypeKey, "JWT"); err != nil {
+		return "", err
+	}
+	token, err := jws.Sign(payload, jws.WithKey(alg, privateKey, jws.WithProtectedHeaders(headers)))
+	if err != nil {
+		return "", err
+	}
+	return string(token), nil
+}
+
+func claimsPayload(claims any) ([]byte, error) {
+	var m map[string]any
+	switch t := claims.(type) {
+	case string:
+		if err := json.Unmarshal([]byte(t), &m); err != nil {
+			return nil, fmt.Errorf("unable to decode claims: %w", err)
+		}
+	default:
+		var err error
+		if m, err = toStringKeyMap(claims); err != nil {
+			return nil, err
+		}
+	}
+	for _, claim := range numericClaims {
+		value, ok := m[claim].(string)
+		if !ok {
+			continue
+		}
+		n, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("claim %q must be a number: %w", claim, err)
+		}
+		m[claim] = n
+	}
+	return json.Marshal(m)
+}
+
+func publicKeyOf(block *pem.Block) (any, error) {
+	switch block.Type {
+	case pemTypeCertificate:
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return nil, err
+		}
+		return cert.PublicKey, nil
+	case "PUBLIC KEY":
+		return x509.ParsePKIXPublicKey(block.Bytes)
+	case "RSA PUBLIC KEY":
+		return x509.ParsePKCS1PublicKey(block.Bytes)
+	case pemTypeKey, "RSA PRIVATE KEY", "EC PRIVATE KEY":
+		key, err := parsePrivateKey(block.Bytes)
+		if err != nil {
+			return nil, err
+		}
+		signer, ok := key.(crypto.Signer)
+		if !ok {
+			return nil, errors.New(errParsePrivKey)
+		}
+		return signer.Public(), nil
+	default:
+		return nil, nil
+	}
+}
+
+func signatureAlgorithm(pub crypto.PublicKey) (jwa.SignatureAlgorithm, error) {
+	switch k := pub.(type) {
+	case *rsa.PublicKey:
+		return jwa.RS256, nil
+	case *ecdsa.PublicKey:
+		switch k.Curve {
+		case elliptic.P256():
+			return jwa.ES256, nil
+		case elliptic.P384():
+			return jwa.ES384, nil
+		case elliptic.P521():
+			return jwa.ES512, nil
+		}
+		return "", fmt.Errorf("unsupported curve %s", k.Curve.Params().Name)
+	case ed25519.PublicKey:
+		return jwa.EdDSA, nil
+	default:
+		return "", fmt.Errorf("unsupported key type %T", pub)
+	}
+}
@@ -0,0 +1,143 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package template
+
+import (
+	"crypto/ed25519"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"os"
+	"testing"
+
+	"github.com/lestrrat-go/jwx/v2/jwa"
+	"github.com/lestrrat-go/jwx/v2/jwk"
+	"github.com/lestrrat-go/jwx/v2/jws"
+)
+
+func ed25519Key(t *testing.T) string {
+	t.Helper()
+	_, key, err := ed25519.GenerateKey(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(pem.EncodeToMemory(&pem.Block{Type: pemTypeKey, Bytes: der}))
+}
+
+func TestPemToJwks(t *testing.T) {
+	ecKey, err := os.ReadFile("_testdata/foo.key")
+	if err != nil {
+		t.Fatal(err)
+	}
+	out, err := pemToJwks(certData + string(ecKey) + ed25519Key(t))
+	if err != nil {
+		t.Fatal(err)
+	}
+	set, err := jwk.Parse([]byte(out))
+	if err != nil {
+		t.Fatal(err)
+	}
+	wantAlgs := []jwa.SignatureAlgorithm{jwa.RS256, jwa.ES256, jwa.EdDSA}
+	if set.Len() != len(wantAlgs) {
+		t.Fatalf("pemToJwks() returned %d keys, want %d", set.Len(), len(wantAlgs))
+	}
+	for i, alg := range wantAlgs {
+		key, _ := set.Key(i)
+		if key.Algorithm() != alg {
+			t.Errorf("key %d: alg = %v, want %v", i, key.Algorithm(), alg)
+		}
+		if key.KeyID() == "" {
+			t.Errorf("key %d: expected a key id", i)
+		}
+		if _, isPrivate := key.(jwk.RSAPrivateKey); isPrivate {
+			t.Errorf("key %d: expected a public key", i)
+		}
+	}
+
+	if _, err := pemToJwks("junk"); err == nil {
+		t.Error("pemToJwks() expected an error without keys")
+	}
+}
+
+func TestJwtSign(t *testing.T) {
+	ecKey, err := os.ReadFile("_testdata/foo.key")
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		name   string
+		key    string
+		claims any
+		alg    jwa.SignatureAlgorithm
+	}{
+		{
+			name:   "rsa key with dict claims",
+			key:    keyData,
+			claims: map[string]any{"sub": "foo", "exp": "1700000000"},
+			alg:    jwa.RS256,
+		},
+		{
+			name:   "ec key with json claims",
+			key:    string(ecKey),
+			claims: `{"sub":"foo","exp":1700000000}`,
+			alg:    jwa.ES256,
+		},
+		{
+			name:   "ed25519 key with string map claims",
+			key:    ed25519Key(t),
+			claims: map[string]string{"sub": "foo", "exp": "1700000000"},
+			alg:    jwa.EdDSA,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			token, err := jwtSign(tt.key, tt.claims)
+			if err != nil {
+				t.Fatal(err)
+			}
+			jwks, err := pemToJwks(tt.key)
+			if err != nil {
+				t.Fatal(err)
+			}
+			set, err := jwk.Parse([]byte(jwks))
+			if err != nil {
+				t.Fatal(err)
+			}
+			pub, _ := set.Key(0)
+			payload, err := jws.Verify([]byte(token), jws.WithKey(tt.alg, pub))
+			if err != nil {
+				t.Fatalf("unable to verify token: %v", err)
+			}
+			var claims map[string]any
+			if err := json.Unmarshal(payload, &claims); err != nil {
+				t.Fatal(err)
+			}
+			if claims["sub"] != "foo" || claims["exp"] != float64(1700000000) {
+				t.Errorf("unexpected claims %v", claims)
+			}
+		})
+	}
+
+	if _, err := jwtSign(keyData, map[string]any{"exp": "tomorrow"}); err == nil {
+		t.Error("jwtSign() expected an error for a non numeric exp claim")
+	}
+	if _, err := jwtSign(certData, map[string]any{}); err == nil {
+		t.Error("jwtSign() expected an error without private key")
+	}
+}
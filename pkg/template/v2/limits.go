@@ -0,0 +1,305 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package template
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"reflect"
+	"sync/atomic"
+	tpl "text/template"
+	tplparse "text/template/parse"
+	"time"
+)
+
+const (
+	defaultExecutionTimeout  = 10 * time.Second
+	defaultMaxOutputSize     = 1 << 20
+	defaultMaxExpensiveCalls = 100
+
+	// maxListLength limits the lists created by until, untilStep and seq,
+	// the strings created by repeat and the integers a template ranges over.
+	maxListLength = 1 << 20
+
+	// checkFuncName is the function which is inserted into the pipelines of
+	// range and template actions to check the limits.
+	checkFuncName = "_checkLimits"
+)
+
+// ErrLimitExceeded is returned if the execution of a template exceeds one of the configured limits.
+var ErrLimitExceeded = errors.New("template limit exceeded")
+
+var (
+	executionTimeout  time.Duration
+	maxOutputSize     int
+	maxExpensiveCalls int
+	deniedFuncs       []string
+)
+
+// expensiveFuncs are functions which take a lot of cpu time or memory.
+// Their calls are counted against --template-max-expensive-calls.
+var expensiveFuncs = []string{
+	"pkcs12key", "pkcs12keyPass", "pkcs12cert", "pkcs12certPass",
+	"pemToPkcs12", "pemToPkcs12Pass", "fullPemToPkcs12", "fullPemToPkcs12Pass",
+	"bcrypt", "bcryptWithSalt", "bcryptCost", "argon2id", "htpasswd", "htpasswdWithSalt", "scramSha256",
+	"jwtSign",
+	"genPrivateKey", "genCA", "genCAWithKey",
+	"genSelfSignedCert", "genSelfSignedCertWithKey", "genSignedCert", "genSignedCertWithKey",
+	"derivePassword",
+}
+
+// limits tracks the resources used by a single template execution.
+// The configured limits are copied, as the execution may outlive the call of execute.
+type limits struct {
+	timeout           time.Duration
+	maxOutputSize     int
+	maxExpensiveCalls int
+	deadline          time.Time
+	calls             int
+	// timedOut is set once the caller stopped waiting for the execution.
+	timedOut atomic.Bool
+}
+
+func newLimits() *limits {
+	l := &limits{
+		timeout:           executionTimeout,
+		maxOutputSize:     maxOutputSize,
+		maxExpensiveCalls: maxExpensiveCalls,
+	}
+	if l.timeout > 0 {
+		l.deadline = time.Now().Add(l.timeout)
+	}
+	return l
+}
+
+func (l *limits) checkDeadline() error {
+	if l.timedOut.Load() || !l.deadline.IsZero() && time.Now().After(l.deadline) {
+		return fmt.Errorf("%w: execution took longer than %s", ErrLimitExceeded, l.timeout)
+	}
+	return nil
+}
+
+// run calls execute and returns its error. If the execution takes longer than the
+// timeout, run returns an error without waiting for it. The execution then stops
+// at the next check of the limits.
+func (l *limits) run(execute func() error) error {
+	if l.deadline.IsZero() {
+		return execute()
+	}
+	done := make(chan error, 1)
+	go func() {
+		defer func() {
+			if r := recover(); r != nil {
+				done <- fmt.Errorf("template execution panicked: %v", r)
+			}
+		}()
+		done <- execute()
+	}()
+	timer := time.NewTimer(time.Until(l.deadline))
+	defer timer.Stop()
+	select {
+	case err := <-done:
+		return err
+	case <-timer.C:
+		l.timedOut.Store(true)
+		return fmt.Errorf("%w: execution took longer than %s", ErrLimitExceeded, l.timeout)
+	}
+}
+
+// funcs returns the functions which are replaced for this execution to enforce the limits.
+// They panic on violations, which text/template turns into an execution error.
+func (l *limits) funcs() tpl.FuncMap {
+	funcs := tpl.FuncMap{}
+	for _, name := range expensiveFuncs {
+		fn, ok := tplFuncs[name]
+		if !ok {
+			continue
+		}
+		funcs[name] = l.wrap(name, fn)
+	}
+	if until, ok := tplFuncs["until"].(func(int) []int); ok {
+		funcs["until"] = func(count int) []int {
+			l.checkListLength(int64(count))
+			return until(count)
+		}
+	}
+	if untilStep, ok := tplFuncs["untilStep"].(func(int, int, int) []int); ok {
+		funcs["untilStep"] = func(start, stop, step int) []int {
+			if step != 0 {
+				l.checkListLength(int64((stop - start) / step))
+			}
+			return untilStep(start, stop, step)
+		}
+	}
+	if seq, ok := tplFuncs["seq"].(func(...int) string); ok {
+		funcs["seq"] = func(params ...int) string {
+			l.checkListLength(int64(seqLength(params)))
+			return seq(params...)
+		}
+	}
+	if repeat, ok := tplFuncs["repeat"].(func(int, string) string); ok {
+		funcs["repeat"] = func(count int, str string) string {
+			if len(str) > 0 && count > maxListLength/len(str) {
+				panic(fmt.Errorf("%w: string of %d times %d bytes is longer than %d", ErrLimitExceeded, count, len(str), maxListLength))
+			}
+			return repeat(count, str)
+		}
+	}
+	funcs[checkFuncName] = l.checkPipeline
+	return funcs
+}
+
+// checkPipeline is called with the result of the pipeline of every range and template action.
+// It checks the deadline, so that loops and recursive templates which never write output are
+// stopped, and the length of integers, which are ranged over without allocating a list.
+func (l *limits) checkPipeline(value ...any) any {
+	if len(value) == 0 {
+		return nil
+	}
+	v := reflect.ValueOf(value[0])
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		l.checkListLength(v.Int())
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		l.checkListLength(int64(min(v.Uint(), maxListLength+1)))
+	default:
+		if err := l.checkDeadline(); err != nil {
+			panic(err)
+		}
+	}
+	return value[0]
+}
+
+// seqLength returns the number of elements created by sprig's seq.
+func seqLength(params []int) int {
+	start, step, end := 1, 1, 0
+	switch len(params) {
+	case 1:
+		end = params[0]
+	case 2:
+		start, end = params[0], params[1]
+	case 3:
+		start, step, end = params[0], params[1], params[2]
+	default:
+		return 0
+	}
+	if step < 0 {
+		step = -step
+	}
+	if step == 0 {
+		step = 1
+	}
+	length := end - start
+	if length < 0 {
+		length = -length
+	}
+	return length/step + 1
+}
+
+// instrument inserts a call of checkFuncName into the pipeline of every
+// range and template action of t, so that the limits are checked even
+// if the template neither writes output nor calls a limited function.
+func instrument(t *tpl.Template) {
+	for _, tmpl := range t.Templates() {
+		if tmpl.Tree != nil {
+			instrumentNode(tmpl.Tree, tmpl.Root)
+		}
+	}
+}
+
+func instrumentNode(tree *tplparse.Tree, node tplparse.Node) {
+	switch n := node.(type) {
+	case *tplparse.ListNode:
+		if n == nil {
+			return
+		}
+		for _, child := range n.Nodes {
+			instrumentNode(tree, child)
+		}
+	case *tplparse.IfNode:
+		instrumentNode(tree, n.List)
+		instrumentNode(tree, n.ElseList)
+	case *tplparse.WithNode:
+		instrumentNode(tree, n.List)
+		instrumentNode(tree, n.ElseList)
+	case *tplparse.RangeNode:
+		n.Pipe.Cmds = append(n.Pipe.Cmds, checkCommand(tree, n.Pipe.Position()))
+		instrumentNode(tree, n.List)
+		instrumentNode(tree, n.ElseList)
+	case *tplparse.TemplateNode:
+		if n.Pipe == nil {
+			n.Pipe = &tplparse.PipeNode{NodeType: tplparse.NodePipe, Pos: n.Pos, Line: n.Line}
+		}
+		n.Pipe.Cmds = append(n.Pipe.Cmds, checkCommand(tree, n.Pos))
+	}
+}
+
+func checkCommand(tree *tplparse.Tree, pos tplparse.Pos) *tplparse.CommandNode {
+	return &tplparse.CommandNode{
+		NodeType: tplparse.NodeCommand,
+		Pos:      pos,
+		Args:     []tplparse.Node{tplparse.NewIdentifier(checkFuncName).SetTree(tree).SetPos(pos)},
+	}
+}
+
+func (l *limits) wrap(name string, fn any) any {
+	v := reflect.ValueOf(fn)
+	return reflect.MakeFunc(v.Type(), func(args []reflect.Value) []reflect.Value {
+		if err := l.checkDeadline(); err != nil {
+			panic(err)
+		}
+		l.calls++
+		if l.maxExpensiveCalls > 0 && l.calls > l.maxExpensiveCalls {
+			panic(fmt.Errorf("%w: more than %d calls to expensive functions like %s", ErrLimitExceeded, l.maxExpensiveCalls, name))
+		}
+		if v.Type().IsVariadic() {
+			return v.CallSlice(args)
+		}
+		return v.Call(args)
+	}).Interface()
+}
+
+func (l *limits) checkListLength(length int64) {
+	if err := l.checkDeadline(); err != nil {
+		panic(err)
+	}
+	if length > maxListLength {
+		panic(fmt.Errorf("%w: list of %d elements is longer than %d", ErrLimitExceeded, length, maxListLength))
+	}
+}
+
+// limitedWriter buffers the output of a template and enforces the output size and the execution timeout.
+type limitedWriter struct {
+	bytes.Buffer
+	limits *limits
+}
+
+func (w *limitedWriter) Write(p []byte) (int, error) {
+	if err := w.limits.checkDeadline(); err != nil {
+		return 0, err
+	}
+	if w.limits.maxOutputSize > 0 && w.Len()+len(p) > w.limits.maxOutputSize {
+		return 0, fmt.Errorf("%w: output is larger than %d bytes", ErrLimitExceeded, w.limits.maxOutputSize)
+	}
+	return w.Buffer.Write(p)
+}
+
+// denyFuncs removes functions which must not be used in templates.
+func denyFuncs(names []string) {
+	for _, name := range names {
+		delete(tplFuncs, name)
+	}
+}
@@ -0,0 +1,133 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package template
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+
+	esapi "github.com/external-secrets/external-secrets/apis/externalsecrets/v1"
+)
+
+func TestLimits(t *testing.T) {
+	tests := []struct {
+		name    string
+		tpl     string
+		setup   func()
+		wantErr string
+	}{
+		{
+			name:    "output size",
+			tpl:     `{{ repeat 100 "a" }}`,
+			setup:   func() { maxOutputSize = 10 },
+			wantErr: "output is larger than 10 bytes",
+		},
+		{
+			name:    "expensive calls",
+			tpl:     `{{ range until 3 }}{{ bcryptCost 4 "salt" "password" }}{{ end }}`,
+			setup:   func() { maxExpensiveCalls = 2 },
+			wantErr: "more than 2 calls to expensive functions like bcryptCost",
+		},
+		{
+			name:    "list length",
+			tpl:     `{{ range until 100000000 }}{{ end }}`,
+			wantErr: "list of 100000000 elements is longer than",
+		},
+		{
+			name:    "list length with step",
+			tpl:     `{{ range untilStep 0 100000000 2 }}{{ end }}`,
+			wantErr: "list of 50000000 elements is longer than",
+		},
+		{
+			name:    "range over int",
+			tpl:     `{{ range 3000000000 }}{{ end }}`,
+			setup:   func() { executionTimeout = time.Second },
+			wantErr: "list of 3000000000 elements is longer than",
+		},
+		{
+			name:    "seq length",
+			tpl:     `{{ seq 1 3000000 }}`,
+			wantErr: "list of 3000000 elements is longer than",
+		},
+		{
+			name:    "repeat length",
+			tpl:     `{{ repeat 3000000 "ab" }}`,
+			wantErr: "string of 3000000 times 2 bytes is longer than",
+		},
+		{
+			name:    "timeout",
+			tpl:     `{{ range until 1000 }}a{{ end }}`,
+			setup:   func() { executionTimeout = time.Nanosecond },
+			wantErr: "execution took longer than 1ns",
+		},
+		{
+			name:    "timeout without output",
+			tpl:     `{{ range 1000000 }}{{ range 1000000 }}{{ end }}{{ end }}`,
+			setup:   func() { executionTimeout = 100 * time.Millisecond },
+			wantErr: "execution took longer than 100ms",
+		},
+		{
+			name:    "timeout of recursive template",
+			tpl:     `{{ define "r" }}{{ template "r" }}{{ template "r" }}{{ end }}{{ template "r" }}`,
+			setup:   func() { executionTimeout = 100 * time.Millisecond },
+			wantErr: "execution took longer than 100ms",
+		},
+		{
+			name: "within limits",
+			tpl:  `{{ range until 3 }}{{ bcryptCost 4 "salt" "password" | len }}{{ end }}`,
+		},
+		{
+			name: "range and template within limits",
+			tpl:  `{{ define "t" }}{{ . }}{{ end }}{{ range $i, $v := list 1 2 }}{{ template "t" $v }}{{ end }}{{ range 2 }}{{ template "t" }}{{ end }}{{ seq 3 }}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			defer func(timeout time.Duration, size, calls int) {
+				executionTimeout, maxOutputSize, maxExpensiveCalls = timeout, size, calls
+			}(executionTimeout, maxOutputSize, maxExpensiveCalls)
+			if tt.setup != nil {
+				tt.setup()
+			}
+			sec := &corev1.Secret{}
+			err := Execute(map[string][]byte{"key": []byte(tt.tpl)}, nil, esapi.TemplateScopeValues, esapi.TemplateTargetData, sec)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				return
+			}
+			if !errors.Is(err, ErrLimitExceeded) {
+				t.Fatalf("expected ErrLimitExceeded, got %v", err)
+			}
+			if !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("expected error to contain %q, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestDenyFuncs(t *testing.T) {
+	defer func(fn any) { tplFuncs["sha256sum"] = fn }(tplFuncs["sha256sum"])
+	denyFuncs([]string{"sha256sum"})
+	err := Parse("key", `{{ "foo" | sha256sum }}`)
+	if err == nil || !strings.Contains(err.Error(), `function "sha256sum" not defined`) {
+		t.Errorf("expected denied function to be undefined, got %v", err)
+	}
+}
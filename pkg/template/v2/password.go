@@ -0,0 +1,152 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package template
+
+import (
+	"crypto/hmac"
+	"crypto/sha1" //nolint:gosec // required by mysql_native_password
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+
+	"golang.org/x/crypto/argon2"
+	"golang.org/x/crypto/bcrypt"
+	"golang.org/x/crypto/blowfish"
+
+	"github.com/external-secrets/external-secrets/pkg/utils/scram"
+)
+
+const (
+	bcryptSaltSize = 16
+	bcryptMaxSize  = 72
+	// bcrypt only encodes 23 of the 24 encrypted bytes for compatibility with the C implementation.
+	bcryptHashSize = 23
+
+	// argon2id parameters as recommended by OWASP.
+	argon2Time    = 2
+	argon2Memory  = 19 * 1024
+	argon2Threads = 1
+	argon2KeySize = 32
+	argon2Salt    = 16
+)
+
+// bcryptEncoding is the base64 alphabet used by bcrypt.
+var bcryptEncoding = base64.NewEncoding("./ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz0123456789").WithPadding(base64.NoPadding)
+
+// bcryptMagic is the string "OrpheanBeholderScryDoubt", which is encrypted by bcrypt.
+var bcryptMagic = []byte("OrpheanBeholderScryDoubt")
+
+var (
+	errBcryptPasswordTooLong = errors.New("bcrypt: password length exceeds 72 bytes")
+	errEmptySalt             = errors.New("salt must not be empty")
+)
+
+// deriveSalt returns size bytes of salt for the algorithm.
+// Templates are executed on every reconcile, so a random salt would change the
+// secret every time. Instead the salt is derived from the salt given by the user,
+// who is responsible for choosing a unique salt per secret.
+func deriveSalt(algorithm, salt string, size int) ([]byte, error) {
+	if salt == "" {
+		return nil, errEmptySalt
+	}
+	mac := hmac.New(sha256.New, []byte(salt))
+	mac.Write([]byte(algorithm))
+	return mac.Sum(nil)[:size], nil
+}
+
+// bcryptHash returns the bcrypt hash of the password with the default cost.
+func bcryptHash(salt, password string) (string, error) {
+	return bcryptCost(bcrypt.DefaultCost, salt, password)
+}
+
+// bcryptCost returns the bcrypt hash of the password with the given cost.
+func bcryptCost(cost int, salt, password string) (string, error) {
+	return bcryptWithVersion("2a", cost, salt, password)
+}
+
+func bcryptWithVersion(version string, cost int, salt, password string) (string, error) {
+	if len(password) > bcryptMaxSize {
+		return "", errBcryptPasswordTooLong
+	}
+	if cost < bcrypt.MinCost || cost > bcrypt.MaxCost {
+		return "", bcrypt.InvalidCostError(cost)
+	}
+	csalt, err := deriveSalt("bcrypt", salt, bcryptSaltSize)
+	if err != nil {
+		return "", err
+	}
+	// the C implementations use the trailing NULL of the key
+	ckey := append([]byte(password), 0)
+	c, err := blowfish.NewSaltedCipher(ckey, csalt)
+	if err != nil {
+		return "", err
+	}
+	for range uint64(1) << cost {
+		blowfish.ExpandKey(ckey, c)
+		blowfish.ExpandKey(csalt, c)
+	}
+	data := make([]byte, len(bcryptMagic))
+	copy(data, bcryptMagic)
+	for i := 0; i < len(data); i += c.BlockSize() {
+		for range 64 {
+			c.Encrypt(data[i:i+c.BlockSize()], data[i:i+c.BlockSize()])
+		}
+	}
+	return fmt.Sprintf("$%s$%02d$%s%s", version, cost, bcryptEncoding.EncodeToString(csalt), bcryptEncoding.EncodeToString(data[:bcryptHashSize])), nil
+}
+
+// argon2idHash returns the argon2id hash of the password in the PHC string format.
+func argon2idHash(salt, password string) (string, error) {
+	csalt, err := deriveSalt("argon2id", salt, argon2Salt)
+	if err != nil {
+		return "", err
+	}
+	key := argon2.IDKey([]byte(password), csalt, argon2Time, argon2Memory, argon2Threads, argon2KeySize)
+	return fmt.Sprintf("$argon2id$v=%d$m=%d,t=%d,p=%d$%s$%s",
+		argon2.Version, argon2Memory, argon2Time, argon2Threads,
+		base64.RawStdEncoding.EncodeToString(csalt),
+		base64.RawStdEncoding.EncodeToString(key)), nil
+}
+
+// htpasswd returns an htpasswd entry of the user with the bcrypt hash of the password.
+func htpasswd(user, salt, password string) (string, error) {
+	if strings.Contains(user, ":") {
+		return "", errors.New("htpasswd: user must not contain ':'")
+	}
+	hash, err := bcryptWithVersion("2y", bcrypt.DefaultCost, salt, password)
+	if err != nil {
+		return "", err
+	}
+	return user + ":" + hash, nil
+}
+
+// scramSha256 returns the SCRAM-SHA-256 verifier of the password as stored by PostgreSQL.
+func scramSha256(salt, password string) (string, error) {
+	csalt, err := deriveSalt("scram-sha-256", salt, scram.SaltSize)
+	if err != nil {
+		return "", err
+	}
+	return scram.Verifier(password, csalt)
+}
+
+// mysqlNativePassword returns the hash of the password used by the mysql_native_password plugin.
+func mysqlNativePassword(password string) string {
+	first := sha1.Sum([]byte(password)) //nolint:gosec // required by mysql_native_password
+	second := sha1.Sum(first[:])        //nolint:gosec // required by mysql_native_password
+	return "*" + strings.ToUpper(hex.EncodeToString(second[:]))
+}
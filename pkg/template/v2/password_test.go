@@ -0,0 +1,133 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package template
+
+import (
+	"bytes"
+	"encoding/base64"
+	"strings"
+	"testing"
+	tpl "text/template"
+
+	"golang.org/x/crypto/argon2"
+	"golang.org/x/crypto/bcrypt"
+)
+
+func TestBcrypt(t *testing.T) {
+	hash, err := bcryptCost(bcrypt.MinCost, "salt", "secret")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := bcrypt.CompareHashAndPassword([]byte(hash), []byte("secret")); err != nil {
+		t.Errorf("bcryptCost() = %q does not match the password: %v", hash, err)
+	}
+	again, _ := bcryptCost(bcrypt.MinCost, "salt", "secret")
+	if hash != again {
+		t.Errorf("expected a stable hash, got %q and %q", hash, again)
+	}
+	other, _ := bcryptCost(bcrypt.MinCost, "other", "secret")
+	if other == hash {
+		t.Error("expected a different hash with a different salt")
+	}
+	if _, err := bcryptCost(bcrypt.MinCost, "", "secret"); err == nil {
+		t.Error("expected an error for an empty salt")
+	}
+	if _, err := bcryptHash("salt", strings.Repeat("x", 73)); err == nil {
+		t.Error("expected an error for passwords longer than 72 bytes")
+	}
+	if _, err := bcryptCost(32, "salt", "secret"); err == nil {
+		t.Error("expected an error for an invalid cost")
+	}
+}
+
+func TestHtpasswd(t *testing.T) {
+	entry, err := htpasswd("admin", "salt", "secret")
+	if err != nil {
+		t.Fatal(err)
+	}
+	user, hash, _ := strings.Cut(entry, ":")
+	if user != "admin" || !strings.HasPrefix(hash, "$2y$10$") {
+		t.Fatalf("unexpected htpasswd entry %q", entry)
+	}
+	if err := bcrypt.CompareHashAndPassword([]byte(hash), []byte("secret")); err != nil {
+		t.Errorf("htpasswd() = %q does not match the password: %v", entry, err)
+	}
+	if _, err := htpasswd("ad:min", "salt", "secret"); err == nil {
+		t.Error("expected an error for a user containing ':'")
+	}
+}
+
+func TestArgon2id(t *testing.T) {
+	hash, err := argon2idHash("salt", "secret")
+	if err != nil {
+		t.Fatal(err)
+	}
+	parts := strings.Split(hash, "$")
+	if len(parts) != 6 || parts[1] != "argon2id" || parts[2] != "v=19" || parts[3] != "m=19456,t=2,p=1" {
+		t.Fatalf("unexpected argon2id hash %q", hash)
+	}
+	salt, _ := base64.RawStdEncoding.DecodeString(parts[4])
+	key := argon2.IDKey([]byte("secret"), salt, 2, 19456, 1, 32)
+	if parts[5] != base64.RawStdEncoding.EncodeToString(key) {
+		t.Errorf("argon2id() = %q does not match the password", hash)
+	}
+	if _, err := argon2idHash("", "secret"); err == nil {
+		t.Error("expected an error for an empty salt")
+	}
+}
+
+func TestScramSha256(t *testing.T) {
+	verifier, err := scramSha256("c2FsdA", "secret")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasPrefix(verifier, "SCRAM-SHA-256$4096:") {
+		t.Errorf("unexpected verifier %q", verifier)
+	}
+	again, _ := scramSha256("c2FsdA", "secret")
+	if verifier != again {
+		t.Errorf("expected a stable verifier, got %q and %q", verifier, again)
+	}
+}
+
+func TestMysqlNativePassword(t *testing.T) {
+	if got := mysqlNativePassword("password"); got != "*2470C0C06DEE42FD1618BB99005ADCA2EC9D1E19" {
+		t.Errorf("mysqlNativePassword() = %q", got)
+	}
+}
+
+// The salted variants must not shadow the sprig functions bcrypt and htpasswd,
+// which existing templates call without a salt.
+func TestSprigPasswordFuncs(t *testing.T) {
+	sprig, err := tpl.New("sprig").Funcs(FuncMap()).Parse(`{{ bcrypt "secret" }} {{ htpasswd "admin" "secret" }}`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var buf bytes.Buffer
+	if err := sprig.Execute(&buf, nil); err != nil {
+		t.Fatal(err)
+	}
+	hash, entry, _ := strings.Cut(buf.String(), " ")
+	if err := bcrypt.CompareHashAndPassword([]byte(hash), []byte("secret")); err != nil {
+		t.Errorf("bcrypt = %q does not match the password: %v", hash, err)
+	}
+	user, entryHash, _ := strings.Cut(entry, ":")
+	if user != "admin" {
+		t.Errorf("unexpected htpasswd entry %q", entry)
+	}
+	if err := bcrypt.CompareHashAndPassword([]byte(entryHash), []byte("secret")); err != nil {
+		t.Errorf("htpasswd = %q does not match the password: %v", entry, err)
+	}
+}
@@ -15,7 +15,6 @@ limitations under the License.
 package template
 
 import (
-	"bytes"
 	"fmt"
 	tpl "text/template"
 
@@ -45,8 +44,29 @@ var tplFuncs = tpl.FuncMap{
 	"jwkPublicKeyPem":  jwkPublicKeyPem,
 	"jwkPrivateKeyPem": jwkPrivateKeyPem,
 
+	"x509Parse":      x509Parse,
+	"certChainOrder": certChainOrder,
+	"pemToJwks":      pemToJwks,
+	"jwtSign":        jwtSign,
+
 	"toYaml":   toYAML,
 	"fromYaml": fromYAML,
+
+	"toToml":         toTOML,
+	"fromToml":       fromTOML,
+	"toIni":          toINI,
+	"fromIni":        fromINI,
+	"toProperties":   toProperties,
+	"fromProperties": fromProperties,
+	"toDotenv":       toDotenv,
+	"fromDotenv":     fromDotenv,
+
+	"bcryptWithSalt":      bcryptHash,
+	"bcryptCost":          bcryptCost,
+	"argon2id":            argon2idHash,
+	"htpasswdWithSalt":    htpasswd,
+	"scramSha256":         scramSha256,
+	"mysqlNativePassword": mysqlNativePassword,
 }
 
 var leftDelim, rightDelim string
@@ -57,8 +77,8 @@ func FuncMap() tpl.FuncMap {
 }
 
 const (
-	errParse                = "unable to parse template at key %s: %s"
-	errExecute              = "unable to execute template at key %s: %s"
+	errParse                = "unable to parse template at key %s: %w"
+	errExecute              = "unable to execute template at key %s: %w"
 	errDecodePKCS12WithPass = "unable to decode pkcs12 with password: %s"
 	errDecodeCertWithPass   = "unable to decode pkcs12 certificate with password: %s"
 	errParsePrivKey         = "unable to parse private key type"
@@ -78,8 +98,15 @@ func init() {
 	fs := pflag.NewFlagSet("template", pflag.ExitOnError)
 	fs.StringVar(&leftDelim, "template-left-delimiter", "{{", "templating left delimiter")
 	fs.StringVar(&rightDelim, "template-right-delimiter", "}}", "templating right delimiter")
+	fs.DurationVar(&executionTimeout, "template-timeout", defaultExecutionTimeout, "Maximum time a single template may take to execute. Set to 0 to disable.")
+	fs.IntVar(&maxOutputSize, "template-max-output-size", defaultMaxOutputSize, "Maximum size in bytes of the output of a single template. Set to 0 to disable.")
+	fs.IntVar(&maxExpensiveCalls, "template-max-expensive-calls", defaultMaxExpensiveCalls, "Maximum number of calls to expensive functions, e.g. fullPemToPkcs12 or bcrypt, in a single template. Set to 0 to disable.")
+	fs.StringSliceVar(&deniedFuncs, "template-deny-functions", nil, "Comma separated list of template functions which are not available in templates, in addition to env and expandenv.")
 	feature.Register(feature.Feature{
 		Flags: fs,
+		Initialize: func() {
+			denyFuncs(deniedFuncs)
+		},
 	})
 }
 
@@ -155,12 +182,46 @@ func Execute(tpl, data map[string][]byte, scope esapi.TemplateScope, target esap
 	return nil
 }
 
+// Render executes the template val with the given data.
+// It provides the same functions and enforces the same limits as Execute.
+func Render(k, val string, data any) ([]byte, error) {
+	return render(k, val, data)
+}
+
 func execute(k, val string, data map[string][]byte) ([]byte, error) {
 	strValData := make(map[string]string, len(data))
 	for k := range data {
 		strValData[k] = string(data[k])
 	}
+	return render(k, val, strValData)
+}
+
+func render(k, val string, data any) ([]byte, error) {
+	t, err := parse(k, val)
+	if err != nil {
+		return nil, err
+	}
+	l := newLimits()
+	instrument(t)
+	buf := &limitedWriter{limits: l}
+	t = t.Funcs(l.funcs())
+	err = l.run(func() error {
+		return t.Execute(buf, data)
+	})
+	if err != nil {
+		return nil, fmt.Errorf(errExecute, k, err)
+	}
+	return buf.Bytes(), nil
+}
+
+// Parse parses the template at key k without executing it.
+// It returns an error if the template is malformed or calls an unknown function.
+func Parse(k, val string) error {
+	_, err := parse(k, val)
+	return err
+}
 
+func parse(k, val string) (*tpl.Template, error) {
 	t, err := tpl.New(k).
 		Option("missingkey=error").
 		Funcs(tplFuncs).
@@ -169,10 +230,5 @@ func execute(k, val string, data map[string][]byte) ([]byte, error) {
 	if err != nil {
 		return nil, fmt.Errorf(errParse, k, err)
 	}
-	buf := bytes.NewBuffer(nil)
-	err = t.Execute(buf, strValData)
-	if err != nil {
-		return nil, fmt.Errorf(errExecute, k, err)
-	}
-	return buf.Bytes(), nil
+	return t, nil
 }
@@ -164,6 +164,19 @@ func TestExecute(t *testing.T) {
 			annotationsTpl: nil,
 			data:           nil,
 		},
+		{
+			name: "htpasswdWithSalt func is stable",
+			tpl: map[string][]byte{
+				"auth": []byte(`{{ .password | htpasswdWithSalt "admin" .salt }}`),
+			},
+			data: map[string][]byte{
+				"password": []byte("secret"),
+				"salt":     []byte("c2FsdA"),
+			},
+			expectedData: map[string][]byte{
+				"auth": []byte("admin:$2y$10$ww8zAkjM0KeZy8ZGpeG3YOyREDmEPT8TfYQv7iM9R32/B0Gy/viFK"),
+			},
+		},
 		{
 			name: "b64dec func",
 			tpl: map[string][]byte{
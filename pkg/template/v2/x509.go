@@ -0,0 +1,96 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package template
+
+import (
+	"crypto/sha1" //nolint:gosec // sha1 fingerprints are still widely used to identify certificates
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+const errNoCertificate = "no certificate found"
+
+// x509Parse parses the first certificate of a list of PEM blocks
+// and returns its most relevant fields, e.g. to check the expiry or the SANs in a template.
+func x509Parse(input string) (map[string]any, error) {
+	cert, err := firstCertificate(input)
+	if err != nil {
+		return nil, err
+	}
+	ipAddresses := make([]string, 0, len(cert.IPAddresses))
+	for _, ip := range cert.IPAddresses {
+		ipAddresses = append(ipAddresses, ip.String())
+	}
+	uris := make([]string, 0, len(cert.URIs))
+	for _, uri := range cert.URIs {
+		uris = append(uris, uri.String())
+	}
+	sha1Sum := sha1.Sum(cert.Raw) //nolint:gosec // see import
+	sha256Sum := sha256.Sum256(cert.Raw)
+	return map[string]any{
+		"subject":        cert.Subject.String(),
+		"commonName":     cert.Subject.CommonName,
+		"issuer":         cert.Issuer.String(),
+		"serialNumber":   cert.SerialNumber.String(),
+		"notBefore":      cert.NotBefore.UTC().Format(time.RFC3339),
+		"notAfter":       cert.NotAfter.UTC().Format(time.RFC3339),
+		"dnsNames":       cert.DNSNames,
+		"emailAddresses": cert.EmailAddresses,
+		"ipAddresses":    ipAddresses,
+		"uris":           uris,
+		"isCA":           cert.IsCA,
+		"fingerprints": map[string]any{
+			"sha1":   fingerprint(sha1Sum[:]),
+			"sha256": fingerprint(sha256Sum[:]),
+		},
+	}, nil
+}
+
+// certChainOrder orders a certificate chain as leaf / intermediate(s) / root.
+func certChainOrder(input string) (string, error) {
+	chain, err := fetchCertChains([]byte(input))
+	if err != nil {
+		return "", err
+	}
+	return string(chain), nil
+}
+
+func firstCertificate(input string) (*x509.Certificate, error) {
+	data := []byte(input)
+	for {
+		block, rest := pem.Decode(data)
+		if block == nil {
+			return nil, errors.New(errNoCertificate)
+		}
+		data = rest
+		if block.Type == pemTypeCertificate {
+			return x509.ParseCertificate(block.Bytes)
+		}
+	}
+}
+
+// fingerprint formats a digest the same way as openssl, e.g. AB:CD:EF.
+func fingerprint(sum []byte) string {
+	parts := make([]string, len(sum))
+	for i, b := range sum {
+		parts[i] = fmt.Sprintf("%02X", b)
+	}
+	return strings.Join(parts, ":")
+}
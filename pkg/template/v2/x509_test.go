@@ -0,0 +1,81 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package template
+
+import (
+	"os"
+	"testing"
+)
+
+func TestX509Parse(t *testing.T) {
+	cert, err := os.ReadFile("_testdata/foo.crt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := x509Parse(string(cert))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := map[string]any{
+		"commonName": "foo",
+		"subject":    "CN=foo",
+		"issuer":     "CN=intermediate-ca",
+		"notBefore":  "2022-02-09T10:25:31Z",
+		"notAfter":   "2022-02-10T10:25:31Z",
+		"isCA":       false,
+	}
+	for k, v := range want {
+		if got[k] != v {
+			t.Errorf("x509Parse()[%q] = %v, want %v", k, got[k], v)
+		}
+	}
+	if dnsNames := got["dnsNames"].([]string); len(dnsNames) != 1 || dnsNames[0] != "foo" {
+		t.Errorf("x509Parse()[dnsNames] = %v, want [foo]", dnsNames)
+	}
+	sha256 := got["fingerprints"].(map[string]any)["sha256"]
+	if sha256 != "24:97:48:51:F4:6A:96:4A:D3:0C:D0:47:50:37:2D:53:63:B1:55:C2:B2:D5:E4:DC:0F:DE:2B:09:FA:6F:81:9A" {
+		t.Errorf("x509Parse()[fingerprints][sha256] = %v", sha256)
+	}
+
+	if _, err := x509Parse(keyData); err == nil {
+		t.Error("x509Parse() expected an error without certificate")
+	}
+}
+
+func TestCertChainOrder(t *testing.T) {
+	unordered, err := readCertificates([]string{
+		"_testdata/root-ca.crt",
+		"_testdata/foo.crt",
+		"_testdata/intermediate-ca.crt",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want, err := readCertificates([]string{
+		"_testdata/foo.crt",
+		"_testdata/intermediate-ca.crt",
+		"_testdata/root-ca.crt",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := certChainOrder(string(unordered))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got != string(want) {
+		t.Errorf("certChainOrder() = %v, want %v", got, string(want))
+	}
+}
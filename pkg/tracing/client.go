@@ -0,0 +1,104 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tracing
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/attribute"
+	corev1 "k8s.io/api/core/v1"
+
+	esv1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1"
+)
+
+const (
+	AttributeStoreKind      = attribute.Key("externalsecrets.store.kind")
+	AttributeStoreName      = attribute.Key("externalsecrets.store.name")
+	AttributeStoreNamespace = attribute.Key("externalsecrets.store.namespace")
+	AttributeProvider       = attribute.Key("externalsecrets.provider")
+	AttributeRemoteKey      = attribute.Key("externalsecrets.remote_ref.key")
+	AttributeProperty       = attribute.Key("externalsecrets.remote_ref.property")
+)
+
+// Client wraps a SecretsClient and creates a span for every provider method.
+type Client struct {
+	esv1.SecretsClient
+	attrs []attribute.KeyValue
+}
+
+var _ esv1.SecretsClient = &Client{}
+
+// NewClient returns a SecretsClient that traces all calls to client.
+func NewClient(client esv1.SecretsClient, store esv1.GenericStore) *Client {
+	provider, _ := esv1.GetProviderName(store)
+	return &Client{
+		SecretsClient: client,
+		attrs: []attribute.KeyValue{
+			AttributeStoreKind.String(store.GetKind()),
+			AttributeStoreName.String(store.GetName()),
+			AttributeStoreNamespace.String(store.GetNamespace()),
+			AttributeProvider.String(provider),
+		},
+	}
+}
+
+func (c *Client) start(ctx context.Context, method string, attrs ...attribute.KeyValue) (context.Context, func(error)) {
+	ctx, span := Start(ctx, "provider."+method, append(attrs, c.attrs...)...)
+	return ctx, func(err error) {
+		End(span, err)
+	}
+}
+
+func (c *Client) GetSecret(ctx context.Context, ref esv1.ExternalSecretDataRemoteRef) ([]byte, error) {
+	ctx, end := c.start(ctx, "GetSecret", AttributeRemoteKey.String(ref.Key), AttributeProperty.String(ref.Property))
+	secret, err := c.SecretsClient.GetSecret(ctx, ref)
+	end(err)
+	return secret, err
+}
+
+func (c *Client) GetSecretMap(ctx context.Context, ref esv1.ExternalSecretDataRemoteRef) (map[string][]byte, error) {
+	ctx, end := c.start(ctx, "GetSecretMap", AttributeRemoteKey.String(ref.Key), AttributeProperty.String(ref.Property))
+	secrets, err := c.SecretsClient.GetSecretMap(ctx, ref)
+	end(err)
+	return secrets, err
+}
+
+func (c *Client) GetAllSecrets(ctx context.Context, ref esv1.ExternalSecretFind) (map[string][]byte, error) {
+	ctx, end := c.start(ctx, "GetAllSecrets")
+	secrets, err := c.SecretsClient.GetAllSecrets(ctx, ref)
+	end(err)
+	return secrets, err
+}
+
+func (c *Client) PushSecret(ctx context.Context, secret *corev1.Secret, data esv1.PushSecretData) error {
+	ctx, end := c.start(ctx, "PushSecret", AttributeRemoteKey.String(data.GetRemoteKey()), AttributeProperty.String(data.GetProperty()))
+	err := c.SecretsClient.PushSecret(ctx, secret, data)
+	end(err)
+	return err
+}
+
+func (c *Client) DeleteSecret(ctx context.Context, remoteRef esv1.PushSecretRemoteRef) error {
+	ctx, end := c.start(ctx, "DeleteSecret", AttributeRemoteKey.String(remoteRef.GetRemoteKey()), AttributeProperty.String(remoteRef.GetProperty()))
+	err := c.SecretsClient.DeleteSecret(ctx, remoteRef)
+	end(err)
+	return err
+}
+
+func (c *Client) SecretExists(ctx context.Context, remoteRef esv1.PushSecretRemoteRef) (bool, error) {
+	ctx, end := c.start(ctx, "SecretExists", AttributeRemoteKey.String(remoteRef.GetRemoteKey()))
+	exists, err := c.SecretsClient.SecretExists(ctx, remoteRef)
+	end(err)
+	return exists, err
+}
@@ -0,0 +1,93 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tracing
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"sync"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+)
+
+// traces maps the UID of an object that is being reconciled to the ID of the trace of that reconcile.
+// controller-runtime never reconciles the same object concurrently.
+var traces sync.Map
+
+// Track associates events emitted for the object with the given UID with the trace in ctx,
+// until the returned function is called.
+func Track(ctx context.Context, uid types.UID) func() {
+	id := TraceID(ctx)
+	if id == "" || uid == "" {
+		return func() {}
+	}
+	traces.Store(uid, id)
+	return func() {
+		traces.Delete(uid)
+	}
+}
+
+// EventRecorder adds the trace ID of the current reconcile
+// as annotation to all events of a tracked object.
+type EventRecorder struct {
+	record.EventRecorder
+}
+
+// NewEventRecorder wraps the event recorder.
+func NewEventRecorder(recorder record.EventRecorder) *EventRecorder {
+	return &EventRecorder{EventRecorder: recorder}
+}
+
+func (r *EventRecorder) Event(object runtime.Object, eventtype, reason, message string) {
+	r.AnnotatedEventf(object, nil, eventtype, reason, "%s", message)
+}
+
+func (r *EventRecorder) Eventf(object runtime.Object, eventtype, reason, messageFmt string, args ...any) {
+	r.AnnotatedEventf(object, nil, eventtype, reason, messageFmt, args...)
+}
+
+func (r *EventRecorder) AnnotatedEventf(object runtime.Object, annotations map[string]string, eventtype, reason, messageFmt string, args ...any) {
+	id, ok := traceIDOf(object)
+	if !ok {
+		if annotations == nil {
+			r.EventRecorder.Event(object, eventtype, reason, fmt.Sprintf(messageFmt, args...))
+			return
+		}
+		r.EventRecorder.AnnotatedEventf(object, annotations, eventtype, reason, messageFmt, args...)
+		return
+	}
+	annotations = maps.Clone(annotations)
+	if annotations == nil {
+		annotations = make(map[string]string, 1)
+	}
+	annotations[TraceIDAnnotation] = id
+	r.EventRecorder.AnnotatedEventf(object, annotations, eventtype, reason, messageFmt, args...)
+}
+
+func traceIDOf(object runtime.Object) (string, bool) {
+	accessor, err := meta.Accessor(object)
+	if err != nil {
+		return "", false
+	}
+	id, ok := traces.Load(accessor.GetUID())
+	if !ok {
+		return "", false
+	}
+	return id.(string), true
+}
@@ -0,0 +1,160 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package tracing sets up OpenTelemetry trace export and provides
+// helpers to instrument reconcilers, provider clients and HTTP transports.
+package tracing
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync/atomic"
+
+	"github.com/go-logr/logr"
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.34.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	tracerName = "github.com/external-secrets/external-secrets"
+
+	ProtocolGRPC = "grpc"
+	ProtocolHTTP = "http/protobuf"
+
+	// TraceIDAnnotation is set on events emitted during a traced reconcile.
+	TraceIDAnnotation = "external-secrets.io/trace-id"
+
+	// LogKeyTraceID is the key used to add the trace ID to log lines.
+	LogKeyTraceID = "traceID"
+)
+
+// Options configures the OTLP trace exporter.
+type Options struct {
+	// Endpoint is the host:port of the OTLP receiver. Tracing is disabled if it is empty.
+	Endpoint      string
+	Protocol      string
+	Insecure      bool
+	SamplingRatio float64
+	ServiceName   string
+}
+
+var enabled atomic.Bool
+
+// Enabled returns true if trace export has been set up.
+func Enabled() bool {
+	return enabled.Load()
+}
+
+// Setup configures the global tracer provider to export spans via OTLP.
+// The returned function flushes and stops the exporter.
+// If no endpoint is configured Setup is a no-op.
+func Setup(ctx context.Context, opts Options) (func(context.Context) error, error) {
+	if opts.Endpoint == "" {
+		return func(context.Context) error { return nil }, nil
+	}
+	exporter, err := newExporter(ctx, opts)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create OTLP trace exporter: %w", err)
+	}
+	res, err := resource.New(ctx,
+		resource.WithFromEnv(),
+		resource.WithTelemetrySDK(),
+		resource.WithAttributes(semconv.ServiceName(opts.ServiceName)),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create trace resource: %w", err)
+	}
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(res),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(opts.SamplingRatio))),
+	)
+	otel.SetTracerProvider(provider)
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{}))
+	enabled.Store(true)
+	return provider.Shutdown, nil
+}
+
+func newExporter(ctx context.Context, opts Options) (*otlptrace.Exporter, error) {
+	switch opts.Protocol {
+	case ProtocolGRPC:
+		grpcOpts := []otlptracegrpc.Option{otlptracegrpc.WithEndpoint(opts.Endpoint)}
+		if opts.Insecure {
+			grpcOpts = append(grpcOpts, otlptracegrpc.WithInsecure())
+		}
+		return otlptracegrpc.New(ctx, grpcOpts...)
+	case ProtocolHTTP:
+		httpOpts := []otlptracehttp.Option{otlptracehttp.WithEndpoint(opts.Endpoint)}
+		if opts.Insecure {
+			httpOpts = append(httpOpts, otlptracehttp.WithInsecure())
+		}
+		return otlptracehttp.New(ctx, httpOpts...)
+	default:
+		return nil, fmt.Errorf("unsupported OTLP protocol %q, must be one of %s, %s", opts.Protocol, ProtocolGRPC, ProtocolHTTP)
+	}
+}
+
+// Start starts a span as child of the span in ctx.
+func Start(ctx context.Context, name string, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	return otel.Tracer(tracerName).Start(ctx, name, trace.WithAttributes(attrs...))
+}
+
+// End records err on the span, if any, and ends it.
+func End(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
+
+// TraceID returns the ID of the trace in ctx or an empty string
+// if ctx does not carry a sampled span.
+func TraceID(ctx context.Context) string {
+	sc := trace.SpanContextFromContext(ctx)
+	if !sc.IsValid() || !sc.IsSampled() {
+		return ""
+	}
+	return sc.TraceID().String()
+}
+
+// Logger adds the trace ID of ctx to the logger.
+func Logger(ctx context.Context, log logr.Logger) logr.Logger {
+	if id := TraceID(ctx); id != "" {
+		return log.WithValues(LogKeyTraceID, id)
+	}
+	return log
+}
+
+// Transport instruments rt to create a client span for every request.
+// It returns rt unchanged if tracing is disabled.
+// The process-wide http.DefaultTransport is never instrumented, so clients
+// that should be traced must wrap their transport explicitly.
+func Transport(rt http.RoundTripper) http.RoundTripper {
+	if !Enabled() {
+		return rt
+	}
+	return otelhttp.NewTransport(rt)
+}
@@ -0,0 +1,152 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tracing
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+
+	esv1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1"
+	"github.com/external-secrets/external-secrets/pkg/provider/testing/fake"
+)
+
+func setUpSpanRecorder(t *testing.T) *tracetest.SpanRecorder {
+	t.Helper()
+	recorder := tracetest.NewSpanRecorder()
+	previous := otel.GetTracerProvider()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+	t.Cleanup(func() {
+		otel.SetTracerProvider(previous)
+	})
+	return recorder
+}
+
+func TestClient(t *testing.T) {
+	spans := setUpSpanRecorder(t)
+	store := &esv1.SecretStore{
+		TypeMeta:   metav1.TypeMeta{Kind: esv1.SecretStoreKind},
+		ObjectMeta: metav1.ObjectMeta{Name: "store", Namespace: "default"},
+		Spec: esv1.SecretStoreSpec{
+			Provider: &esv1.SecretStoreProvider{Fake: &esv1.FakeProvider{}},
+		},
+	}
+	fakeClient := fake.New()
+	fakeClient.WithGetSecret(nil, errors.New("boom"))
+
+	ctx, parent := Start(context.Background(), "parent")
+	_, _ = NewClient(fakeClient, store).GetSecret(ctx, esv1.ExternalSecretDataRemoteRef{Key: "db"})
+	parent.End()
+
+	ended := spans.Ended()
+	if len(ended) != 2 {
+		t.Fatalf("expected 2 spans, got %d", len(ended))
+	}
+	span := ended[0]
+	if span.Name() != "provider.GetSecret" {
+		t.Errorf("unexpected span name %q", span.Name())
+	}
+	if span.Parent().SpanID() != parent.SpanContext().SpanID() {
+		t.Error("expected provider span to be a child of the parent span")
+	}
+	if span.Status().Code != codes.Error {
+		t.Errorf("expected error status, got %v", span.Status().Code)
+	}
+	attrs := map[string]string{}
+	for _, attr := range span.Attributes() {
+		attrs[string(attr.Key)] = attr.Value.Emit()
+	}
+	if attrs[string(AttributeProvider)] != "fake" || attrs[string(AttributeRemoteKey)] != "db" {
+		t.Errorf("unexpected span attributes: %v", attrs)
+	}
+}
+
+type annotationRecorder struct {
+	record.EventRecorder
+	annotations []map[string]string
+}
+
+func (r *annotationRecorder) Event(_ runtime.Object, _, _, _ string) {
+	r.annotations = append(r.annotations, nil)
+}
+
+func (r *annotationRecorder) AnnotatedEventf(_ runtime.Object, annotations map[string]string, _, _, _ string, _ ...any) {
+	r.annotations = append(r.annotations, annotations)
+}
+
+func TestEventRecorder(t *testing.T) {
+	setUpSpanRecorder(t)
+	inner := &annotationRecorder{}
+	recorder := NewEventRecorder(inner)
+	tracked := &v1.Secret{ObjectMeta: metav1.ObjectMeta{UID: "tracked"}}
+	other := &v1.Secret{ObjectMeta: metav1.ObjectMeta{UID: "other"}}
+
+	ctx, span := Start(context.Background(), "reconcile")
+	defer span.End()
+	untrack := Track(ctx, tracked.UID)
+	recorder.Eventf(tracked, v1.EventTypeNormal, "Reason", "message %d", 1)
+	recorder.Event(other, v1.EventTypeNormal, "Reason", "message")
+	untrack()
+	recorder.Event(tracked, v1.EventTypeNormal, "Reason", "message")
+
+	want := []string{span.SpanContext().TraceID().String(), "", ""}
+	if len(inner.annotations) != len(want) {
+		t.Fatalf("expected %d events, got %d", len(want), len(inner.annotations))
+	}
+	for i, annotations := range inner.annotations {
+		if got := annotations[TraceIDAnnotation]; got != want[i] {
+			t.Errorf("event %d: expected trace id %q, got %q", i, want[i], got)
+		}
+	}
+}
+
+func TestTransport(t *testing.T) {
+	spans := setUpSpanRecorder(t)
+	if rt := Transport(http.DefaultTransport); rt != http.DefaultTransport {
+		t.Error("expected the transport to be unchanged if tracing is disabled")
+	}
+	enabled.Store(true)
+	t.Cleanup(func() {
+		enabled.Store(false)
+	})
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer srv.Close()
+
+	client := &http.Client{Transport: Transport(http.DefaultTransport)}
+	resp, err := client.Get(srv.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_ = resp.Body.Close()
+	if got := len(spans.Ended()); got != 1 {
+		t.Errorf("expected 1 span, got %d", got)
+	}
+	if _, ok := http.DefaultTransport.(*http.Transport); !ok {
+		t.Error("expected http.DefaultTransport not to be replaced")
+	}
+}
@@ -223,6 +223,94 @@ func clusterGeneratorToVirtual(gen *genv1alpha1.ClusterGenerator) (client.Object
 			},
 			Spec: *gen.Spec.Generator.SSHKeySpec,
 		}, nil
+	case genv1alpha1.GeneratorKindCertificate:
+		if gen.Spec.Generator.CertificateSpec == nil {
+			return nil, fmt.Errorf("when kind is %s, CertificateSpec must be set", gen.Spec.Kind)
+		}
+		return &genv1alpha1.Certificate{
+			TypeMeta: metav1.TypeMeta{
+				APIVersion: genv1alpha1.SchemeGroupVersion.String(),
+				Kind:       genv1alpha1.CertificateKind,
+			},
+			Spec: *gen.Spec.Generator.CertificateSpec,
+		}, nil
+	case genv1alpha1.GeneratorKindServiceAccountToken:
+		if gen.Spec.Generator.ServiceAccountTokenSpec == nil {
+			return nil, fmt.Errorf("when kind is %s, ServiceAccountTokenSpec must be set", gen.Spec.Kind)
+		}
+		return &genv1alpha1.ServiceAccountToken{
+			TypeMeta: metav1.TypeMeta{
+				APIVersion: genv1alpha1.SchemeGroupVersion.String(),
+				Kind:       genv1alpha1.ServiceAccountTokenKind,
+			},
+			Spec: *gen.Spec.Generator.ServiceAccountTokenSpec,
+		}, nil
+	case genv1alpha1.GeneratorKindWireGuardKey:
+		if gen.Spec.Generator.WireGuardKeySpec == nil {
+			return nil, fmt.Errorf("when kind is %s, WireGuardKeySpec must be set", gen.Spec.Kind)
+		}
+		return &genv1alpha1.WireGuardKey{
+			TypeMeta: metav1.TypeMeta{
+				APIVersion: genv1alpha1.SchemeGroupVersion.String(),
+				Kind:       genv1alpha1.WireGuardKeyKind,
+			},
+			Spec: *gen.Spec.Generator.WireGuardKeySpec,
+		}, nil
+	case genv1alpha1.GeneratorKindAgeKey:
+		if gen.Spec.Generator.AgeKeySpec == nil {
+			return nil, fmt.Errorf("when kind is %s, AgeKeySpec must be set", gen.Spec.Kind)
+		}
+		return &genv1alpha1.AgeKey{
+			TypeMeta: metav1.TypeMeta{
+				APIVersion: genv1alpha1.SchemeGroupVersion.String(),
+				Kind:       genv1alpha1.AgeKeyKind,
+			},
+			Spec: *gen.Spec.Generator.AgeKeySpec,
+		}, nil
+	case genv1alpha1.GeneratorKindDatabaseUser:
+		if gen.Spec.Generator.DatabaseUserSpec == nil {
+			return nil, fmt.Errorf("when kind is %s, DatabaseUserSpec must be set", gen.Spec.Kind)
+		}
+		return &genv1alpha1.DatabaseUser{
+			TypeMeta: metav1.TypeMeta{
+				APIVersion: genv1alpha1.SchemeGroupVersion.String(),
+				Kind:       genv1alpha1.DatabaseUserKind,
+			},
+			Spec: *gen.Spec.Generator.DatabaseUserSpec,
+		}, nil
+	case genv1alpha1.GeneratorKindGitlabAccessToken:
+		if gen.Spec.Generator.GitlabAccessTokenSpec == nil {
+			return nil, fmt.Errorf("when kind is %s, GitlabAccessTokenSpec must be set", gen.Spec.Kind)
+		}
+		return &genv1alpha1.GitlabAccessToken{
+			TypeMeta: metav1.TypeMeta{
+				APIVersion: genv1alpha1.SchemeGroupVersion.String(),
+				Kind:       genv1alpha1.GitlabAccessTokenKind,
+			},
+			Spec: *gen.Spec.Generator.GitlabAccessTokenSpec,
+		}, nil
+	case genv1alpha1.GeneratorKindOAuth2Token:
+		if gen.Spec.Generator.OAuth2TokenSpec == nil {
+			return nil, fmt.Errorf("when kind is %s, OAuth2TokenSpec must be set", gen.Spec.Kind)
+		}
+		return &genv1alpha1.OAuth2Token{
+			TypeMeta: metav1.TypeMeta{
+				APIVersion: genv1alpha1.SchemeGroupVersion.String(),
+				Kind:       genv1alpha1.OAuth2TokenKind,
+			},
+			Spec: *gen.Spec.Generator.OAuth2TokenSpec,
+		}, nil
+	case genv1alpha1.GeneratorKindTransform:
+		if gen.Spec.Generator.TransformSpec == nil {
+			return nil, fmt.Errorf("when kind is %s, TransformSpec must be set", gen.Spec.Kind)
+		}
+		return &genv1alpha1.Transform{
+			TypeMeta: metav1.TypeMeta{
+				APIVersion: genv1alpha1.SchemeGroupVersion.String(),
+				Kind:       genv1alpha1.TransformKind,
+			},
+			Spec: *gen.Spec.Generator.TransformSpec,
+		}, nil
 	case genv1alpha1.GeneratorKindSTSSessionToken:
 		if gen.Spec.Generator.STSSessionTokenSpec == nil {
 			return nil, fmt.Errorf("when kind is %s, STSSessionTokenSpec must be set", gen.Spec.Kind)